@@ -19,10 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	CommentService_GetCommentList_FullMethodName = "/comment.v1.CommentService/GetCommentList"
-	CommentService_DeleteComment_FullMethodName  = "/comment.v1.CommentService/DeleteComment"
-	CommentService_CreateComment_FullMethodName  = "/comment.v1.CommentService/CreateComment"
-	CommentService_GetMoreReplies_FullMethodName = "/comment.v1.CommentService/GetMoreReplies"
+	CommentService_GetCommentList_FullMethodName  = "/comment.v1.CommentService/GetCommentList"
+	CommentService_DeleteComment_FullMethodName   = "/comment.v1.CommentService/DeleteComment"
+	CommentService_CreateComment_FullMethodName   = "/comment.v1.CommentService/CreateComment"
+	CommentService_GetMoreReplies_FullMethodName  = "/comment.v1.CommentService/GetMoreReplies"
+	CommentService_GetUserComments_FullMethodName = "/comment.v1.CommentService/GetUserComments"
 )
 
 // CommentServiceClient is the client API for CommentService service.
@@ -36,6 +37,8 @@ type CommentServiceClient interface {
 	// CreateComment 创建评论
 	CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*CreateCommentResponse, error)
 	GetMoreReplies(ctx context.Context, in *GetMoreRepliesRequest, opts ...grpc.CallOption) (*GetMoreRepliesResponse, error)
+	// GetUserComments 用户发表过的评论，按照 ID 降序，导出用户数据的时候使用
+	GetUserComments(ctx context.Context, in *GetUserCommentsRequest, opts ...grpc.CallOption) (*GetUserCommentsResponse, error)
 }
 
 type commentServiceClient struct {
@@ -86,6 +89,16 @@ func (c *commentServiceClient) GetMoreReplies(ctx context.Context, in *GetMoreRe
 	return out, nil
 }
 
+func (c *commentServiceClient) GetUserComments(ctx context.Context, in *GetUserCommentsRequest, opts ...grpc.CallOption) (*GetUserCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserCommentsResponse)
+	err := c.cc.Invoke(ctx, CommentService_GetUserComments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CommentServiceServer is the server API for CommentService service.
 // All implementations must embed UnimplementedCommentServiceServer
 // for forward compatibility
@@ -97,6 +110,8 @@ type CommentServiceServer interface {
 	// CreateComment 创建评论
 	CreateComment(context.Context, *CreateCommentRequest) (*CreateCommentResponse, error)
 	GetMoreReplies(context.Context, *GetMoreRepliesRequest) (*GetMoreRepliesResponse, error)
+	// GetUserComments 用户发表过的评论，按照 ID 降序，导出用户数据的时候使用
+	GetUserComments(context.Context, *GetUserCommentsRequest) (*GetUserCommentsResponse, error)
 	mustEmbedUnimplementedCommentServiceServer()
 }
 
@@ -116,6 +131,9 @@ func (UnimplementedCommentServiceServer) CreateComment(context.Context, *CreateC
 func (UnimplementedCommentServiceServer) GetMoreReplies(context.Context, *GetMoreRepliesRequest) (*GetMoreRepliesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMoreReplies not implemented")
 }
+func (UnimplementedCommentServiceServer) GetUserComments(context.Context, *GetUserCommentsRequest) (*GetUserCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserComments not implemented")
+}
 func (UnimplementedCommentServiceServer) mustEmbedUnimplementedCommentServiceServer() {}
 
 // UnsafeCommentServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -201,6 +219,24 @@ func _CommentService_GetMoreReplies_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CommentService_GetUserComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).GetUserComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_GetUserComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).GetUserComments(ctx, req.(*GetUserCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CommentService_ServiceDesc is the grpc.ServiceDesc for CommentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -224,6 +260,10 @@ var CommentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMoreReplies",
 			Handler:    _CommentService_GetMoreReplies_Handler,
 		},
+		{
+			MethodName: "GetUserComments",
+			Handler:    _CommentService_GetUserComments_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "comment/v1/comment.proto",
@@ -0,0 +1,57 @@
+// dlqreplay 把死信队列里面的消息重新投递到原来的 topic，
+// 一般是修复了消费者的问题之后手动执行一次：
+//
+//	go run ./cmd/dlqreplay --addrs localhost:9094 --topic user_deleted_dead_letter
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"webook/pkg/logger"
+	"webook/pkg/saramax"
+
+	"github.com/IBM/sarama"
+	"github.com/spf13/pflag"
+	"go.uber.org/zap"
+)
+
+func main() {
+	addrs := pflag.StringSlice("addrs", []string{"localhost:9094"}, "Kafka 的地址")
+	topic := pflag.String("topic", "", "死信队列的 topic")
+	group := pflag.String("group", "", "记录投递进度的消费者组，默认是 topic + _replay")
+	pflag.Parse()
+	if *topic == "" {
+		log.Fatalln("必须指定 --topic")
+	}
+	if *group == "" {
+		*group = *topic + "_replay"
+	}
+
+	cfg := sarama.NewConfig()
+	cfg.Producer.Return.Successes = true
+	client, err := sarama.NewClient(*addrs, cfg)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	defer client.Close()
+	producer, err := sarama.NewSyncProducerFromClient(client)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	defer producer.Close()
+	zl, err := zap.NewDevelopment()
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+	r := saramax.NewReplayer(client, producer, *group, logger.NewZapLogger(zl))
+	cnt, err := r.Replay(ctx, *topic)
+	log.Printf("重新投递了 %d 条消息\n", cnt)
+	if err != nil {
+		log.Fatalln(err)
+	}
+}
@@ -67,6 +67,16 @@ func (c *CommentServiceServer) CreateComment(ctx context.Context, request *comme
 	return &commentv1.CreateCommentResponse{}, err
 }
 
+func (c *CommentServiceServer) GetUserComments(ctx context.Context, request *commentv1.GetUserCommentsRequest) (*commentv1.GetUserCommentsResponse, error) {
+	cs, err := c.svc.GetUserComments(ctx, request.GetUid(), request.GetMinId(), request.GetLimit())
+	if err != nil {
+		return nil, err
+	}
+	return &commentv1.GetUserCommentsResponse{
+		Comments: c.toDTO(cs),
+	}, nil
+}
+
 func (c *CommentServiceServer) toDTO(domainComments []domain.Comment) []*commentv1.Comment {
 	rpcComments := make([]*commentv1.Comment, 0, len(domainComments))
 	for _, domainComment := range domainComments {
@@ -0,0 +1,39 @@
+package ioc
+
+import (
+	events2 "webook/comment/events"
+	"webook/internal/events"
+
+	"github.com/IBM/sarama"
+	"github.com/spf13/viper"
+)
+
+func InitSaramaClient() sarama.Client {
+	type Config struct {
+		Addr []string `yaml:"addr"`
+	}
+	var cfg Config
+	err := viper.UnmarshalKey("kafka", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	scfg := sarama.NewConfig()
+	client, err := sarama.NewClient(cfg.Addr, scfg)
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
+func InitSaramaSyncProducer(client sarama.Client) sarama.SyncProducer {
+	p, err := sarama.NewSyncProducerFromClient(client)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+func InitConsumers(c1 *events2.UserDeletedEventConsumer,
+	c2 *events2.UserMergedEventConsumer) []events.Consumer {
+	return []events.Consumer{c1, c2}
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"webook/internal/events"
 	"webook/pkg/grpcx"
 
 	"github.com/spf13/pflag"
@@ -10,6 +11,12 @@ import (
 func main() {
 	initViperV2Watch()
 	app := Init()
+	for _, c := range app.consumers {
+		err := c.Start()
+		if err != nil {
+			panic(err)
+		}
+	}
 	err := app.server.Serve()
 	if err != nil {
 		panic(err)
@@ -30,5 +37,6 @@ func initViperV2Watch() {
 }
 
 type App struct {
-	server *grpcx.Server
+	server    *grpcx.Server
+	consumers []events.Consumer
 }
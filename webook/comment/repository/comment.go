@@ -23,6 +23,10 @@ type CommentRepository interface {
 	// GetCommentByIds 获取单条评论 支持批量获取
 	GetCommentByIds(ctx context.Context, id []int64) ([]domain.Comment, error)
 	GetMoreReplies(ctx context.Context, rid int64, id int64, limit int64) ([]domain.Comment, error)
+	// FindByUid 查找某个用户发表的评论，按照 ID 倒序
+	FindByUid(ctx context.Context, uid, minID, limit int64) ([]domain.Comment, error)
+	AnonymizeByUid(ctx context.Context, uid int64, content string) error
+	UpdateUid(ctx context.Context, sourceUid int64, targetUid int64) error
 }
 
 type CachedCommentRepo struct {
@@ -42,6 +46,26 @@ func (c *CachedCommentRepo) GetMoreReplies(ctx context.Context, rid int64, maxID
 	return res, nil
 }
 
+func (c *CachedCommentRepo) FindByUid(ctx context.Context, uid, minID, limit int64) ([]domain.Comment, error) {
+	cs, err := c.dao.FindByUid(ctx, uid, minID, limit)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]domain.Comment, 0, len(cs))
+	for _, cm := range cs {
+		res = append(res, c.toDomain(cm))
+	}
+	return res, nil
+}
+
+func (c *CachedCommentRepo) AnonymizeByUid(ctx context.Context, uid int64, content string) error {
+	return c.dao.AnonymizeByUid(ctx, uid, content)
+}
+
+func (c *CachedCommentRepo) UpdateUid(ctx context.Context, sourceUid int64, targetUid int64) error {
+	return c.dao.UpdateUid(ctx, sourceUid, targetUid)
+}
+
 func (c *CachedCommentRepo) FindByBiz(ctx context.Context, biz string,
 	bizId, minID, limit int64) ([]domain.Comment, error) {
 	daoComments, err := c.dao.FindByBiz(ctx, biz, bizId, minID, limit)
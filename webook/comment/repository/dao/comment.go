@@ -3,6 +3,8 @@ package dao
 import (
 	"context"
 	"database/sql"
+	"time"
+
 	"gorm.io/gorm"
 )
 
@@ -22,6 +24,12 @@ type CommentDAO interface {
 	Delete(ctx context.Context, u Comment) error
 	FindOneByIDs(ctx context.Context, id []int64) ([]Comment, error)
 	FindRepliesByRid(ctx context.Context, rid int64, id int64, limit int64) ([]Comment, error)
+	// FindByUid 按照 ID 倒序查找某个用户发表的评论，minID 为 0 表示从头开始
+	FindByUid(ctx context.Context, uid, minID, limit int64) ([]Comment, error)
+	// AnonymizeByUid 用户注销之后把他的评论匿名化，保留评论树的结构
+	AnonymizeByUid(ctx context.Context, uid int64, content string) error
+	// UpdateUid 账号合并之后把 source 的评论转到 target 名下
+	UpdateUid(ctx context.Context, sourceUid int64, targetUid int64) error
 }
 
 type TreeBase struct {
@@ -36,7 +44,7 @@ type Comment struct {
 	Id int64 `gorm:"autoIncrement,primaryKey"`
 	// 发表评论的人
 	// 也就是说，如果你需要查询某个人发表的所有的评论，那么你需要在这里创建一个索引
-	Uid int64
+	Uid int64 `gorm:"index"`
 	// 被评价的东西
 	// 这里要不要建索引？
 	Biz     string `gorm:"index:biz_type_id"`
@@ -140,3 +148,33 @@ func (c *GORMCommentDAO) Delete(ctx context.Context, u Comment) error {
 		Id: u.Id,
 	}).Error
 }
+
+func (c *GORMCommentDAO) FindByUid(ctx context.Context, uid, minID, limit int64) ([]Comment, error) {
+	var res []Comment
+	builder := c.db.WithContext(ctx).Where("uid = ?", uid)
+	if minID > 0 {
+		builder = builder.Where("id < ?", minID)
+	}
+	err := builder.Order("id DESC").
+		Limit(int(limit)).Find(&res).Error
+	return res, err
+}
+
+func (c *GORMCommentDAO) UpdateUid(ctx context.Context, sourceUid int64, targetUid int64) error {
+	return c.db.WithContext(ctx).Model(&Comment{}).
+		Where("uid = ?", sourceUid).
+		Updates(map[string]any{
+			"uid":   targetUid,
+			"utime": time.Now().UnixMilli(),
+		}).Error
+}
+
+func (c *GORMCommentDAO) AnonymizeByUid(ctx context.Context, uid int64, content string) error {
+	return c.db.WithContext(ctx).Model(&Comment{}).
+		Where("uid = ?", uid).
+		Updates(map[string]any{
+			"uid":     0,
+			"content": content,
+			"utime":   time.Now().UnixMilli(),
+		}).Error
+}
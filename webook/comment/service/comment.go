@@ -15,8 +15,17 @@ type CommentService interface {
 	// CreateComment 创建评论
 	CreateComment(ctx context.Context, comment domain.Comment) error
 	GetMoreReplies(ctx context.Context, rid int64, maxID int64, limit int64) ([]domain.Comment, error)
+	// GetUserComments 获取某个用户发表的评论，用于导出用户数据
+	GetUserComments(ctx context.Context, uid, minID, limit int64) ([]domain.Comment, error)
+	// AnonymizeUserComments 用户注销之后，评论不删除，只是抹掉作者和内容
+	AnonymizeUserComments(ctx context.Context, uid int64) error
+	// MergeUserComments 账号合并之后把 source 的评论转到 target 名下
+	MergeUserComments(ctx context.Context, sourceUid int64, targetUid int64) error
 }
 
+// anonymizedContent 匿名化之后评论展示的内容
+const anonymizedContent = "该评论已删除"
+
 type commentService struct {
 	repo repository.CommentRepository
 }
@@ -27,6 +36,18 @@ func (c *commentService) GetMoreReplies(ctx context.Context,
 	return c.repo.GetMoreReplies(ctx, rid, maxID, limit)
 }
 
+func (c *commentService) GetUserComments(ctx context.Context, uid, minID, limit int64) ([]domain.Comment, error) {
+	return c.repo.FindByUid(ctx, uid, minID, limit)
+}
+
+func (c *commentService) AnonymizeUserComments(ctx context.Context, uid int64) error {
+	return c.repo.AnonymizeByUid(ctx, uid, anonymizedContent)
+}
+
+func (c *commentService) MergeUserComments(ctx context.Context, sourceUid int64, targetUid int64) error {
+	return c.repo.UpdateUid(ctx, sourceUid, targetUid)
+}
+
 func NewCommentSvc(repo repository.CommentRepository) CommentService {
 	return &commentService{
 		repo: repo,
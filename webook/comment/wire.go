@@ -3,6 +3,7 @@
 package main
 
 import (
+	"webook/comment/events"
 	grpc2 "webook/comment/grpc"
 	"webook/comment/ioc"
 	"webook/comment/repository"
@@ -17,11 +18,15 @@ var serviceProviderSet = wire.NewSet(
 	repository.NewCommentRepo,
 	service.NewCommentSvc,
 	grpc2.NewGrpcServer,
+	events.NewUserDeletedEventConsumer,
+	events.NewUserMergedEventConsumer,
 )
 
 var thirdProvider = wire.NewSet(
 	ioc.InitLogger,
 	ioc.InitDB,
+	ioc.InitSaramaClient,
+	ioc.InitSaramaSyncProducer,
 )
 
 func Init() *App {
@@ -29,6 +34,7 @@ func Init() *App {
 		thirdProvider,
 		serviceProviderSet,
 		ioc.InitGRPCxServer,
+		ioc.InitConsumers,
 		wire.Struct(new(App), "*"),
 	)
 	return new(App)
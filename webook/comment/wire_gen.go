@@ -1,18 +1,19 @@
 // Code generated by Wire. DO NOT EDIT.
 
-//go:generate go run github.com/google/wire/cmd/wire
+//go:generate go run -mod=mod github.com/google/wire/cmd/wire
 //go:build !wireinject
 // +build !wireinject
 
 package main
 
 import (
+	"github.com/google/wire"
+	"webook/comment/events"
 	"webook/comment/grpc"
 	"webook/comment/ioc"
 	"webook/comment/repository"
 	"webook/comment/repository/dao"
 	"webook/comment/service"
-	"github.com/google/wire"
 )
 
 // Injectors from wire.go:
@@ -21,18 +22,24 @@ func Init() *App {
 	loggerV1 := ioc.InitLogger()
 	db := ioc.InitDB(loggerV1)
 	commentDAO := dao.NewCommentDAO(db)
-	commentRepo := repository.NewCommentRepo(commentDAO)
-	commentService := service.NewCommentSvc(commentRepo)
+	commentRepository := repository.NewCommentRepo(commentDAO, loggerV1)
+	commentService := service.NewCommentSvc(commentRepository)
 	commentServiceServer := grpc.NewGrpcServer(commentService)
 	server := ioc.InitGRPCxServer(commentServiceServer)
+	client := ioc.InitSaramaClient()
+	syncProducer := ioc.InitSaramaSyncProducer(client)
+	userDeletedEventConsumer := events.NewUserDeletedEventConsumer(client, syncProducer, loggerV1, commentService)
+	userMergedEventConsumer := events.NewUserMergedEventConsumer(client, syncProducer, loggerV1, commentService)
+	v := ioc.InitConsumers(userDeletedEventConsumer, userMergedEventConsumer)
 	app := &App{
-		server: server,
+		server:    server,
+		consumers: v,
 	}
 	return app
 }
 
 // wire.go:
 
-var serviceProviderSet = wire.NewSet(dao.NewCommentDAO, repository.NewCommentRepo, service.NewCommentSvc, grpc.NewGrpcServer)
+var serviceProviderSet = wire.NewSet(dao.NewCommentDAO, repository.NewCommentRepo, service.NewCommentSvc, grpc.NewGrpcServer, events.NewUserDeletedEventConsumer, events.NewUserMergedEventConsumer)
 
-var thirdProvider = wire.NewSet(ioc.InitLogger, ioc.InitDB)
+var thirdProvider = wire.NewSet(ioc.InitLogger, ioc.InitDB, ioc.InitSaramaClient, ioc.InitSaramaSyncProducer)
@@ -0,0 +1,64 @@
+package events
+
+import (
+	"context"
+	"time"
+	"webook/follow/service"
+	"webook/pkg/logger"
+	"webook/pkg/saramax"
+
+	"github.com/IBM/sarama"
+)
+
+const TopicUserDeleted = "user_deleted"
+
+type UserDeletedEvent struct {
+	Uid int64
+}
+
+// UserDeletedEventConsumer 用户注销之后删除他的关注关系
+type UserDeletedEventConsumer struct {
+	client   sarama.Client
+	producer sarama.SyncProducer
+	svc      service.FollowRelationService
+	l        logger.LoggerV1
+}
+
+// NewUserDeletedEventConsumer producer 用来投递死信队列
+func NewUserDeletedEventConsumer(
+	client sarama.Client,
+	producer sarama.SyncProducer,
+	l logger.LoggerV1,
+	svc service.FollowRelationService) *UserDeletedEventConsumer {
+	return &UserDeletedEventConsumer{
+		client:   client,
+		producer: producer,
+		l:        l,
+		svc:      svc,
+	}
+}
+
+func (c *UserDeletedEventConsumer) Start() error {
+	cg, err := sarama.NewConsumerGroupFromClient("follow_user_deleted",
+		c.client)
+	if err != nil {
+		return err
+	}
+	go func() {
+		err := cg.Consume(context.Background(),
+			[]string{TopicUserDeleted},
+			saramax.NewHandler(c.l, c.Consume,
+				saramax.WithDeadLetter(saramax.NewDeadLetter(c.producer, ""))))
+		if err != nil {
+			c.l.Error("退出了消费循环异常", logger.Error(err))
+		}
+	}()
+	return err
+}
+
+// Consume 是幂等的，重复消费的时候已经没有数据可以删了
+func (c *UserDeletedEventConsumer) Consume(msg *sarama.ConsumerMessage, evt UserDeletedEvent) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	return c.svc.DeleteUserRelations(ctx, evt.Uid)
+}
@@ -0,0 +1,65 @@
+package events
+
+import (
+	"context"
+	"time"
+	"webook/follow/service"
+	"webook/pkg/logger"
+	"webook/pkg/saramax"
+
+	"github.com/IBM/sarama"
+)
+
+const TopicUserMerged = "user_merged"
+
+type UserMergedEvent struct {
+	SourceUid int64
+	TargetUid int64
+}
+
+// UserMergedEventConsumer 账号合并之后把被合并账号的关注关系转过来
+type UserMergedEventConsumer struct {
+	client   sarama.Client
+	producer sarama.SyncProducer
+	svc      service.FollowRelationService
+	l        logger.LoggerV1
+}
+
+// NewUserMergedEventConsumer producer 用来投递死信队列
+func NewUserMergedEventConsumer(
+	client sarama.Client,
+	producer sarama.SyncProducer,
+	l logger.LoggerV1,
+	svc service.FollowRelationService) *UserMergedEventConsumer {
+	return &UserMergedEventConsumer{
+		client:   client,
+		producer: producer,
+		l:        l,
+		svc:      svc,
+	}
+}
+
+func (c *UserMergedEventConsumer) Start() error {
+	cg, err := sarama.NewConsumerGroupFromClient("follow_user_merged",
+		c.client)
+	if err != nil {
+		return err
+	}
+	go func() {
+		err := cg.Consume(context.Background(),
+			[]string{TopicUserMerged},
+			saramax.NewHandler(c.l, c.Consume,
+				saramax.WithDeadLetter(saramax.NewDeadLetter(c.producer, ""))))
+		if err != nil {
+			c.l.Error("退出了消费循环异常", logger.Error(err))
+		}
+	}()
+	return err
+}
+
+// Consume 是幂等的，重复消费的时候 source 已经没有关注关系了
+func (c *UserMergedEventConsumer) Consume(msg *sarama.ConsumerMessage, evt UserMergedEvent) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	return c.svc.MergeUserRelations(ctx, evt.SourceUid, evt.TargetUid)
+}
@@ -0,0 +1,13 @@
+package ioc
+
+import (
+	"github.com/redis/go-redis/v9"
+	"github.com/spf13/viper"
+)
+
+func InitRedis() redis.Cmdable {
+	// 这个是假设你有一个独立的 Redis 的配置文件
+	return redis.NewClient(&redis.Options{
+		Addr: viper.GetString("redis.addr"),
+	})
+}
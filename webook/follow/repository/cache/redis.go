@@ -58,6 +58,17 @@ func (r *RedisFollowCache) SetStaticsInfo(ctx context.Context, uid int64, static
 	return r.client.HMSet(ctx, r.staticsKey(uid), fieldFollowerCnt, statics.Followers, fieldFolloweeCnt, statics.Followees).Err()
 }
 
+func (r *RedisFollowCache) DelStaticsInfo(ctx context.Context, uids ...int64) error {
+	if len(uids) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(uids))
+	for _, uid := range uids {
+		keys = append(keys, r.staticsKey(uid))
+	}
+	return r.client.Del(ctx, keys...).Err()
+}
+
 func (r *RedisFollowCache) staticsKey(uid int64) string {
 	return fmt.Sprintf("follow:statics:%d", uid)
 }
@@ -10,4 +10,6 @@ type FollowCache interface {
 	SetStaticsInfo(ctx context.Context, uid int64, statics domain.FollowStatics) error
 	Follow(ctx context.Context, follower, followee int64) error
 	CancelFollow(ctx context.Context, follower, followee int64) error
+	// DelStaticsInfo 删除计数缓存，下一次查询的时候会从数据库里重新加载
+	DelStaticsInfo(ctx context.Context, uids ...int64) error
 }
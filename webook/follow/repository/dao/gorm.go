@@ -76,6 +76,95 @@ func (g *GORMFollowRelationDAO) CreateFollowRelation(ctx context.Context, f Foll
 	// 在这里更新 FollowStatis 的计数（也是 upsert）
 }
 
+func (g *GORMFollowRelationDAO) DeleteByUid(ctx context.Context, uid int64) ([]int64, error) {
+	var uids []int64
+	err := g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var frs []FollowRelation
+		// 注销的用户不会再有新的关注关系，所以这里一次性查出来就可以
+		err := tx.Where("follower = ? OR followee = ?", uid, uid).
+			Find(&frs).Error
+		if err != nil {
+			return err
+		}
+		for _, fr := range frs {
+			if fr.Follower == uid {
+				uids = append(uids, fr.Followee)
+			} else {
+				uids = append(uids, fr.Follower)
+			}
+		}
+		return tx.Where("follower = ? OR followee = ?", uid, uid).
+			Delete(&FollowRelation{}).Error
+	})
+	return uids, err
+}
+
+func (g *GORMFollowRelationDAO) MergeUid(ctx context.Context, sourceUid int64, targetUid int64) ([]int64, error) {
+	var uids []int64
+	now := time.Now().UnixMilli()
+	err := g.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var frs []FollowRelation
+		err := tx.Where("follower = ? OR followee = ?", sourceUid, sourceUid).
+			Find(&frs).Error
+		if err != nil {
+			return err
+		}
+		for _, fr := range frs {
+			follower, followee := fr.Follower, fr.Followee
+			if follower == sourceUid {
+				follower = targetUid
+				uids = append(uids, fr.Followee)
+			}
+			if followee == sourceUid {
+				followee = targetUid
+				uids = append(uids, fr.Follower)
+			}
+			if follower == followee {
+				// 合并之前两个账号之间的关注，合并之后就是自己关注自己了
+				err = tx.Where("id = ?", fr.ID).Delete(&FollowRelation{}).Error
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			var existing FollowRelation
+			err = tx.Where("follower = ? AND followee = ?", follower, followee).
+				First(&existing).Error
+			switch {
+			case err == gorm.ErrRecordNotFound:
+				err = tx.Model(&FollowRelation{}).Where("id = ?", fr.ID).
+					Updates(map[string]any{
+						"follower": follower,
+						"followee": followee,
+						"utime":    now,
+					}).Error
+				if err != nil {
+					return err
+				}
+				continue
+			case err != nil:
+				return err
+			case fr.Status == FollowRelationStatusActive &&
+				existing.Status != FollowRelationStatusActive:
+				err = tx.Model(&FollowRelation{}).Where("id = ?", existing.ID).
+					Updates(map[string]any{
+						"status": FollowRelationStatusActive,
+						"utime":  now,
+					}).Error
+				if err != nil {
+					return err
+				}
+			}
+			err = tx.Where("id = ?", fr.ID).Delete(&FollowRelation{}).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return uids, err
+}
+
 func NewGORMFollowRelationDAO(db *gorm.DB) FollowRelationDao {
 	return &GORMFollowRelationDAO{
 		db: db,
@@ -45,6 +45,12 @@ type FollowRelationDao interface {
 	CntFollower(ctx context.Context, uid int64) (int64, error)
 	// CntFollowee 统计自己关注了多少人
 	CntFollowee(ctx context.Context, uid int64) (int64, error)
+	// DeleteByUid 删除和 uid 相关的所有关注关系，包括他关注的和关注他的
+	// 返回受到影响的另一方的 uid，方便上层处理缓存
+	DeleteByUid(ctx context.Context, uid int64) ([]int64, error)
+	// MergeUid 把 source 的关注关系转到 target 上，重复的和自己关注自己的直接删掉，
+	// 返回受到影响的另一方的 uid
+	MergeUid(ctx context.Context, sourceUid int64, targetUid int64) ([]int64, error)
 }
 
 // UserRelation 另外一种设计方案，但是不要这么做
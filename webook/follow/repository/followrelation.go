@@ -18,6 +18,10 @@ type FollowRepository interface {
 	// InactiveFollowRelation 取消关注
 	InactiveFollowRelation(ctx context.Context, follower int64, followee int64) error
 	GetFollowStatics(ctx context.Context, uid int64) (domain.FollowStatics, error)
+	// DeleteByUid 删除用户所有的关注关系，用户注销的时候使用
+	DeleteByUid(ctx context.Context, uid int64) error
+	// MergeUid 账号合并之后把 source 的关注关系转到 target 上
+	MergeUid(ctx context.Context, sourceUid int64, targetUid int64) error
 }
 
 type CachedRelationRepository struct {
@@ -48,6 +52,36 @@ func (d *CachedRelationRepository) GetFollowStatics(ctx context.Context, uid int
 	return res, nil
 }
 
+func (d *CachedRelationRepository) DeleteByUid(ctx context.Context, uid int64) error {
+	uids, err := d.dao.DeleteByUid(ctx, uid)
+	if err != nil {
+		return err
+	}
+	// 计数的缓存不好逐个 -1，直接删掉就可以
+	err = d.cache.DelStaticsInfo(ctx, append(uids, uid)...)
+	if err != nil {
+		d.l.Error("删除关注计数缓存失败",
+			logger.Int64("uid", uid),
+			logger.Error(err))
+	}
+	return nil
+}
+
+func (d *CachedRelationRepository) MergeUid(ctx context.Context, sourceUid int64, targetUid int64) error {
+	uids, err := d.dao.MergeUid(ctx, sourceUid, targetUid)
+	if err != nil {
+		return err
+	}
+	err = d.cache.DelStaticsInfo(ctx, append(uids, sourceUid, targetUid)...)
+	if err != nil {
+		d.l.Error("删除关注计数缓存失败",
+			logger.Int64("source", sourceUid),
+			logger.Int64("target", targetUid),
+			logger.Error(err))
+	}
+	return nil
+}
+
 func (d *CachedRelationRepository) InactiveFollowRelation(ctx context.Context, follower int64, followee int64) error {
 	err := d.dao.UpdateStatus(ctx, followee, follower, dao.FollowRelationStatusInactive)
 	if err != nil {
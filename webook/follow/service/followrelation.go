@@ -12,6 +12,10 @@ type FollowRelationService interface {
 		follower, followee int64) (domain.FollowRelation, error)
 	Follow(ctx context.Context, follower, followee int64) error
 	CancelFollow(ctx context.Context, follower, followee int64) error
+	// DeleteUserRelations 用户注销之后删除他的关注关系
+	DeleteUserRelations(ctx context.Context, uid int64) error
+	// MergeUserRelations 账号合并之后把 source 的关注关系转到 target 上
+	MergeUserRelations(ctx context.Context, sourceUid int64, targetUid int64) error
 }
 
 type followRelationService struct {
@@ -22,6 +26,14 @@ func (f *followRelationService) CancelFollow(ctx context.Context, follower, foll
 	return f.repo.InactiveFollowRelation(ctx, follower, followee)
 }
 
+func (f *followRelationService) DeleteUserRelations(ctx context.Context, uid int64) error {
+	return f.repo.DeleteByUid(ctx, uid)
+}
+
+func (f *followRelationService) MergeUserRelations(ctx context.Context, sourceUid int64, targetUid int64) error {
+	return f.repo.MergeUid(ctx, sourceUid, targetUid)
+}
+
 func NewFollowRelationService(repo repository.FollowRepository) FollowRelationService {
 	return &followRelationService{
 		repo: repo,
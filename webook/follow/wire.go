@@ -3,9 +3,11 @@
 package main
 
 import (
+	"webook/follow/events"
 	grpc2 "webook/follow/grpc"
 	"webook/follow/ioc"
 	"webook/follow/repository"
+	"webook/follow/repository/cache"
 	"webook/follow/repository/dao"
 	"webook/follow/service"
 
@@ -14,14 +16,20 @@ import (
 
 var serviceProviderSet = wire.NewSet(
 	dao.NewGORMFollowRelationDAO,
+	cache.NewRedisFollowCache,
 	repository.NewFollowRelationRepository,
 	service.NewFollowRelationService,
 	grpc2.NewFollowRelationServiceServer,
+	events.NewUserDeletedEventConsumer,
+	events.NewUserMergedEventConsumer,
 )
 
 var thirdProvider = wire.NewSet(
 	ioc.InitDB,
 	ioc.InitLogger,
+	ioc.InitRedis,
+	ioc.InitSaramaClient,
+	ioc.InitSaramaSyncProducer,
 )
 
 func Init() *App {
@@ -29,6 +37,7 @@ func Init() *App {
 		thirdProvider,
 		serviceProviderSet,
 		ioc.InitGRPCxServer,
+		ioc.InitConsumers,
 		wire.Struct(new(App), "*"),
 	)
 	return new(App)
@@ -1,18 +1,20 @@
 // Code generated by Wire. DO NOT EDIT.
 
-//go:generate go run github.com/google/wire/cmd/wire
+//go:generate go run -mod=mod github.com/google/wire/cmd/wire
 //go:build !wireinject
 // +build !wireinject
 
 package main
 
 import (
+	"github.com/google/wire"
+	"webook/follow/events"
 	"webook/follow/grpc"
 	"webook/follow/ioc"
 	"webook/follow/repository"
+	"webook/follow/repository/cache"
 	"webook/follow/repository/dao"
 	"webook/follow/service"
-	"github.com/google/wire"
 )
 
 // Injectors from wire.go:
@@ -21,18 +23,26 @@ func Init() *App {
 	loggerV1 := ioc.InitLogger()
 	db := ioc.InitDB(loggerV1)
 	followRelationDao := dao.NewGORMFollowRelationDAO(db)
-	followRelationRepository := repository.NewFollowRelationRepository(followRelationDao)
-	followRelationService := service.NewFollowRelationService(followRelationRepository)
-	followRelationServiceServer := grpc.NewFollowRelationServiceServer(followRelationService)
-	server := ioc.InitGRPCxServer(followRelationServiceServer)
+	cmdable := ioc.InitRedis()
+	followCache := cache.NewRedisFollowCache(cmdable)
+	followRepository := repository.NewFollowRelationRepository(followRelationDao, followCache, loggerV1)
+	followRelationService := service.NewFollowRelationService(followRepository)
+	followServiceServer := grpc.NewFollowRelationServiceServer(followRelationService)
+	server := ioc.InitGRPCxServer(followServiceServer)
+	client := ioc.InitSaramaClient()
+	syncProducer := ioc.InitSaramaSyncProducer(client)
+	userDeletedEventConsumer := events.NewUserDeletedEventConsumer(client, syncProducer, loggerV1, followRelationService)
+	userMergedEventConsumer := events.NewUserMergedEventConsumer(client, syncProducer, loggerV1, followRelationService)
+	v := ioc.InitConsumers(userDeletedEventConsumer, userMergedEventConsumer)
 	app := &App{
-		server: server,
+		server:    server,
+		consumers: v,
 	}
 	return app
 }
 
 // wire.go:
 
-var serviceProviderSet = wire.NewSet(dao.NewGORMFollowRelationDAO, repository.NewFollowRelationRepository, service.NewFollowRelationService, grpc.NewFollowRelationServiceServer)
+var serviceProviderSet = wire.NewSet(dao.NewGORMFollowRelationDAO, cache.NewRedisFollowCache, repository.NewFollowRelationRepository, service.NewFollowRelationService, grpc.NewFollowRelationServiceServer, events.NewUserDeletedEventConsumer, events.NewUserMergedEventConsumer)
 
-var thirdProvider = wire.NewSet(ioc.InitDB, ioc.InitLogger)
+var thirdProvider = wire.NewSet(ioc.InitDB, ioc.InitLogger, ioc.InitRedis, ioc.InitSaramaClient, ioc.InitSaramaSyncProducer)
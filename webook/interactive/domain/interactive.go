@@ -1,5 +1,7 @@
 package domain
 
+import "time"
+
 // Interactive 这个是总体交互的计数
 type Interactive struct {
 	Biz        string `json:"biz"`
@@ -7,8 +9,59 @@ type Interactive struct {
 	ReadCnt    int64  `json:"read_cnt"`
 	LikeCnt    int64  `json:"like_cnt"`
 	CollectCnt int64  `json:"collect_cnt"`
+	// UvCnt 去重之后的读者数，TodayUvCnt 是今天的，都是 HyperLogLog 估算出来的
+	UvCnt      int64 `json:"uv_cnt"`
+	TodayUvCnt int64 `json:"today_uv_cnt"`
 	// 这个是当下这个资源，你有没有点赞或者收集
 	// 你也可以考虑把这两个字段分离出去，作为一个单独的结构体
 	Liked     bool `json:"liked"`
 	Collected bool `json:"collected"`
 }
+
+// UserLike 用户点过赞的资源
+type UserLike struct {
+	Biz   string
+	BizId int64
+	Ctime time.Time
+}
+
+// UserCollection 用户收藏过的资源
+type UserCollection struct {
+	Biz   string
+	BizId int64
+	// Cid 收藏夹 ID
+	Cid   int64
+	Ctime time.Time
+}
+
+type CollectionVisibility uint8
+
+const (
+	// CollectionVisibilityUnknown 为了避免零值之类的问题
+	CollectionVisibilityUnknown CollectionVisibility = iota
+	// CollectionVisibilityPrivate 只有自己能看到
+	CollectionVisibilityPrivate
+	// CollectionVisibilityPublic 别人也能看到
+	CollectionVisibilityPublic
+)
+
+func (v CollectionVisibility) ToUint8() uint8 {
+	return uint8(v)
+}
+
+func (v CollectionVisibility) Valid() bool {
+	return v == CollectionVisibilityPrivate || v == CollectionVisibilityPublic
+}
+
+// Collection 收藏夹。Id 为 0 的是默认收藏夹，不需要创建
+type Collection struct {
+	Id          int64
+	Uid         int64
+	Name        string
+	Description string
+	Visibility  CollectionVisibility
+	// ItemCnt 收藏夹里面有多少东西
+	ItemCnt int64
+	Ctime   time.Time
+	Utime   time.Time
+}
@@ -2,6 +2,7 @@ package events
 
 import (
 	"context"
+	"sort"
 	"webook/interactive/repository"
 	"webook/pkg/logger"
 	"webook/pkg/saramax"
@@ -13,25 +14,37 @@ import (
 
 const TopicReadEvent = "article_read"
 
+const (
+	// 阅读事件攒够一批或者等一秒之后合并起来更新
+	readEventBatchSize     = 100
+	readEventBatchDuration = time.Second
+)
+
 type ReadEvent struct {
 	Aid int64
 	Uid int64
+	// Ctime 阅读的时间，以前的消息没有这个字段
+	Ctime int64
 }
 
 type InteractiveReadEventConsumer struct {
-	client sarama.Client
-	repo   repository.InteractiveRepository
-	l      logger.LoggerV1
+	client   sarama.Client
+	producer sarama.SyncProducer
+	repo     repository.InteractiveRepository
+	l        logger.LoggerV1
 }
 
+// NewInteractiveReadEventConsumer producer 用来投递死信队列
 func NewInteractiveReadEventConsumer(
 	client sarama.Client,
+	producer sarama.SyncProducer,
 	l logger.LoggerV1,
 	repo repository.InteractiveRepository) *InteractiveReadEventConsumer {
 	return &InteractiveReadEventConsumer{
-		client: client,
-		l:      l,
-		repo:   repo,
+		client:   client,
+		producer: producer,
+		l:        l,
+		repo:     repo,
 	}
 }
 
@@ -44,7 +57,8 @@ func (r *InteractiveReadEventConsumer) Start() error {
 	go func() {
 		err := cg.Consume(context.Background(),
 			[]string{TopicReadEvent},
-			saramax.NewHandler(r.l, r.Consume))
+			saramax.NewBatchHandler(r.l, readEventBatchSize, readEventBatchDuration, r.BatchConsume,
+				saramax.WithDeadLetter(saramax.NewDeadLetter(r.producer, ""))))
 		if err != nil {
 			r.l.Error("退出了消费循环异常", logger.Error(err))
 		}
@@ -52,6 +66,75 @@ func (r *InteractiveReadEventConsumer) Start() error {
 	return err
 }
 
+// BatchConsume 把同一篇文章的阅读次数合并起来，一次更新数据库和缓存，
+// 同时记录读者用来统计 UV。阅读数和 Consume 一样不是幂等的，UV 是幂等的
+func (r *InteractiveReadEventConsumer) BatchConsume(msgs []*sarama.ConsumerMessage, ts []ReadEvent) error {
+	cnts := make(map[int64]int64, len(ts))
+	// 一批消息可能跨天，读者按照阅读的那一天分开记
+	readers := make(map[time.Time]map[int64][]int64, 1)
+	for _, evt := range ts {
+		cnts[evt.Aid]++
+		if evt.Uid > 0 {
+			day := readDay(evt.Ctime)
+			if readers[day] == nil {
+				readers[day] = make(map[int64][]int64, len(ts))
+			}
+			readers[day][evt.Aid] = append(readers[day][evt.Aid], evt.Uid)
+		}
+	}
+	bizs, bizIds := sortedBizIds(cnts)
+	deltas := make([]int64, 0, len(bizIds))
+	for _, aid := range bizIds {
+		deltas = append(deltas, cnts[aid])
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	err := r.repo.IncrReadCntBatch(ctx, bizs, bizIds, deltas)
+	if err != nil {
+		return err
+	}
+	// 阅读数已经加上去了，这里返回 error 会导致整批重新消费，阅读数就重复了。
+	// UV 不准一点没有关系，记录日志就可以
+	for day, dayReaders := range readers {
+		uvBizs, uvBizIds := sortedBizIds(dayReaders)
+		uids := make([][]int64, 0, len(uvBizIds))
+		for _, aid := range uvBizIds {
+			uids = append(uids, dayReaders[aid])
+		}
+		err = r.repo.AddUvBatch(ctx, uvBizs, uvBizIds, uids, day)
+		if err != nil {
+			r.l.Error("记录 UV 失败", logger.Error(err))
+		}
+	}
+	return nil
+}
+
+// sortedBizIds 按照 ID 排序，几个消费者同时更新的时候加锁的顺序一样，避免死锁
+func sortedBizIds[V any](m map[int64]V) ([]string, []int64) {
+	bizIds := make([]int64, 0, len(m))
+	for aid := range m {
+		bizIds = append(bizIds, aid)
+	}
+	sort.Slice(bizIds, func(i, j int) bool {
+		return bizIds[i] < bizIds[j]
+	})
+	bizs := make([]string, 0, len(bizIds))
+	for range bizIds {
+		bizs = append(bizs, "article")
+	}
+	return bizs, bizIds
+}
+
+// readDay 以前的消息没有阅读时间，只能当作是今天读的
+func readDay(ctime int64) time.Time {
+	t := time.Now()
+	if ctime > 0 {
+		t = time.UnixMilli(ctime)
+	}
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
 // Consume 这个不是幂等的
 func (r *InteractiveReadEventConsumer) Consume(msg *sarama.ConsumerMessage, t ReadEvent) error {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
@@ -0,0 +1,82 @@
+package events
+
+import (
+	"errors"
+	"testing"
+	"time"
+	"webook/interactive/repository"
+	repomocks "webook/interactive/repository/mocks"
+	"webook/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestInteractiveReadEventConsumer_BatchConsume(t *testing.T) {
+	// 23:59 读的，第二天才消费，也要记到前一天
+	yesterday := time.Date(2024, 3, 1, 0, 0, 0, 0, time.Local)
+	today := yesterday.Add(time.Hour * 24)
+	lastNight := yesterday.Add(time.Hour*24 - time.Minute).UnixMilli()
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) repository.InteractiveRepository
+		evts []ReadEvent
+
+		wantErr error
+	}{
+		{
+			name: "合并阅读数，UV 按照阅读的那一天分开记",
+			mock: func(ctrl *gomock.Controller) repository.InteractiveRepository {
+				repo := repomocks.NewMockInteractiveRepository(ctrl)
+				repo.EXPECT().IncrReadCntBatch(gomock.Any(), []string{"article", "article"},
+					[]int64{1, 2}, []int64{3, 1}).Return(nil)
+				repo.EXPECT().AddUvBatch(gomock.Any(), []string{"article", "article"},
+					[]int64{1, 2}, [][]int64{{11}, {12}}, yesterday).Return(nil)
+				repo.EXPECT().AddUvBatch(gomock.Any(), []string{"article"},
+					[]int64{1}, [][]int64{{13}}, today).Return(nil)
+				return repo
+			},
+			evts: []ReadEvent{
+				{Aid: 2, Uid: 12, Ctime: lastNight},
+				{Aid: 1, Uid: 11, Ctime: lastNight},
+				{Aid: 1, Uid: 13, Ctime: today.Add(time.Minute).UnixMilli()},
+				// 没有登录的只算阅读数
+				{Aid: 1, Ctime: lastNight},
+			},
+		},
+		{
+			name: "记录 UV 失败不影响阅读数",
+			mock: func(ctrl *gomock.Controller) repository.InteractiveRepository {
+				repo := repomocks.NewMockInteractiveRepository(ctrl)
+				repo.EXPECT().IncrReadCntBatch(gomock.Any(), []string{"article"},
+					[]int64{1}, []int64{1}).Return(nil)
+				repo.EXPECT().AddUvBatch(gomock.Any(), []string{"article"},
+					[]int64{1}, [][]int64{{11}}, yesterday).Return(errors.New("mock redis 错误"))
+				return repo
+			},
+			evts: []ReadEvent{{Aid: 1, Uid: 11, Ctime: lastNight}},
+		},
+		{
+			name: "更新阅读数失败，不记 UV",
+			mock: func(ctrl *gomock.Controller) repository.InteractiveRepository {
+				repo := repomocks.NewMockInteractiveRepository(ctrl)
+				repo.EXPECT().IncrReadCntBatch(gomock.Any(), []string{"article"},
+					[]int64{1}, []int64{1}).Return(errors.New("mock db 错误"))
+				return repo
+			},
+			evts:    []ReadEvent{{Aid: 1, Uid: 11, Ctime: lastNight}},
+			wantErr: errors.New("mock db 错误"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			c := NewInteractiveReadEventConsumer(nil, nil, logger.NewNopLogger(), tc.mock(ctrl))
+			err := c.BatchConsume(nil, tc.evts)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
@@ -7,15 +7,19 @@ import (
 	"webook/interactive/service"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type InteractiveServiceServer struct {
 	intrv1.UnimplementedInteractiveServiceServer
-	svc service.InteractiveService
+	svc  service.InteractiveService
+	csvc service.CollectionService
 }
 
-func NewInteractiveServiceServer(svc service.InteractiveService) *InteractiveServiceServer {
-	return &InteractiveServiceServer{svc: svc}
+func NewInteractiveServiceServer(svc service.InteractiveService,
+	csvc service.CollectionService) *InteractiveServiceServer {
+	return &InteractiveServiceServer{svc: svc, csvc: csvc}
 }
 
 func (i *InteractiveServiceServer) Register(s *grpc.Server) {
@@ -40,7 +44,12 @@ func (i *InteractiveServiceServer) CancelLike(ctx context.Context, request *intr
 func (i *InteractiveServiceServer) Collect(ctx context.Context, request *intrv1.CollectRequest) (*intrv1.CollectResponse, error) {
 	err := i.svc.Collect(ctx, request.GetBiz(), request.GetBizId(),
 		request.GetCid(), request.GetUid())
-	return &intrv1.CollectResponse{}, err
+	return &intrv1.CollectResponse{}, ToStatus(err)
+}
+
+func (i *InteractiveServiceServer) CancelCollect(ctx context.Context, request *intrv1.CancelCollectRequest) (*intrv1.CancelCollectResponse, error) {
+	err := i.svc.CancelCollect(ctx, request.GetBiz(), request.GetBizId(), request.GetUid())
+	return &intrv1.CancelCollectResponse{}, err
 }
 
 func (i *InteractiveServiceServer) Get(ctx context.Context, request *intrv1.GetRequest) (*intrv1.GetResponse, error) {
@@ -68,6 +77,94 @@ func (i *InteractiveServiceServer) GetByIds(ctx context.Context, request *intrv1
 	}, nil
 }
 
+func (i *InteractiveServiceServer) GetUserData(ctx context.Context, request *intrv1.GetUserDataRequest) (*intrv1.GetUserDataResponse, error) {
+	likes, collections, err := i.svc.GetUserData(ctx, request.GetUid())
+	if err != nil {
+		return nil, err
+	}
+	return &intrv1.GetUserDataResponse{
+		Likes:       toUserLikeDTOs(likes),
+		Collections: toUserCollectionDTOs(collections),
+	}, nil
+}
+
+func (i *InteractiveServiceServer) ListUserLikes(ctx context.Context, request *intrv1.ListUserLikesRequest) (*intrv1.ListUserLikesResponse, error) {
+	likes, err := i.svc.ListUserLikes(ctx, request.GetUid(),
+		int(request.GetOffset()), int(request.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+	return &intrv1.ListUserLikesResponse{
+		Likes: toUserLikeDTOs(likes),
+	}, nil
+}
+
+func (i *InteractiveServiceServer) ListUserCollections(ctx context.Context, request *intrv1.ListUserCollectionsRequest) (*intrv1.ListUserCollectionsResponse, error) {
+	collections, err := i.svc.ListUserCollections(ctx, request.GetUid(),
+		int(request.GetOffset()), int(request.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+	return &intrv1.ListUserCollectionsResponse{
+		Collections: toUserCollectionDTOs(collections),
+	}, nil
+}
+
+func (i *InteractiveServiceServer) SaveCollection(ctx context.Context, request *intrv1.SaveCollectionRequest) (*intrv1.SaveCollectionResponse, error) {
+	id, err := i.csvc.Save(ctx, toCollectionDomain(request.GetCollection()))
+	if err != nil {
+		return nil, ToStatus(err)
+	}
+	return &intrv1.SaveCollectionResponse{Id: id}, nil
+}
+
+func (i *InteractiveServiceServer) DeleteCollection(ctx context.Context, request *intrv1.DeleteCollectionRequest) (*intrv1.DeleteCollectionResponse, error) {
+	err := i.csvc.Delete(ctx, request.GetUid(), request.GetId())
+	return &intrv1.DeleteCollectionResponse{}, ToStatus(err)
+}
+
+func (i *InteractiveServiceServer) GetCollection(ctx context.Context, request *intrv1.GetCollectionRequest) (*intrv1.GetCollectionResponse, error) {
+	c, err := i.csvc.Get(ctx, request.GetId(), request.GetViewer())
+	if err != nil {
+		return nil, ToStatus(err)
+	}
+	return &intrv1.GetCollectionResponse{
+		Collection: toCollectionDTO(c),
+	}, nil
+}
+
+func (i *InteractiveServiceServer) ListCollections(ctx context.Context, request *intrv1.ListCollectionsRequest) (*intrv1.ListCollectionsResponse, error) {
+	cs, err := i.csvc.List(ctx, request.GetUid(), request.GetViewer(),
+		int(request.GetOffset()), int(request.GetLimit()))
+	if err != nil {
+		return nil, ToStatus(err)
+	}
+	res := make([]*intrv1.Collection, 0, len(cs))
+	for _, c := range cs {
+		res = append(res, toCollectionDTO(c))
+	}
+	return &intrv1.ListCollectionsResponse{
+		Collections: res,
+	}, nil
+}
+
+func (i *InteractiveServiceServer) ListCollectionItems(ctx context.Context, request *intrv1.ListCollectionItemsRequest) (*intrv1.ListCollectionItemsResponse, error) {
+	items, err := i.csvc.ListItems(ctx, request.GetUid(), request.GetCid(),
+		request.GetViewer(), int(request.GetOffset()), int(request.GetLimit()))
+	if err != nil {
+		return nil, ToStatus(err)
+	}
+	return &intrv1.ListCollectionItemsResponse{
+		Items: toUserCollectionDTOs(items),
+	}, nil
+}
+
+func (i *InteractiveServiceServer) MoveCollectionItem(ctx context.Context, request *intrv1.MoveCollectionItemRequest) (*intrv1.MoveCollectionItemResponse, error) {
+	err := i.csvc.MoveItem(ctx, request.GetBiz(), request.GetBizId(),
+		request.GetUid(), request.GetCid())
+	return &intrv1.MoveCollectionItemResponse{}, ToStatus(err)
+}
+
 func (i *InteractiveServiceServer) toDTO(intr domain.Interactive) *intrv1.Interactive {
 	return &intrv1.Interactive{
 		Biz:        intr.Biz,
@@ -77,5 +174,68 @@ func (i *InteractiveServiceServer) toDTO(intr domain.Interactive) *intrv1.Intera
 		Collected:  intr.Collected,
 		Liked:      intr.Liked,
 		LikeCnt:    intr.LikeCnt,
+		UvCnt:      intr.UvCnt,
+		TodayUvCnt: intr.TodayUvCnt,
+	}
+}
+
+func toUserLikeDTOs(likes []domain.UserLike) []*intrv1.UserLike {
+	res := make([]*intrv1.UserLike, 0, len(likes))
+	for _, l := range likes {
+		res = append(res, &intrv1.UserLike{
+			Biz:   l.Biz,
+			BizId: l.BizId,
+			Ctime: l.Ctime.UnixMilli(),
+		})
+	}
+	return res
+}
+
+func toUserCollectionDTOs(collections []domain.UserCollection) []*intrv1.UserCollection {
+	res := make([]*intrv1.UserCollection, 0, len(collections))
+	for _, c := range collections {
+		res = append(res, &intrv1.UserCollection{
+			Biz:   c.Biz,
+			BizId: c.BizId,
+			Cid:   c.Cid,
+			Ctime: c.Ctime.UnixMilli(),
+		})
+	}
+	return res
+}
+
+func toCollectionDTO(c domain.Collection) *intrv1.Collection {
+	return &intrv1.Collection{
+		Id:          c.Id,
+		Uid:         c.Uid,
+		Name:        c.Name,
+		Description: c.Description,
+		Visibility:  int32(c.Visibility),
+		ItemCnt:     c.ItemCnt,
+		Ctime:       c.Ctime.UnixMilli(),
+		Utime:       c.Utime.UnixMilli(),
+	}
+}
+
+func toCollectionDomain(c *intrv1.Collection) domain.Collection {
+	return domain.Collection{
+		Id:          c.GetId(),
+		Uid:         c.GetUid(),
+		Name:        c.GetName(),
+		Description: c.GetDescription(),
+		Visibility:  domain.CollectionVisibility(c.GetVisibility()),
+	}
+}
+
+// ToStatus 把收藏夹的业务错误转成 gRPC 的错误码，调用方只看错误码。
+// 本地调用的适配器也用这个，两种部署方式返回的错误是一样的
+func ToStatus(err error) error {
+	switch err {
+	case service.ErrCollectionNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case service.ErrInvalidCollection, service.ErrInvalidPage:
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return err
 	}
 }
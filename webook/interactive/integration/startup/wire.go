@@ -24,7 +24,13 @@ var interactiveSvcSet = wire.NewSet(
 	service.NewInteractiveService,
 )
 
+var collectionSvcSet = wire.NewSet(
+	dao.NewGORMCollectionDAO,
+	repository.NewCachedCollectionRepository,
+	service.NewCollectionService,
+)
+
 func InitInteractiveService() *grpc.InteractiveServiceServer {
-	wire.Build(thirdPartySet, interactiveSvcSet, grpc.NewInteractiveServiceServer)
+	wire.Build(thirdPartySet, interactiveSvcSet, collectionSvcSet, grpc.NewInteractiveServiceServer)
 	return new(grpc.InteractiveServiceServer)
 }
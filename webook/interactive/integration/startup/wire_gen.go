@@ -25,7 +25,10 @@ func InitInteractiveService() *grpc.InteractiveServiceServer {
 	loggerV1 := InitLogger()
 	interactiveRepository := repository.NewCachedInteractiveRepository(interactiveDAO, interactiveCache, loggerV1)
 	interactiveService := service.NewInteractiveService(interactiveRepository)
-	interactiveServiceServer := grpc.NewInteractiveServiceServer(interactiveService)
+	collectionDAO := dao.NewGORMCollectionDAO(db)
+	collectionRepository := repository.NewCachedCollectionRepository(collectionDAO, interactiveCache, loggerV1)
+	collectionService := service.NewCollectionService(collectionRepository)
+	interactiveServiceServer := grpc.NewInteractiveServiceServer(interactiveService, collectionService)
 	return interactiveServiceServer
 }
 
@@ -37,3 +40,5 @@ var thirdPartySet = wire.NewSet(
 )
 
 var interactiveSvcSet = wire.NewSet(dao.NewGORMInteractiveDAO, cache.NewRedisInteractiveCache, repository.NewCachedInteractiveRepository, service.NewInteractiveService)
+
+var collectionSvcSet = wire.NewSet(dao.NewGORMCollectionDAO, repository.NewCachedCollectionRepository, service.NewCollectionService)
@@ -2,26 +2,45 @@ package ioc
 
 import (
 	grpc2 "webook/interactive/grpc"
+	ijwt "webook/internal/web/jwt"
 	"webook/pkg/grpcx"
+	rbacx "webook/pkg/grpcx/interceptor/rbac"
 	"webook/pkg/logger"
+	"webook/pkg/rbac"
 
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
 )
 
-func NewGrpcxServer(intrSvc *grpc2.InteractiveServiceServer, l logger.LoggerV1) *grpcx.Server {
+func NewGrpcxServer(intrSvc *grpc2.InteractiveServiceServer, l logger.LoggerV1,
+	policy *rbac.Policy, keys *ijwt.RemoteKeySet) *grpcx.Server {
+	type Permission struct {
+		// Method 方法的全名，例如 /intr.v1.InteractiveService/Like
+		Method     string `yaml:"method"`
+		Permission string `yaml:"permission"`
+	}
 	type Config struct {
 		EtcdAddr string `yaml:"etcdAddr"`
 		Port     int    `yaml:"port"`
 		Name     string `yaml:"name"`
+		// Permissions 需要权限的方法，没有配置的方法不做检查。
+		// 用列表而不是 map，因为 viper 会把 map 的 key 转成小写
+		Permissions []Permission `yaml:"permissions"`
 	}
-	s := grpc.NewServer()
-	intrSvc.Register(s)
 	var cfg Config
 	err := viper.UnmarshalKey("grpc.server", &cfg)
 	if err != nil {
 		panic(err)
 	}
+	perms := make(map[string]string, len(cfg.Permissions))
+	for _, p := range cfg.Permissions {
+		perms[p.Method] = p.Permission
+	}
+	s := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		rbacx.NewInterceptorBuilder(policy, perms, rbacx.JWTRoles(keys.Keyfunc)).
+			BuildServerUnaryInterceptor(),
+	))
+	intrSvc.Register(s)
 	return &grpcx.Server{
 		Server:   s,
 		EtcdAddr: cfg.EtcdAddr,
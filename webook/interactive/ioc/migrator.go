@@ -1,16 +1,22 @@
 package ioc
 
 import (
+	"net/http"
+	"strings"
 	"webook/interactive/repository/dao"
+	ijwt "webook/internal/web/jwt"
 	"webook/pkg/ginx"
+	rbacx "webook/pkg/ginx/middleware/rbac"
 	"webook/pkg/gormx/connpool"
 	"webook/pkg/logger"
 	"webook/pkg/migrator/events"
 	"webook/pkg/migrator/events/fixer"
 	"webook/pkg/migrator/scheduler"
+	"webook/pkg/rbac"
 
 	"github.com/IBM/sarama"
 	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
 	prometheus2 "github.com/prometheus/client_golang/prometheus"
 	"github.com/spf13/viper"
 )
@@ -20,9 +26,20 @@ func InitGinxServer(l logger.LoggerV1,
 	src SrcDB,
 	dst DstDB,
 	pool *connpool.DoubleWritePool,
-	producer events.Producer) *ginx.Server {
+	producer events.Producer,
+	policy *rbac.Policy,
+	keys *ijwt.RemoteKeySet) *ginx.Server {
 	engine := gin.Default()
-	group := engine.Group("/migrator")
+	rb := rbacx.NewBuilder(policy, func(ctx *gin.Context) ([]string, bool) {
+		val, ok := ctx.Get("user")
+		if !ok {
+			return nil, false
+		}
+		uc, ok := val.(ijwt.UserClaims)
+		return uc.Roles, ok
+	})
+	// 切换读写模式、修数据都是高危操作，只有 migrator 权限的人才能调用
+	group := engine.Group("/migrator", authenticate(keys), rb.Require("migrator:write"))
 	ginx.InitCounter(prometheus2.CounterOpts{
 		Namespace: "geektime_daming",
 		Subsystem: "webook_intr_admin",
@@ -37,15 +54,35 @@ func InitGinxServer(l logger.LoggerV1,
 	}
 }
 
+// authenticate 校验 webook 签发的短 token。
+// 这边拿不到 webook 的会话记录，所以被踢掉的 token 要等过期才会失效
+func authenticate(keys *ijwt.RemoteKeySet) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		tokenStr, ok := strings.CutPrefix(ctx.GetHeader("Authorization"), "Bearer ")
+		if !ok {
+			ctx.AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+		var uc ijwt.UserClaims
+		token, err := jwt.ParseWithClaims(tokenStr, &uc, keys.Keyfunc)
+		if err != nil || !token.Valid {
+			ctx.AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+		ctx.Set("user", uc)
+	}
+}
+
 func InitInteractiveProducer(p sarama.SyncProducer) events.Producer {
 	return events.NewSaramaProducer("inconsistent_interactive", p)
 }
 
 func InitFixerConsumer(client sarama.Client,
+	producer sarama.SyncProducer,
 	l logger.LoggerV1,
 	src SrcDB,
 	dst DstDB) *fixer.Consumer[dao.Interactive] {
-	res, err := fixer.NewConsumer[dao.Interactive](client, l, "inconsistent_interactive", src, dst)
+	res, err := fixer.NewConsumer[dao.Interactive](client, producer, l, "inconsistent_interactive", src, dst)
 	if err != nil {
 		panic(err)
 	}
@@ -0,0 +1,27 @@
+package ioc
+
+import (
+	ijwt "webook/internal/web/jwt"
+	"webook/pkg/rbac"
+
+	"github.com/spf13/viper"
+)
+
+// InitRBACPolicy 和 webook 使用同一份角色配置
+func InitRBACPolicy() *rbac.Policy {
+	var roles map[string][]string
+	err := viper.UnmarshalKey("rbac.roles", &roles)
+	if err != nil {
+		panic(err)
+	}
+	return rbac.NewPolicy(roles)
+}
+
+// InitJWTKeySet 用 webook 公开的 JWKS 校验短 token
+func InitJWTKeySet() *ijwt.RemoteKeySet {
+	url := viper.GetString("jwt.jwksURL")
+	if url == "" {
+		panic("没有配置 jwt.jwksURL")
+	}
+	return ijwt.NewRemoteKeySet(url)
+}
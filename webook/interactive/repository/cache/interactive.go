@@ -20,15 +20,27 @@ const (
 	fieldReadCnt    = "read_cnt"
 	fieldLikeCnt    = "like_cnt"
 	fieldCollectCnt = "collect_cnt"
+
+	// dailyUvExpiration 每天的 UV 保留一周，方便按周统计
+	dailyUvExpiration = time.Hour * 24 * 8
 )
 
+//go:generate mockgen -source=./interactive.go -package=cachemocks -destination=./mocks/interactive.mock.go InteractiveCache
 type InteractiveCache interface {
 	IncrReadCntIfPresent(ctx context.Context, biz string, bizId int64) error
+	// IncrReadCntBatchIfPresent 用 pipeline 一次发过去
+	IncrReadCntBatchIfPresent(ctx context.Context, bizs []string, bizIds []int64, cnts []int64) error
 	IncrLikeCntIfPresent(ctx context.Context, biz string, bizId int64) error
 	DecrLikeCntIfPresent(ctx context.Context, biz string, bizId int64) error
 	IncrCollectCntIfPresent(ctx context.Context, biz string, bizId int64) error
+	DecrCollectCntIfPresent(ctx context.Context, biz string, bizId int64) error
 	Get(ctx context.Context, biz string, bizId int64) (domain.Interactive, error)
 	Set(ctx context.Context, biz string, bizId int64, intr domain.Interactive) error
+	Del(ctx context.Context, biz string, bizId int64) error
+	// AddUv uids[i] 是读过 bizs[i]、bizIds[i] 的用户，同时记到总的和 day 这一天的 UV 里面
+	AddUv(ctx context.Context, bizs []string, bizIds []int64, uids [][]int64, day time.Time) error
+	// GetUv 返回的两个切片和 bizIds 一一对应，分别是总的和 day 这一天的 UV
+	GetUv(ctx context.Context, biz string, bizIds []int64, day time.Time) ([]int64, []int64, error)
 }
 
 type RedisInteractiveCache struct {
@@ -48,6 +60,21 @@ func (ic *RedisInteractiveCache) IncrReadCntIfPresent(ctx context.Context,
 		fieldReadCnt, 1).Err()
 }
 
+func (ic *RedisInteractiveCache) IncrReadCntBatchIfPresent(ctx context.Context,
+	bizs []string, bizIds []int64, cnts []int64) error {
+	if len(bizIds) == 0 {
+		return nil
+	}
+	pipe := ic.client.Pipeline()
+	for i := range bizIds {
+		pipe.Eval(ctx, luaIncrCnt,
+			[]string{ic.key(bizs[i], bizIds[i])},
+			fieldReadCnt, cnts[i])
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
 func (ic *RedisInteractiveCache) IncrLikeCntIfPresent(ctx context.Context,
 	biz string, bizId int64) error {
 	return ic.client.Eval(ctx, luaIncrCnt,
@@ -69,6 +96,13 @@ func (ic *RedisInteractiveCache) IncrCollectCntIfPresent(ctx context.Context,
 		fieldCollectCnt, 1).Err()
 }
 
+func (ic *RedisInteractiveCache) DecrCollectCntIfPresent(ctx context.Context,
+	biz string, bizId int64) error {
+	return ic.client.Eval(ctx, luaIncrCnt,
+		[]string{ic.key(biz, bizId)},
+		fieldCollectCnt, -1).Err()
+}
+
 func (ic *RedisInteractiveCache) Get(ctx context.Context,
 	biz string, bizId int64) (domain.Interactive, error) {
 	// 直接使用 HMGet，即便缓存中没有对应的 key，也不会返回 error
@@ -108,6 +142,67 @@ func (ic *RedisInteractiveCache) Set(ctx context.Context, biz string, bizId int6
 	return ic.client.Expire(ctx, key, time.Minute*15).Err()
 }
 
+func (ic *RedisInteractiveCache) Del(ctx context.Context, biz string, bizId int64) error {
+	return ic.client.Del(ctx, ic.key(biz, bizId)).Err()
+}
+
+func (ic *RedisInteractiveCache) AddUv(ctx context.Context,
+	bizs []string, bizIds []int64, uids [][]int64, day time.Time) error {
+	if len(bizIds) == 0 {
+		return nil
+	}
+	pipe := ic.client.Pipeline()
+	for i := range bizIds {
+		if len(uids[i]) == 0 {
+			continue
+		}
+		members := make([]any, 0, len(uids[i]))
+		for _, uid := range uids[i] {
+			members = append(members, uid)
+		}
+		pipe.PFAdd(ctx, ic.uvKey(bizs[i], bizIds[i]), members...)
+		dailyKey := ic.dailyUvKey(bizs[i], bizIds[i], day)
+		pipe.PFAdd(ctx, dailyKey, members...)
+		pipe.Expire(ctx, dailyKey, dailyUvExpiration)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (ic *RedisInteractiveCache) GetUv(ctx context.Context,
+	biz string, bizIds []int64, day time.Time) ([]int64, []int64, error) {
+	if len(bizIds) == 0 {
+		return nil, nil, nil
+	}
+	pipe := ic.client.Pipeline()
+	totalCmds := make([]*redis.IntCmd, 0, len(bizIds))
+	dailyCmds := make([]*redis.IntCmd, 0, len(bizIds))
+	for _, bizId := range bizIds {
+		totalCmds = append(totalCmds, pipe.PFCount(ctx, ic.uvKey(biz, bizId)))
+		dailyCmds = append(dailyCmds, pipe.PFCount(ctx, ic.dailyUvKey(biz, bizId, day)))
+	}
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	total := make([]int64, 0, len(bizIds))
+	daily := make([]int64, 0, len(bizIds))
+	for i := range bizIds {
+		total = append(total, totalCmds[i].Val())
+		daily = append(daily, dailyCmds[i].Val())
+	}
+	return total, daily, nil
+}
+
 func (ic *RedisInteractiveCache) key(biz string, bizId int64) string {
 	return fmt.Sprintf("interactive:%s:%d", biz, bizId)
 }
+
+// uvKey UV 没有数据库兜底，不能设置过期时间
+func (ic *RedisInteractiveCache) uvKey(biz string, bizId int64) string {
+	return fmt.Sprintf("interactive:uv:%s:%d", biz, bizId)
+}
+
+func (ic *RedisInteractiveCache) dailyUvKey(biz string, bizId int64, day time.Time) string {
+	return fmt.Sprintf("interactive:uv:%s:%d:%s", biz, bizId, day.Format("20060102"))
+}
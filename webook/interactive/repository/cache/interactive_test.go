@@ -0,0 +1,147 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"webook/internal/repository/cache/redismocks"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRedisInteractiveCache_AddUv(t *testing.T) {
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.Local)
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) redis.Cmdable
+
+		bizs   []string
+		bizIds []int64
+		uids   [][]int64
+
+		wantErr error
+	}{
+		{
+			name: "记到总的和当天的 UV 里面，没有读者的跳过",
+			mock: func(ctrl *gomock.Controller) redis.Cmdable {
+				client := redismocks.NewMockCmdable(ctrl)
+				pipe := redismocks.NewMockPipeliner(ctrl)
+				client.EXPECT().Pipeline().Return(pipe)
+				pipe.EXPECT().PFAdd(gomock.Any(), "interactive:uv:article:1", int64(11), int64(12)).
+					Return(redis.NewIntCmd(context.Background()))
+				pipe.EXPECT().PFAdd(gomock.Any(), "interactive:uv:article:1:20240301", int64(11), int64(12)).
+					Return(redis.NewIntCmd(context.Background()))
+				pipe.EXPECT().Expire(gomock.Any(), "interactive:uv:article:1:20240301", dailyUvExpiration).
+					Return(redis.NewBoolCmd(context.Background()))
+				pipe.EXPECT().Exec(gomock.Any()).Return(nil, nil)
+				return client
+			},
+			bizs:   []string{"article", "article"},
+			bizIds: []int64{1, 2},
+			uids:   [][]int64{{11, 12}, nil},
+		},
+		{
+			name: "没有数据不访问 redis",
+			mock: func(ctrl *gomock.Controller) redis.Cmdable {
+				return redismocks.NewMockCmdable(ctrl)
+			},
+		},
+		{
+			name: "redis 返回 error",
+			mock: func(ctrl *gomock.Controller) redis.Cmdable {
+				client := redismocks.NewMockCmdable(ctrl)
+				pipe := redismocks.NewMockPipeliner(ctrl)
+				client.EXPECT().Pipeline().Return(pipe)
+				pipe.EXPECT().PFAdd(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(2).Return(redis.NewIntCmd(context.Background()))
+				pipe.EXPECT().Expire(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(redis.NewBoolCmd(context.Background()))
+				pipe.EXPECT().Exec(gomock.Any()).Return(nil, errors.New("redis错误"))
+				return client
+			},
+			bizs:    []string{"article"},
+			bizIds:  []int64{1},
+			uids:    [][]int64{{11}},
+			wantErr: errors.New("redis错误"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := NewRedisInteractiveCache(tc.mock(ctrl))
+			err := c.AddUv(context.Background(), tc.bizs, tc.bizIds, tc.uids, day)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+func TestRedisInteractiveCache_GetUv(t *testing.T) {
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.Local)
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) redis.Cmdable
+
+		bizIds []int64
+
+		wantTotal []int64
+		wantDaily []int64
+		wantErr   error
+	}{
+		{
+			name: "查询成功",
+			mock: func(ctrl *gomock.Controller) redis.Cmdable {
+				client := redismocks.NewMockCmdable(ctrl)
+				pipe := redismocks.NewMockPipeliner(ctrl)
+				client.EXPECT().Pipeline().Return(pipe)
+				pipe.EXPECT().PFCount(gomock.Any(), "interactive:uv:article:1").
+					Return(intCmd(10))
+				pipe.EXPECT().PFCount(gomock.Any(), "interactive:uv:article:1:20240301").
+					Return(intCmd(3))
+				pipe.EXPECT().PFCount(gomock.Any(), "interactive:uv:article:2").
+					Return(intCmd(5))
+				pipe.EXPECT().PFCount(gomock.Any(), "interactive:uv:article:2:20240301").
+					Return(intCmd(0))
+				pipe.EXPECT().Exec(gomock.Any()).Return(nil, nil)
+				return client
+			},
+			bizIds:    []int64{1, 2},
+			wantTotal: []int64{10, 5},
+			wantDaily: []int64{3, 0},
+		},
+		{
+			name: "redis 返回 error",
+			mock: func(ctrl *gomock.Controller) redis.Cmdable {
+				client := redismocks.NewMockCmdable(ctrl)
+				pipe := redismocks.NewMockPipeliner(ctrl)
+				client.EXPECT().Pipeline().Return(pipe)
+				pipe.EXPECT().PFCount(gomock.Any(), gomock.Any()).
+					Times(2).Return(intCmd(0))
+				pipe.EXPECT().Exec(gomock.Any()).Return(nil, errors.New("redis错误"))
+				return client
+			},
+			bizIds:  []int64{1},
+			wantErr: errors.New("redis错误"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := NewRedisInteractiveCache(tc.mock(ctrl))
+			total, daily, err := c.GetUv(context.Background(), "article", tc.bizIds, day)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantTotal, total)
+			assert.Equal(t, tc.wantDaily, daily)
+		})
+	}
+}
+
+func intCmd(val int64) *redis.IntCmd {
+	cmd := redis.NewIntCmd(context.Background())
+	cmd.SetVal(val)
+	return cmd
+}
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"webook/interactive/domain"
+	"webook/interactive/repository/cache"
+	"webook/interactive/repository/dao"
+	"webook/pkg/logger"
+
+	"github.com/ecodeclub/ekit/slice"
+)
+
+var ErrCollectionNotFound = dao.ErrRecordNotFound
+
+type CollectionRepository interface {
+	Create(ctx context.Context, c domain.Collection) (int64, error)
+	Update(ctx context.Context, c domain.Collection) error
+	Delete(ctx context.Context, uid int64, id int64) error
+	FindById(ctx context.Context, id int64) (domain.Collection, error)
+	// FindByUid onlyPublic 为 true 的时候只返回公开的收藏夹
+	FindByUid(ctx context.Context, uid int64, onlyPublic bool, offset int, limit int) ([]domain.Collection, error)
+	FindItems(ctx context.Context, uid int64, cid int64, offset int, limit int) ([]domain.UserCollection, error)
+	MoveItem(ctx context.Context, biz string, bizId, uid, cid int64) error
+}
+
+type CachedCollectionRepository struct {
+	dao dao.CollectionDAO
+	// 删除收藏夹会改变收藏数，要清理对应的缓存
+	ic cache.InteractiveCache
+	l  logger.LoggerV1
+}
+
+func NewCachedCollectionRepository(dao dao.CollectionDAO,
+	ic cache.InteractiveCache, l logger.LoggerV1) CollectionRepository {
+	return &CachedCollectionRepository{
+		dao: dao,
+		ic:  ic,
+		l:   l,
+	}
+}
+
+func (r *CachedCollectionRepository) Create(ctx context.Context, c domain.Collection) (int64, error) {
+	return r.dao.Insert(ctx, r.toEntity(c))
+}
+
+func (r *CachedCollectionRepository) Update(ctx context.Context, c domain.Collection) error {
+	return r.dao.UpdateById(ctx, r.toEntity(c))
+}
+
+func (r *CachedCollectionRepository) Delete(ctx context.Context, uid int64, id int64) error {
+	items, err := r.dao.Delete(ctx, uid, id)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		err = r.ic.Del(ctx, item.Biz, item.BizId)
+		if err != nil {
+			r.l.Error("删除缓存失败", logger.Error(err),
+				logger.String("biz", item.Biz), logger.Int64("bizId", item.BizId))
+		}
+	}
+	return nil
+}
+
+func (r *CachedCollectionRepository) FindById(ctx context.Context, id int64) (domain.Collection, error) {
+	c, err := r.dao.GetById(ctx, id)
+	if err != nil {
+		return domain.Collection{}, err
+	}
+	return r.toDomain(c), nil
+}
+
+func (r *CachedCollectionRepository) FindByUid(ctx context.Context, uid int64,
+	onlyPublic bool, offset int, limit int) ([]domain.Collection, error) {
+	cs, err := r.dao.ListByUid(ctx, uid, onlyPublic, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(cs, func(idx int, src dao.Collection) domain.Collection {
+		return r.toDomain(src)
+	}), nil
+}
+
+func (r *CachedCollectionRepository) FindItems(ctx context.Context, uid int64,
+	cid int64, offset int, limit int) ([]domain.UserCollection, error) {
+	items, err := r.dao.ListItems(ctx, uid, cid, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(items, func(idx int, src dao.UserCollectionBiz) domain.UserCollection {
+		return domain.UserCollection{
+			Biz:   src.Biz,
+			BizId: src.BizId,
+			Cid:   src.Cid,
+			Ctime: time.UnixMilli(src.Ctime),
+		}
+	}), nil
+}
+
+func (r *CachedCollectionRepository) MoveItem(ctx context.Context, biz string, bizId, uid, cid int64) error {
+	return r.dao.MoveItem(ctx, biz, bizId, uid, cid)
+}
+
+func (r *CachedCollectionRepository) toEntity(c domain.Collection) dao.Collection {
+	return dao.Collection{
+		Id:          c.Id,
+		Uid:         c.Uid,
+		Name:        c.Name,
+		Description: c.Description,
+		Visibility:  c.Visibility.ToUint8(),
+	}
+}
+
+func (r *CachedCollectionRepository) toDomain(c dao.Collection) domain.Collection {
+	return domain.Collection{
+		Id:          c.Id,
+		Uid:         c.Uid,
+		Name:        c.Name,
+		Description: c.Description,
+		Visibility:  domain.CollectionVisibility(c.Visibility),
+		ItemCnt:     c.ItemCnt,
+		Ctime:       time.UnixMilli(c.Ctime),
+		Utime:       time.UnixMilli(c.Utime),
+	}
+}
@@ -0,0 +1,190 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CollectionDAO 收藏夹，收藏的东西本身还是 UserCollectionBiz
+type CollectionDAO interface {
+	Insert(ctx context.Context, c Collection) (int64, error)
+	// UpdateById 只能修改自己的收藏夹，否则返回 ErrRecordNotFound
+	UpdateById(ctx context.Context, c Collection) error
+	// Delete 删除收藏夹，连同里面收藏的东西一起删掉，同时扣减收藏数，返回被删除的收藏
+	Delete(ctx context.Context, uid int64, id int64) ([]UserCollectionBiz, error)
+	GetById(ctx context.Context, id int64) (Collection, error)
+	// ListByUid onlyPublic 为 true 的时候只返回公开的收藏夹
+	ListByUid(ctx context.Context, uid int64, onlyPublic bool, offset int, limit int) ([]Collection, error)
+	// ListItems 收藏夹里面的东西，按照收藏时间倒序。cid 为 0 是默认收藏夹
+	ListItems(ctx context.Context, uid int64, cid int64, offset int, limit int) ([]UserCollectionBiz, error)
+	// MoveItem 把收藏的东西挪到 cid 这个收藏夹，cid 为 0 是挪到默认收藏夹
+	MoveItem(ctx context.Context, biz string, bizId, uid, cid int64) error
+}
+
+type GORMCollectionDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMCollectionDAO(db *gorm.DB) CollectionDAO {
+	return &GORMCollectionDAO{
+		db: db,
+	}
+}
+
+func (d *GORMCollectionDAO) Insert(ctx context.Context, c Collection) (int64, error) {
+	now := time.Now().UnixMilli()
+	c.Ctime = now
+	c.Utime = now
+	err := d.db.WithContext(ctx).Create(&c).Error
+	return c.Id, err
+}
+
+func (d *GORMCollectionDAO) UpdateById(ctx context.Context, c Collection) error {
+	res := d.db.WithContext(ctx).Model(&Collection{}).
+		Where("id = ? AND uid = ?", c.Id, c.Uid).
+		Updates(map[string]any{
+			"name":        c.Name,
+			"description": c.Description,
+			"visibility":  c.Visibility,
+			"utime":       time.Now().UnixMilli(),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrRecordNotFound
+	}
+	return nil
+}
+
+func (d *GORMCollectionDAO) Delete(ctx context.Context, uid int64, id int64) ([]UserCollectionBiz, error) {
+	var items []UserCollectionBiz
+	now := time.Now().UnixMilli()
+	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Where("id = ? AND uid = ?", id, uid).Delete(&Collection{})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrRecordNotFound
+		}
+		err := tx.Where("uid = ? AND cid = ?", uid, id).Find(&items).Error
+		if err != nil {
+			return err
+		}
+		for _, item := range items {
+			err = tx.Model(&Interactive{}).
+				Where("biz = ? AND biz_id = ?", item.Biz, item.BizId).
+				Updates(map[string]any{
+					"collect_cnt": gorm.Expr("`collect_cnt`-1"),
+					"utime":       now,
+				}).Error
+			if err != nil {
+				return err
+			}
+		}
+		return tx.Where("uid = ? AND cid = ?", uid, id).Delete(&UserCollectionBiz{}).Error
+	})
+	return items, err
+}
+
+func (d *GORMCollectionDAO) GetById(ctx context.Context, id int64) (Collection, error) {
+	var res Collection
+	err := d.db.WithContext(ctx).Where("id = ?", id).First(&res).Error
+	return res, err
+}
+
+func (d *GORMCollectionDAO) ListByUid(ctx context.Context, uid int64,
+	onlyPublic bool, offset int, limit int) ([]Collection, error) {
+	var res []Collection
+	db := d.db.WithContext(ctx).Where("uid = ?", uid)
+	if onlyPublic {
+		db = db.Where("visibility = ?", CollectionVisibilityPublic)
+	}
+	err := db.Order("id ASC").
+		Offset(offset).Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (d *GORMCollectionDAO) ListItems(ctx context.Context, uid int64,
+	cid int64, offset int, limit int) ([]UserCollectionBiz, error) {
+	var res []UserCollectionBiz
+	err := d.db.WithContext(ctx).
+		Where("uid = ? AND cid = ?", uid, cid).
+		Order("id DESC").
+		Offset(offset).Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (d *GORMCollectionDAO) MoveItem(ctx context.Context, biz string, bizId, uid, cid int64) error {
+	now := time.Now().UnixMilli()
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var item UserCollectionBiz
+		// 和 DeleteCollectionBiz 一样锁住这一行，不然并发挪动会按照旧的 cid 扣减
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("biz = ? AND biz_id = ? AND uid = ?", biz, bizId, uid).
+			First(&item).Error
+		if err != nil {
+			return err
+		}
+		if item.Cid == cid {
+			return nil
+		}
+		if cid > 0 {
+			// 顺便校验了目标收藏夹是不是自己的
+			res := tx.Model(&Collection{}).
+				Where("id = ? AND uid = ?", cid, uid).
+				Updates(map[string]any{
+					"item_cnt": gorm.Expr("`item_cnt`+1"),
+					"utime":    now,
+				})
+			if res.Error != nil {
+				return res.Error
+			}
+			if res.RowsAffected == 0 {
+				return ErrRecordNotFound
+			}
+		}
+		if item.Cid > 0 {
+			err = tx.Model(&Collection{}).
+				Where("id = ?", item.Cid).
+				Updates(map[string]any{
+					"item_cnt": gorm.Expr("`item_cnt`-1"),
+					"utime":    now,
+				}).Error
+			if err != nil {
+				return err
+			}
+		}
+		return tx.Model(&UserCollectionBiz{}).
+			Where("id = ?", item.Id).
+			Updates(map[string]any{
+				"cid":   cid,
+				"utime": now,
+			}).Error
+	})
+}
+
+const (
+	CollectionVisibilityPrivate uint8 = 1
+	CollectionVisibilityPublic  uint8 = 2
+)
+
+// Collection 收藏夹
+type Collection struct {
+	Id          int64  `gorm:"primaryKey,autoIncrement"`
+	Uid         int64  `gorm:"index"`
+	Name        string `gorm:"type:varchar(64)"`
+	Description string `gorm:"type:varchar(256)"`
+	// 1-私有，2-公开
+	Visibility uint8
+	// ItemCnt 收藏夹里面有多少东西，收藏、取消收藏和挪动的时候维护
+	ItemCnt int64
+	Ctime   int64
+	Utime   int64
+}
@@ -0,0 +1,296 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+func TestGORMCollectionDAO_Delete(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(mock sqlmock.Sqlmock)
+
+		wantItems []UserCollectionBiz
+		wantErr   error
+	}{
+		{
+			name: "删除收藏夹，里面的东西扣减收藏数",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta(
+					"DELETE FROM `collections` WHERE id = ? AND uid = ?")).
+					WithArgs(2, 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectQuery(regexp.QuoteMeta(
+					"SELECT * FROM `user_collection_bizs` WHERE uid = ? AND cid = ?")).
+					WithArgs(1, 2).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "cid", "biz_id", "biz", "uid"}).
+						AddRow(10, 2, 100, "article", 1).
+						AddRow(11, 2, 101, "article", 1))
+				mock.ExpectExec(regexp.QuoteMeta(
+					"UPDATE `interactives` SET `collect_cnt`=`collect_cnt`-1,`utime`=? WHERE biz = ? AND biz_id = ?")).
+					WithArgs(sqlmock.AnyArg(), "article", 100).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta(
+					"UPDATE `interactives` SET `collect_cnt`=`collect_cnt`-1,`utime`=? WHERE biz = ? AND biz_id = ?")).
+					WithArgs(sqlmock.AnyArg(), "article", 101).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta(
+					"DELETE FROM `user_collection_bizs` WHERE uid = ? AND cid = ?")).
+					WithArgs(1, 2).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectCommit()
+			},
+			wantItems: []UserCollectionBiz{
+				{Id: 10, Cid: 2, BizId: 100, Biz: "article", Uid: 1},
+				{Id: 11, Cid: 2, BizId: 101, Biz: "article", Uid: 1},
+			},
+		},
+		{
+			name: "别人的收藏夹，什么都不改",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec("DELETE FROM `collections` .*").
+					WithArgs(2, 1).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			wantErr: ErrRecordNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock := newMockDB(t)
+			tc.mock(mock)
+			items, err := NewGORMCollectionDAO(db).Delete(context.Background(), 1, 2)
+			assert.Equal(t, tc.wantErr, err)
+			if err == nil {
+				assert.Equal(t, tc.wantItems, items)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGORMCollectionDAO_MoveItem(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(mock sqlmock.Sqlmock)
+
+		cid int64
+
+		wantErr error
+	}{
+		{
+			name: "挪到别的收藏夹，两边的数量都要改",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectFindItem(mock, 3)
+				mock.ExpectExec(regexp.QuoteMeta(
+					"UPDATE `collections` SET `item_cnt`=`item_cnt`+1,`utime`=? WHERE id = ? AND uid = ?")).
+					WithArgs(sqlmock.AnyArg(), 4, 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta(
+					"UPDATE `collections` SET `item_cnt`=`item_cnt`-1,`utime`=? WHERE id = ?")).
+					WithArgs(sqlmock.AnyArg(), 3).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta(
+					"UPDATE `user_collection_bizs` SET `cid`=?,`utime`=? WHERE id = ?")).
+					WithArgs(4, sqlmock.AnyArg(), 10).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			cid: 4,
+		},
+		{
+			name: "挪到默认收藏夹，只扣原来的",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectFindItem(mock, 3)
+				mock.ExpectExec(regexp.QuoteMeta(
+					"UPDATE `collections` SET `item_cnt`=`item_cnt`-1,`utime`=? WHERE id = ?")).
+					WithArgs(sqlmock.AnyArg(), 3).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta(
+					"UPDATE `user_collection_bizs` SET `cid`=?,`utime`=? WHERE id = ?")).
+					WithArgs(0, sqlmock.AnyArg(), 10).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			cid: 0,
+		},
+		{
+			name: "从默认收藏夹挪出来，只加目标的",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectFindItem(mock, 0)
+				mock.ExpectExec(regexp.QuoteMeta(
+					"UPDATE `collections` SET `item_cnt`=`item_cnt`+1,`utime`=? WHERE id = ? AND uid = ?")).
+					WithArgs(sqlmock.AnyArg(), 4, 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta(
+					"UPDATE `user_collection_bizs` SET `cid`=?,`utime`=? WHERE id = ?")).
+					WithArgs(4, sqlmock.AnyArg(), 10).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+			cid: 4,
+		},
+		{
+			name: "已经在这个收藏夹了，什么都不改",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectFindItem(mock, 4)
+				mock.ExpectCommit()
+			},
+			cid: 4,
+		},
+		{
+			name: "目标是别人的收藏夹",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectFindItem(mock, 3)
+				mock.ExpectExec("UPDATE `collections` .*").
+					WithArgs(sqlmock.AnyArg(), 4, 1).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			cid:     4,
+			wantErr: ErrRecordNotFound,
+		},
+		{
+			name: "没有收藏过",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT \\* FROM `user_collection_bizs` .*").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectRollback()
+			},
+			cid:     4,
+			wantErr: ErrRecordNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock := newMockDB(t)
+			tc.mock(mock)
+			err := NewGORMCollectionDAO(db).MoveItem(context.Background(), "article", 100, 1, tc.cid)
+			assert.Equal(t, tc.wantErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGORMInteractiveDAO_DeleteCollectionBiz(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(mock sqlmock.Sqlmock)
+
+		wantErr error
+	}{
+		{
+			name: "在收藏夹里面，扣减收藏夹的数量和收藏数",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectFindItem(mock, 3)
+				expectDeleteItem(mock)
+				mock.ExpectExec(regexp.QuoteMeta(
+					"UPDATE `collections` SET `item_cnt`=`item_cnt`-1,`utime`=? WHERE id = ?")).
+					WithArgs(sqlmock.AnyArg(), 3).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				expectDecrCollectCnt(mock)
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "在默认收藏夹里面，只扣收藏数",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectFindItem(mock, 0)
+				expectDeleteItem(mock)
+				expectDecrCollectCnt(mock)
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "没有收藏过",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT \\* FROM `user_collection_bizs` .*").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectRollback()
+			},
+			wantErr: ErrRecordNotFound,
+		},
+		{
+			name: "扣减收藏数失败，整个回滚",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectFindItem(mock, 0)
+				expectDeleteItem(mock)
+				mock.ExpectExec("UPDATE `interactives` .*").
+					WillReturnError(errors.New("mock db 错误"))
+				mock.ExpectRollback()
+			},
+			wantErr: errors.New("mock db 错误"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock := newMockDB(t)
+			tc.mock(mock)
+			err := NewGORMInteractiveDAO(db).DeleteCollectionBiz(context.Background(), "article", 100, 1)
+			assert.Equal(t, tc.wantErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+// expectFindItem 用户 1 收藏了文章 100，在 cid 这个收藏夹里面。查询的时候要锁住这一行
+func expectFindItem(mock sqlmock.Sqlmock, cid int64) {
+	mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `user_collection_bizs` WHERE biz = ? AND biz_id = ? AND uid = ? "+
+			"ORDER BY `user_collection_bizs`.`id` LIMIT 1 FOR UPDATE")).
+		WithArgs("article", 100, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "cid", "biz_id", "biz", "uid"}).
+			AddRow(10, cid, 100, "article", 1))
+}
+
+func expectDeleteItem(mock sqlmock.Sqlmock) {
+	mock.ExpectExec(regexp.QuoteMeta(
+		"DELETE FROM `user_collection_bizs` WHERE id = ?")).
+		WithArgs(10).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
+func expectDecrCollectCnt(mock sqlmock.Sqlmock) {
+	mock.ExpectExec(regexp.QuoteMeta(
+		"UPDATE `interactives` SET `collect_cnt`=`collect_cnt`-1,`utime`=? WHERE biz = ? AND biz_id = ?")).
+		WithArgs(sqlmock.AnyArg(), "article", 100).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
+func newMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	db, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+	})
+	require.NoError(t, err)
+	return db, mock
+}
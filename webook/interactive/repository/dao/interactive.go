@@ -9,15 +9,33 @@ import (
 	"gorm.io/gorm/clause"
 )
 
+//go:generate mockgen -source=./interactive.go -package=daomocks -destination=./mocks/interactive.mock.go InteractiveDAO
 type InteractiveDAO interface {
 	IncrReadCnt(ctx context.Context, biz string, bizId int64) error
+	// IncrReadCntBatch bizs、bizIds 和 cnts 一一对应，第 i 个资源的阅读数加上 cnts[i]
+	IncrReadCntBatch(ctx context.Context, bizs []string, bizIds []int64, cnts []int64) error
 	InsertLikeInfo(ctx context.Context, biz string, bizId, uid int64) error
 	DeleteLikeInfo(ctx context.Context, biz string, bizId, uid int64) error
+	// InsertCollectionBiz cb.Cid 不为 0 的时候，收藏夹必须是自己的，否则返回 ErrRecordNotFound
 	InsertCollectionBiz(ctx context.Context, cb UserCollectionBiz) error
+	// DeleteCollectionBiz 取消收藏，没有收藏过返回 ErrRecordNotFound
+	DeleteCollectionBiz(ctx context.Context, biz string, bizId, uid int64) error
 	Get(ctx context.Context, biz string, bizId int64) (Interactive, error)
 	GetLikeInfo(ctx context.Context, biz string, bizId, uid int64) (UserLikeBiz, error)
 	GetCollectionInfo(ctx context.Context, biz string, bizId, uid int64) (UserCollectionBiz, error)
 	GetByIds(ctx context.Context, biz string, ids []int64) ([]Interactive, error)
+	// GetUserLikes 用户所有有效的点赞
+	GetUserLikes(ctx context.Context, uid int64) ([]UserLikeBiz, error)
+	GetUserCollections(ctx context.Context, uid int64) ([]UserCollectionBiz, error)
+	// ListUserLikes 分页查询用户有效的点赞，最近点赞的在前面
+	ListUserLikes(ctx context.Context, uid int64, offset int, limit int) ([]UserLikeBiz, error)
+	// ListUserCollections 分页查询用户的收藏，不区分收藏夹，最近收藏的在前面
+	ListUserCollections(ctx context.Context, uid int64, offset int, limit int) ([]UserCollectionBiz, error)
+	// DeleteUserData 删除用户的点赞、收藏记录和收藏夹，同时扣减对应的计数，返回被删除的记录
+	DeleteUserData(ctx context.Context, uid int64) ([]UserLikeBiz, []UserCollectionBiz, error)
+	// MergeUserData 把 source 的点赞、收藏和收藏夹转到 target 名下，
+	// 两个人都点赞或者收藏过的只保留 target 的，返回因此扣减了计数的记录
+	MergeUserData(ctx context.Context, sourceUid int64, targetUid int64) ([]UserLikeBiz, []UserCollectionBiz, error)
 }
 
 type GORMInteractiveDAO struct {
@@ -47,6 +65,31 @@ func (id *GORMInteractiveDAO) IncrReadCnt(ctx context.Context, biz string, bizId
 	}).Error
 }
 
+// IncrReadCntBatch 一条语句批量插入或者更新，调用者要保证同一个资源只出现一次
+func (id *GORMInteractiveDAO) IncrReadCntBatch(ctx context.Context,
+	bizs []string, bizIds []int64, cnts []int64) error {
+	if len(bizIds) == 0 {
+		return nil
+	}
+	now := time.Now().UnixMilli()
+	intrs := make([]Interactive, 0, len(bizIds))
+	for i := range bizIds {
+		intrs = append(intrs, Interactive{
+			ReadCnt: cnts[i],
+			Ctime:   now,
+			Utime:   now,
+			Biz:     bizs[i],
+			BizId:   bizIds[i],
+		})
+	}
+	return id.db.WithContext(ctx).Clauses(clause.OnConflict{
+		DoUpdates: clause.Assignments(map[string]any{
+			"read_cnt": gorm.Expr("`read_cnt`+VALUES(`read_cnt`)"),
+			"utime":    now,
+		}),
+	}).Create(&intrs).Error
+}
+
 func (id *GORMInteractiveDAO) InsertLikeInfo(ctx context.Context, biz string, bizId, uid int64) error {
 	now := time.Now().UnixMilli()
 	err := id.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
@@ -109,6 +152,20 @@ func (id *GORMInteractiveDAO) InsertCollectionBiz(ctx context.Context, cb UserCo
 	cb.Utime = now
 	cb.Ctime = now
 	return id.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if cb.Cid > 0 {
+			res := tx.Model(&Collection{}).
+				Where("id = ? AND uid = ?", cb.Cid, cb.Uid).
+				Updates(map[string]any{
+					"item_cnt": gorm.Expr("`item_cnt`+1"),
+					"utime":    now,
+				})
+			if res.Error != nil {
+				return res.Error
+			}
+			if res.RowsAffected == 0 {
+				return ErrRecordNotFound
+			}
+		}
 		err := tx.Create(&cb).Error
 		if err != nil {
 			return err
@@ -128,6 +185,41 @@ func (id *GORMInteractiveDAO) InsertCollectionBiz(ctx context.Context, cb UserCo
 	})
 }
 
+func (id *GORMInteractiveDAO) DeleteCollectionBiz(ctx context.Context, biz string, bizId, uid int64) error {
+	now := time.Now().UnixMilli()
+	return id.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var cb UserCollectionBiz
+		// 锁住这一行，不然并发取消收藏会重复扣减，并发挪动收藏夹会扣错收藏夹
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("biz = ? AND biz_id = ? AND uid = ?", biz, bizId, uid).
+			First(&cb).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Where("id = ?", cb.Id).Delete(&UserCollectionBiz{}).Error
+		if err != nil {
+			return err
+		}
+		if cb.Cid > 0 {
+			err = tx.Model(&Collection{}).
+				Where("id = ?", cb.Cid).
+				Updates(map[string]any{
+					"item_cnt": gorm.Expr("`item_cnt`-1"),
+					"utime":    now,
+				}).Error
+			if err != nil {
+				return err
+			}
+		}
+		return tx.Model(&Interactive{}).
+			Where("biz = ? AND biz_id = ?", biz, bizId).
+			Updates(map[string]any{
+				"collect_cnt": gorm.Expr("`collect_cnt`-1"),
+				"utime":       now,
+			}).Error
+	})
+}
+
 func (id *GORMInteractiveDAO) Get(ctx context.Context, biz string, bizId int64) (Interactive, error) {
 	var res Interactive
 	err := id.db.WithContext(ctx).
@@ -159,6 +251,99 @@ func (id *GORMInteractiveDAO) GetByIds(ctx context.Context, biz string, ids []in
 	return res, err
 }
 
+func (id *GORMInteractiveDAO) GetUserLikes(ctx context.Context, uid int64) ([]UserLikeBiz, error) {
+	var res []UserLikeBiz
+	err := id.db.WithContext(ctx).
+		Where("uid = ? AND status = ?", uid, 1).
+		Order("id DESC").
+		Find(&res).Error
+	return res, err
+}
+
+func (id *GORMInteractiveDAO) GetUserCollections(ctx context.Context, uid int64) ([]UserCollectionBiz, error) {
+	var res []UserCollectionBiz
+	err := id.db.WithContext(ctx).
+		Where("uid = ?", uid).
+		Order("id DESC").
+		Find(&res).Error
+	return res, err
+}
+
+func (id *GORMInteractiveDAO) ListUserLikes(ctx context.Context,
+	uid int64, offset int, limit int) ([]UserLikeBiz, error) {
+	var res []UserLikeBiz
+	// 取消之后再点赞只会更新 utime，所以按照 utime 排序
+	err := id.db.WithContext(ctx).
+		Where("uid = ? AND status = ?", uid, 1).
+		Order("utime DESC, id DESC").
+		Offset(offset).Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (id *GORMInteractiveDAO) ListUserCollections(ctx context.Context,
+	uid int64, offset int, limit int) ([]UserCollectionBiz, error) {
+	var res []UserCollectionBiz
+	err := id.db.WithContext(ctx).
+		Where("uid = ?", uid).
+		Order("id DESC").
+		Offset(offset).Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (id *GORMInteractiveDAO) DeleteUserData(ctx context.Context,
+	uid int64) ([]UserLikeBiz, []UserCollectionBiz, error) {
+	var (
+		likes       []UserLikeBiz
+		collections []UserCollectionBiz
+	)
+	now := time.Now().UnixMilli()
+	err := id.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("uid = ? AND status = ?", uid, 1).Find(&likes).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Where("uid = ?", uid).Find(&collections).Error
+		if err != nil {
+			return err
+		}
+		for _, l := range likes {
+			err = tx.Model(&Interactive{}).
+				Where("biz = ? AND biz_id = ?", l.Biz, l.BizId).
+				Updates(map[string]any{
+					"like_cnt": gorm.Expr("`like_cnt`-1"),
+					"utime":    now,
+				}).Error
+			if err != nil {
+				return err
+			}
+		}
+		for _, c := range collections {
+			err = tx.Model(&Interactive{}).
+				Where("biz = ? AND biz_id = ?", c.Biz, c.BizId).
+				Updates(map[string]any{
+					"collect_cnt": gorm.Expr("`collect_cnt`-1"),
+					"utime":       now,
+				}).Error
+			if err != nil {
+				return err
+			}
+		}
+		// 取消点赞是软删除，这里是真的删除，不保留任何记录
+		err = tx.Where("uid = ?", uid).Delete(&UserLikeBiz{}).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Where("uid = ?", uid).Delete(&UserCollectionBiz{}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Where("uid = ?", uid).Delete(&Collection{}).Error
+	})
+	return likes, collections, err
+}
+
 func (i Interactive) ID() int64 {
 	return i.Id
 }
@@ -184,28 +369,128 @@ type Interactive struct {
 	Utime      int64
 }
 
+func (id *GORMInteractiveDAO) MergeUserData(ctx context.Context,
+	sourceUid int64, targetUid int64) ([]UserLikeBiz, []UserCollectionBiz, error) {
+	var (
+		likes       []UserLikeBiz
+		collections []UserCollectionBiz
+	)
+	now := time.Now().UnixMilli()
+	err := id.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var sourceLikes []UserLikeBiz
+		err := tx.Where("uid = ?", sourceUid).Find(&sourceLikes).Error
+		if err != nil {
+			return err
+		}
+		for _, l := range sourceLikes {
+			var target UserLikeBiz
+			err = tx.Where("uid = ? AND biz = ? AND biz_id = ?", targetUid, l.Biz, l.BizId).
+				First(&target).Error
+			switch {
+			case err == ErrRecordNotFound:
+				err = tx.Model(&UserLikeBiz{}).Where("id = ?", l.Id).
+					Updates(map[string]any{"uid": targetUid, "utime": now}).Error
+			case err != nil:
+				return err
+			case l.Status == 1 && target.Status == 1:
+				// 两个人都点赞了，合并之后只算一次
+				likes = append(likes, l)
+				err = tx.Model(&Interactive{}).
+					Where("biz = ? AND biz_id = ?", l.Biz, l.BizId).
+					Updates(map[string]any{
+						"like_cnt": gorm.Expr("`like_cnt`-1"),
+						"utime":    now,
+					}).Error
+			case l.Status == 1:
+				err = tx.Model(&UserLikeBiz{}).Where("id = ?", target.Id).
+					Updates(map[string]any{"status": 1, "utime": now}).Error
+			}
+			if err != nil {
+				return err
+			}
+		}
+		err = tx.Where("uid = ?", sourceUid).Delete(&UserLikeBiz{}).Error
+		if err != nil {
+			return err
+		}
+
+		var sourceCollections []UserCollectionBiz
+		err = tx.Where("uid = ?", sourceUid).Find(&sourceCollections).Error
+		if err != nil {
+			return err
+		}
+		for _, c := range sourceCollections {
+			var cnt int64
+			err = tx.Model(&UserCollectionBiz{}).
+				Where("uid = ? AND biz = ? AND biz_id = ?", targetUid, c.Biz, c.BizId).
+				Count(&cnt).Error
+			if err != nil {
+				return err
+			}
+			if cnt == 0 {
+				err = tx.Model(&UserCollectionBiz{}).Where("id = ?", c.Id).
+					Updates(map[string]any{"uid": targetUid, "utime": now}).Error
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			// 两个人都收藏了，保留 target 的，source 的收藏夹里面少一个
+			collections = append(collections, c)
+			err = tx.Model(&Interactive{}).
+				Where("biz = ? AND biz_id = ?", c.Biz, c.BizId).
+				Updates(map[string]any{
+					"collect_cnt": gorm.Expr("`collect_cnt`-1"),
+					"utime":       now,
+				}).Error
+			if err != nil {
+				return err
+			}
+			if c.Cid > 0 {
+				err = tx.Model(&Collection{}).Where("id = ?", c.Cid).
+					Updates(map[string]any{
+						"item_cnt": gorm.Expr("`item_cnt`-1"),
+						"utime":    now,
+					}).Error
+				if err != nil {
+					return err
+				}
+			}
+			err = tx.Where("id = ?", c.Id).Delete(&UserCollectionBiz{}).Error
+			if err != nil {
+				return err
+			}
+		}
+		return tx.Model(&Collection{}).Where("uid = ?", sourceUid).
+			Updates(map[string]any{"uid": targetUid, "utime": now}).Error
+	})
+	return likes, collections, err
+}
+
 // UserLikeBiz 用户点赞的某个东西
 type UserLikeBiz struct {
 	Id int64 `gorm:"primaryKey,autoIncrement"`
 	// 三个构成唯一索引
-	Uid   int64  `gorm:"uniqueIndex:biz_type_id_uid"`
+	// uid_status_utime 是给 ListUserLikes 分页用的
+	Uid   int64  `gorm:"uniqueIndex:biz_type_id_uid;index:uid_status_utime,priority:1"`
 	BizId int64  `gorm:"uniqueIndex:biz_type_id_uid"`
 	Biz   string `gorm:"type:varchar(128);uniqueIndex:biz_type_id_uid"`
 	// 1- 有效，0-无效。软删除的用法
-	Status uint8
+	Status uint8 `gorm:"index:uid_status_utime,priority:2"`
 	Ctime  int64
-	Utime  int64
+	Utime  int64 `gorm:"index:uid_status_utime,priority:3"`
 }
 
 // UserCollectionBiz 收藏的东西
 type UserCollectionBiz struct {
-	Id int64 `gorm:"primaryKey,autoIncrement"`
+	// uid_id 是给 ListUserCollections 分页用的
+	Id int64 `gorm:"primaryKey,autoIncrement;index:uid_id,priority:2"`
 	// 收藏夹 ID
 	// 作为关联关系中的外键，我们这里需要索引
 	Cid   int64  `gorm:"index"`
 	BizId int64  `gorm:"uniqueIndex:biz_type_id_uid"`
 	Biz   string `gorm:"type:varchar(128);uniqueIndex:biz_type_id_uid"`
-	Uid   int64  `gorm:"uniqueIndex:biz_type_id_uid"`
+	Uid   int64  `gorm:"uniqueIndex:biz_type_id_uid;index:uid_id,priority:1"`
 	Ctime int64
 	Utime int64
 }
@@ -0,0 +1,166 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGORMInteractiveDAO_IncrReadCntBatch(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(mock sqlmock.Sqlmock)
+
+		bizs   []string
+		bizIds []int64
+		cnts   []int64
+
+		wantErr error
+	}{
+		{
+			name: "一条语句批量更新",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(
+					"INSERT INTO `interactives` (`biz_id`,`biz`,`read_cnt`,`collect_cnt`,`like_cnt`,`ctime`,`utime`) "+
+						"VALUES (?,?,?,?,?,?,?),(?,?,?,?,?,?,?) "+
+						"ON DUPLICATE KEY UPDATE `read_cnt`=`read_cnt`+VALUES(`read_cnt`),`utime`=?")).
+					WithArgs(1, "article", 3, 0, 0, sqlmock.AnyArg(), sqlmock.AnyArg(),
+						2, "article", 4, 0, 0, sqlmock.AnyArg(), sqlmock.AnyArg(),
+						sqlmock.AnyArg()).
+					WillReturnResult(sqlmock.NewResult(2, 3))
+			},
+			bizs:   []string{"article", "article"},
+			bizIds: []int64{1, 2},
+			cnts:   []int64{3, 4},
+		},
+		{
+			name:   "没有数据不查数据库",
+			mock:   func(mock sqlmock.Sqlmock) {},
+			bizs:   []string{},
+			bizIds: []int64{},
+			cnts:   []int64{},
+		},
+		{
+			name: "数据库错误",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("INSERT INTO `interactives` .*").
+					WillReturnError(errors.New("mock db 错误"))
+			},
+			bizs:    []string{"article"},
+			bizIds:  []int64{1},
+			cnts:    []int64{3},
+			wantErr: errors.New("mock db 错误"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock := newMockDB(t)
+			tc.mock(mock)
+			err := NewGORMInteractiveDAO(db).IncrReadCntBatch(context.Background(),
+				tc.bizs, tc.bizIds, tc.cnts)
+			assert.Equal(t, tc.wantErr, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGORMInteractiveDAO_ListUserLikes(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(mock sqlmock.Sqlmock)
+
+		wantLikes []UserLikeBiz
+		wantErr   error
+	}{
+		{
+			name: "只查有效的点赞，最近点赞的在前面",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(
+					"SELECT * FROM `user_like_bizs` WHERE uid = ? AND status = ? "+
+						"ORDER BY utime DESC, id DESC LIMIT 10 OFFSET 20")).
+					WithArgs(1, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "uid", "biz_id", "biz", "status", "utime"}).
+						AddRow(3, 1, 100, "article", 1, 300).
+						AddRow(2, 1, 101, "article", 1, 200))
+			},
+			wantLikes: []UserLikeBiz{
+				{Id: 3, Uid: 1, BizId: 100, Biz: "article", Status: 1, Utime: 300},
+				{Id: 2, Uid: 1, BizId: 101, Biz: "article", Status: 1, Utime: 200},
+			},
+		},
+		{
+			name: "数据库错误",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT \\* FROM `user_like_bizs` .*").
+					WillReturnError(errors.New("mock db 错误"))
+			},
+			wantErr: errors.New("mock db 错误"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock := newMockDB(t)
+			tc.mock(mock)
+			likes, err := NewGORMInteractiveDAO(db).ListUserLikes(context.Background(), 1, 20, 10)
+			assert.Equal(t, tc.wantErr, err)
+			if err == nil {
+				assert.Equal(t, tc.wantLikes, likes)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGORMInteractiveDAO_ListUserCollections(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(mock sqlmock.Sqlmock)
+
+		wantCollections []UserCollectionBiz
+		wantErr         error
+	}{
+		{
+			name: "不区分收藏夹，最近收藏的在前面",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(
+					"SELECT * FROM `user_collection_bizs` WHERE uid = ? " +
+						"ORDER BY id DESC LIMIT 10 OFFSET 20")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "cid", "biz_id", "biz", "uid"}).
+						AddRow(11, 2, 101, "article", 1).
+						AddRow(10, 0, 100, "article", 1))
+			},
+			wantCollections: []UserCollectionBiz{
+				{Id: 11, Cid: 2, BizId: 101, Biz: "article", Uid: 1},
+				{Id: 10, Cid: 0, BizId: 100, Biz: "article", Uid: 1},
+			},
+		},
+		{
+			name: "数据库错误",
+			mock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery("SELECT \\* FROM `user_collection_bizs` .*").
+					WillReturnError(errors.New("mock db 错误"))
+			},
+			wantErr: errors.New("mock db 错误"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock := newMockDB(t)
+			tc.mock(mock)
+			cs, err := NewGORMInteractiveDAO(db).ListUserCollections(context.Background(), 1, 20, 10)
+			assert.Equal(t, tc.wantErr, err)
+			if err == nil {
+				assert.Equal(t, tc.wantCollections, cs)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 	"webook/interactive/domain"
 	"webook/interactive/repository/cache"
 	"webook/interactive/repository/dao"
@@ -10,15 +11,29 @@ import (
 	"github.com/ecodeclub/ekit/slice"
 )
 
+//go:generate mockgen -source=./interactive.go -package=repomocks -destination=./mocks/interactive.mock.go InteractiveRepository
 type InteractiveRepository interface {
 	IncrReadCnt(ctx context.Context, biz string, bizId int64) error
+	// IncrReadCntBatch bizs、bizIds 和 cnts 一一对应，同一个资源只能出现一次
+	IncrReadCntBatch(ctx context.Context, bizs []string, bizIds []int64, cnts []int64) error
+	// AddUvBatch uids[i] 是在 day 这一天读过 bizs[i]、bizIds[i] 的用户
+	AddUvBatch(ctx context.Context, bizs []string, bizIds []int64, uids [][]int64, day time.Time) error
 	IncrLike(ctx context.Context, biz string, bizId, uid int64) error
 	DecrLike(ctx context.Context, biz string, bizId, uid int64) error
 	AddCollectionItem(ctx context.Context, biz string, bizId, cid int64, uid int64) error
+	DeleteCollectionItem(ctx context.Context, biz string, bizId, uid int64) error
 	Get(ctx context.Context, biz string, bizId int64) (domain.Interactive, error)
 	Liked(ctx context.Context, biz string, id int64, uid int64) (bool, error)
 	Collected(ctx context.Context, biz string, id int64, uid int64) (bool, error)
 	GetByIds(ctx context.Context, biz string, ids []int64) ([]domain.Interactive, error)
+	GetUserData(ctx context.Context, uid int64) ([]domain.UserLike, []domain.UserCollection, error)
+	// ListUserLikes 最近点赞的在前面，UserLike.Ctime 是最近一次点赞的时间
+	ListUserLikes(ctx context.Context, uid int64, offset int, limit int) ([]domain.UserLike, error)
+	ListUserCollections(ctx context.Context, uid int64, offset int, limit int) ([]domain.UserCollection, error)
+	// DeleteUserData 用户注销之后删除他的点赞和收藏
+	DeleteUserData(ctx context.Context, uid int64) error
+	// MergeUserData 账号合并之后把 source 的点赞和收藏转到 target 名下
+	MergeUserData(ctx context.Context, sourceUid int64, targetUid int64) error
 }
 
 type CachedInteractiveRepository struct {
@@ -47,6 +62,28 @@ func (ir *CachedInteractiveRepository) IncrReadCnt(ctx context.Context,
 	return ir.ic.IncrReadCntIfPresent(ctx, biz, bizId)
 }
 
+func (ir *CachedInteractiveRepository) IncrReadCntBatch(ctx context.Context,
+	bizs []string, bizIds []int64, cnts []int64) error {
+	err := ir.id.IncrReadCntBatch(ctx, bizs, bizIds, cnts)
+	if err != nil {
+		return err
+	}
+	// 数据库已经提交了，这时候返回错误会让消费者重试，阅读数就加了两次。
+	// 缓存更新失败了也不要紧，等它过期就好了
+	err = ir.ic.IncrReadCntBatchIfPresent(ctx, bizs, bizIds, cnts)
+	if err != nil {
+		ir.l.Error("批量更新阅读数缓存失败",
+			logger.Int("size", len(bizIds)),
+			logger.Error(err))
+	}
+	return nil
+}
+
+func (ir *CachedInteractiveRepository) AddUvBatch(ctx context.Context,
+	bizs []string, bizIds []int64, uids [][]int64, day time.Time) error {
+	return ir.ic.AddUv(ctx, bizs, bizIds, uids, day)
+}
+
 func (ir *CachedInteractiveRepository) IncrLike(ctx context.Context,
 	biz string, bizId int64, uid int64) error {
 	err := ir.id.InsertLikeInfo(ctx, biz, bizId, uid)
@@ -79,7 +116,31 @@ func (ir *CachedInteractiveRepository) AddCollectionItem(ctx context.Context,
 	return ir.ic.IncrCollectCntIfPresent(ctx, biz, bizId)
 }
 
+func (ir *CachedInteractiveRepository) DeleteCollectionItem(ctx context.Context,
+	biz string, bizId, uid int64) error {
+	err := ir.id.DeleteCollectionBiz(ctx, biz, bizId, uid)
+	if err == dao.ErrRecordNotFound {
+		// 本来就没有收藏
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return ir.ic.DecrCollectCntIfPresent(ctx, biz, bizId)
+}
+
 func (ir *CachedInteractiveRepository) Get(ctx context.Context,
+	biz string, bizId int64) (domain.Interactive, error) {
+	intr, err := ir.get(ctx, biz, bizId)
+	if err != nil {
+		return domain.Interactive{}, err
+	}
+	res := []domain.Interactive{intr}
+	ir.fillUv(ctx, biz, res)
+	return res[0], nil
+}
+
+func (ir *CachedInteractiveRepository) get(ctx context.Context,
 	biz string, bizId int64) (domain.Interactive, error) {
 	intr, err := ir.ic.Get(ctx, biz, bizId)
 	if err == nil {
@@ -100,11 +161,30 @@ func (ir *CachedInteractiveRepository) Get(ctx context.Context,
 		return res, nil
 	}
 	if err == dao.ErrRecordNotFound {
-		return domain.Interactive{}, nil
+		return domain.Interactive{BizId: bizId}, nil
 	}
 	return domain.Interactive{}, err
 }
 
+// fillUv UV 只是展示用的，查不到就当作 0
+func (ir *CachedInteractiveRepository) fillUv(ctx context.Context,
+	biz string, intrs []domain.Interactive) {
+	bizIds := slice.Map(intrs, func(idx int, src domain.Interactive) int64 {
+		return src.BizId
+	})
+	total, today, err := ir.ic.GetUv(ctx, biz, bizIds, time.Now())
+	if err != nil {
+		ir.l.Error("查询 UV 失败",
+			logger.String("biz", biz),
+			logger.Error(err))
+		return
+	}
+	for i := range intrs {
+		intrs[i].UvCnt = total[i]
+		intrs[i].TodayUvCnt = today[i]
+	}
+}
+
 func (ir *CachedInteractiveRepository) Liked(ctx context.Context, biz string, id int64, uid int64) (bool, error) {
 	_, err := ir.id.GetLikeInfo(ctx, biz, id, uid)
 	switch err {
@@ -134,9 +214,11 @@ func (ir *CachedInteractiveRepository) GetByIds(ctx context.Context, biz string,
 	if err != nil {
 		return nil, err
 	}
-	return slice.Map(intrs, func(idx int, src dao.Interactive) domain.Interactive {
+	res := slice.Map(intrs, func(idx int, src dao.Interactive) domain.Interactive {
 		return ir.toDomain(src)
-	}), nil
+	})
+	ir.fillUv(ctx, biz, res)
+	return res, nil
 }
 
 func (ir *CachedInteractiveRepository) toDomain(intr dao.Interactive) domain.Interactive {
@@ -147,3 +229,97 @@ func (ir *CachedInteractiveRepository) toDomain(intr dao.Interactive) domain.Int
 		ReadCnt:    intr.ReadCnt,
 	}
 }
+
+func (ir *CachedInteractiveRepository) GetUserData(ctx context.Context,
+	uid int64) ([]domain.UserLike, []domain.UserCollection, error) {
+	likes, err := ir.id.GetUserLikes(ctx, uid)
+	if err != nil {
+		return nil, nil, err
+	}
+	collections, err := ir.id.GetUserCollections(ctx, uid)
+	if err != nil {
+		return nil, nil, err
+	}
+	return slice.Map(likes, func(idx int, src dao.UserLikeBiz) domain.UserLike {
+			return domain.UserLike{
+				Biz:   src.Biz,
+				BizId: src.BizId,
+				Ctime: time.UnixMilli(src.Ctime),
+			}
+		}), slice.Map(collections, func(idx int, src dao.UserCollectionBiz) domain.UserCollection {
+			return domain.UserCollection{
+				Biz:   src.Biz,
+				BizId: src.BizId,
+				Cid:   src.Cid,
+				Ctime: time.UnixMilli(src.Ctime),
+			}
+		}), nil
+}
+
+func (ir *CachedInteractiveRepository) ListUserLikes(ctx context.Context,
+	uid int64, offset int, limit int) ([]domain.UserLike, error) {
+	likes, err := ir.id.ListUserLikes(ctx, uid, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(likes, func(idx int, src dao.UserLikeBiz) domain.UserLike {
+		return domain.UserLike{
+			Biz:   src.Biz,
+			BizId: src.BizId,
+			Ctime: time.UnixMilli(src.Utime),
+		}
+	}), nil
+}
+
+func (ir *CachedInteractiveRepository) ListUserCollections(ctx context.Context,
+	uid int64, offset int, limit int) ([]domain.UserCollection, error) {
+	collections, err := ir.id.ListUserCollections(ctx, uid, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(collections, func(idx int, src dao.UserCollectionBiz) domain.UserCollection {
+		return domain.UserCollection{
+			Biz:   src.Biz,
+			BizId: src.BizId,
+			Cid:   src.Cid,
+			Ctime: time.UnixMilli(src.Ctime),
+		}
+	}), nil
+}
+
+func (ir *CachedInteractiveRepository) DeleteUserData(ctx context.Context, uid int64) error {
+	likes, collections, err := ir.id.DeleteUserData(ctx, uid)
+	if err != nil {
+		return err
+	}
+	ir.delCntCache(ctx, likes, collections)
+	return nil
+}
+
+func (ir *CachedInteractiveRepository) MergeUserData(ctx context.Context, sourceUid int64, targetUid int64) error {
+	likes, collections, err := ir.id.MergeUserData(ctx, sourceUid, targetUid)
+	if err != nil {
+		return err
+	}
+	ir.delCntCache(ctx, likes, collections)
+	return nil
+}
+
+// delCntCache 计数都变了，直接删掉缓存
+func (ir *CachedInteractiveRepository) delCntCache(ctx context.Context,
+	likes []dao.UserLikeBiz, collections []dao.UserCollectionBiz) {
+	for _, l := range likes {
+		err := ir.ic.Del(ctx, l.Biz, l.BizId)
+		if err != nil {
+			ir.l.Error("删除缓存失败", logger.Error(err),
+				logger.String("biz", l.Biz), logger.Int64("bizId", l.BizId))
+		}
+	}
+	for _, c := range collections {
+		err := ir.ic.Del(ctx, c.Biz, c.BizId)
+		if err != nil {
+			ir.l.Error("删除缓存失败", logger.Error(err),
+				logger.String("biz", c.Biz), logger.Int64("bizId", c.BizId))
+		}
+	}
+}
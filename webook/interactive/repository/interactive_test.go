@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"webook/interactive/domain"
+	"webook/interactive/repository/cache"
+	cachemocks "webook/interactive/repository/cache/mocks"
+	"webook/interactive/repository/dao"
+	daomocks "webook/interactive/repository/dao/mocks"
+	"webook/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCachedInteractiveRepository_IncrReadCntBatch(t *testing.T) {
+	bizs := []string{"article", "article"}
+	bizIds := []int64{1, 2}
+	cnts := []int64{3, 4}
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (dao.InteractiveDAO, cache.InteractiveCache)
+
+		wantErr error
+	}{
+		{
+			name: "更新成功",
+			mock: func(ctrl *gomock.Controller) (dao.InteractiveDAO, cache.InteractiveCache) {
+				id := daomocks.NewMockInteractiveDAO(ctrl)
+				id.EXPECT().IncrReadCntBatch(gomock.Any(), bizs, bizIds, cnts).Return(nil)
+				ic := cachemocks.NewMockInteractiveCache(ctrl)
+				ic.EXPECT().IncrReadCntBatchIfPresent(gomock.Any(), bizs, bizIds, cnts).Return(nil)
+				return id, ic
+			},
+		},
+		{
+			name: "数据库失败，不更新缓存",
+			mock: func(ctrl *gomock.Controller) (dao.InteractiveDAO, cache.InteractiveCache) {
+				id := daomocks.NewMockInteractiveDAO(ctrl)
+				id.EXPECT().IncrReadCntBatch(gomock.Any(), bizs, bizIds, cnts).
+					Return(errors.New("mock db 错误"))
+				return id, cachemocks.NewMockInteractiveCache(ctrl)
+			},
+			wantErr: errors.New("mock db 错误"),
+		},
+		{
+			name: "缓存失败，不返回错误",
+			mock: func(ctrl *gomock.Controller) (dao.InteractiveDAO, cache.InteractiveCache) {
+				id := daomocks.NewMockInteractiveDAO(ctrl)
+				id.EXPECT().IncrReadCntBatch(gomock.Any(), bizs, bizIds, cnts).Return(nil)
+				ic := cachemocks.NewMockInteractiveCache(ctrl)
+				ic.EXPECT().IncrReadCntBatchIfPresent(gomock.Any(), bizs, bizIds, cnts).
+					Return(errors.New("mock redis 错误"))
+				return id, ic
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			id, ic := tc.mock(ctrl)
+			repo := NewCachedInteractiveRepository(id, ic, logger.NewNopLogger())
+			err := repo.IncrReadCntBatch(context.Background(), bizs, bizIds, cnts)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+func TestCachedInteractiveRepository_GetByIds(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (dao.InteractiveDAO, cache.InteractiveCache)
+
+		wantIntrs []domain.Interactive
+		wantErr   error
+	}{
+		{
+			name: "查询成功，带上 UV",
+			mock: func(ctrl *gomock.Controller) (dao.InteractiveDAO, cache.InteractiveCache) {
+				id := daomocks.NewMockInteractiveDAO(ctrl)
+				id.EXPECT().GetByIds(gomock.Any(), "article", []int64{1, 2}).
+					Return([]dao.Interactive{
+						{BizId: 1, ReadCnt: 10},
+						{BizId: 2, ReadCnt: 20},
+					}, nil)
+				ic := cachemocks.NewMockInteractiveCache(ctrl)
+				ic.EXPECT().GetUv(gomock.Any(), "article", []int64{1, 2}, gomock.Any()).
+					Return([]int64{5, 8}, []int64{1, 2}, nil)
+				return id, ic
+			},
+			wantIntrs: []domain.Interactive{
+				{BizId: 1, ReadCnt: 10, UvCnt: 5, TodayUvCnt: 1},
+				{BizId: 2, ReadCnt: 20, UvCnt: 8, TodayUvCnt: 2},
+			},
+		},
+		{
+			name: "查询 UV 失败，当作 0",
+			mock: func(ctrl *gomock.Controller) (dao.InteractiveDAO, cache.InteractiveCache) {
+				id := daomocks.NewMockInteractiveDAO(ctrl)
+				id.EXPECT().GetByIds(gomock.Any(), "article", []int64{1, 2}).
+					Return([]dao.Interactive{
+						{BizId: 1, ReadCnt: 10},
+						{BizId: 2, ReadCnt: 20},
+					}, nil)
+				ic := cachemocks.NewMockInteractiveCache(ctrl)
+				ic.EXPECT().GetUv(gomock.Any(), "article", []int64{1, 2}, gomock.Any()).
+					Return(nil, nil, errors.New("mock redis 错误"))
+				return id, ic
+			},
+			wantIntrs: []domain.Interactive{
+				{BizId: 1, ReadCnt: 10},
+				{BizId: 2, ReadCnt: 20},
+			},
+		},
+		{
+			name: "数据库失败",
+			mock: func(ctrl *gomock.Controller) (dao.InteractiveDAO, cache.InteractiveCache) {
+				id := daomocks.NewMockInteractiveDAO(ctrl)
+				id.EXPECT().GetByIds(gomock.Any(), "article", []int64{1, 2}).
+					Return(nil, errors.New("mock db 错误"))
+				return id, cachemocks.NewMockInteractiveCache(ctrl)
+			},
+			wantErr: errors.New("mock db 错误"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			id, ic := tc.mock(ctrl)
+			repo := NewCachedInteractiveRepository(id, ic, logger.NewNopLogger())
+			intrs, err := repo.GetByIds(context.Background(), "article", []int64{1, 2})
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantIntrs, intrs)
+		})
+	}
+}
+
+func TestCachedInteractiveRepository_AddUvBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// 按照阅读的那一天记，不是处理的时候
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.Local)
+	ic := cachemocks.NewMockInteractiveCache(ctrl)
+	ic.EXPECT().AddUv(gomock.Any(), []string{"article"}, []int64{1}, [][]int64{{11}}, day).
+		Return(nil)
+	repo := NewCachedInteractiveRepository(daomocks.NewMockInteractiveDAO(ctrl), ic, logger.NewNopLogger())
+	err := repo.AddUvBatch(context.Background(), []string{"article"}, []int64{1}, [][]int64{{11}}, day)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"webook/interactive/domain"
+	"webook/interactive/repository"
+)
+
+var (
+	// ErrCollectionNotFound 别人的收藏夹在修改的时候，以及别人的私有收藏夹在查看的时候，也当作不存在
+	ErrCollectionNotFound = repository.ErrCollectionNotFound
+	// ErrInvalidCollection 名字为空或者可见性不对
+	ErrInvalidCollection = errors.New("收藏夹名字或者可见性不对")
+	// ErrInvalidPage 分页参数不对，一次最多查 maxPageLimit 条
+	ErrInvalidPage = errors.New("分页参数不对")
+)
+
+const maxPageLimit = 100
+
+//go:generate mockgen -source=./collection.go -package=svcmocks -destination=./mocks/collection.mock.go CollectionService
+type CollectionService interface {
+	// Save 创建或者修改收藏夹，c.Uid 是收藏夹的主人
+	Save(ctx context.Context, c domain.Collection) (int64, error)
+	// Delete 删除收藏夹，里面收藏的东西也一起取消收藏
+	Delete(ctx context.Context, uid int64, id int64) error
+	// Get viewer 是查看的人
+	Get(ctx context.Context, id int64, viewer int64) (domain.Collection, error)
+	// List uid 的收藏夹，viewer 不是 uid 本人的时候只返回公开的
+	List(ctx context.Context, uid int64, viewer int64, offset int, limit int) ([]domain.Collection, error)
+	// ListItems uid 的 cid 收藏夹里面的东西，cid 为 0 的默认收藏夹只有自己能看
+	ListItems(ctx context.Context, uid int64, cid int64, viewer int64, offset int, limit int) ([]domain.UserCollection, error)
+	// MoveItem 把收藏的东西挪到 cid 这个收藏夹
+	MoveItem(ctx context.Context, biz string, bizId, uid, cid int64) error
+}
+
+type collectionService struct {
+	repo repository.CollectionRepository
+}
+
+func NewCollectionService(repo repository.CollectionRepository) CollectionService {
+	return &collectionService{
+		repo: repo,
+	}
+}
+
+func (s *collectionService) Save(ctx context.Context, c domain.Collection) (int64, error) {
+	if c.Name == "" || !c.Visibility.Valid() {
+		return 0, ErrInvalidCollection
+	}
+	if c.Id > 0 {
+		return c.Id, s.repo.Update(ctx, c)
+	}
+	return s.repo.Create(ctx, c)
+}
+
+func (s *collectionService) Delete(ctx context.Context, uid int64, id int64) error {
+	return s.repo.Delete(ctx, uid, id)
+}
+
+func (s *collectionService) Get(ctx context.Context, id int64, viewer int64) (domain.Collection, error) {
+	c, err := s.repo.FindById(ctx, id)
+	if err != nil {
+		return domain.Collection{}, err
+	}
+	if c.Uid != viewer && c.Visibility != domain.CollectionVisibilityPublic {
+		return domain.Collection{}, ErrCollectionNotFound
+	}
+	return c, nil
+}
+
+func (s *collectionService) List(ctx context.Context, uid int64, viewer int64,
+	offset int, limit int) ([]domain.Collection, error) {
+	if !validPage(offset, limit) {
+		return nil, ErrInvalidPage
+	}
+	return s.repo.FindByUid(ctx, uid, uid != viewer, offset, limit)
+}
+
+func (s *collectionService) ListItems(ctx context.Context, uid int64, cid int64,
+	viewer int64, offset int, limit int) ([]domain.UserCollection, error) {
+	if !validPage(offset, limit) {
+		return nil, ErrInvalidPage
+	}
+	if cid == 0 {
+		if uid != viewer {
+			return nil, ErrCollectionNotFound
+		}
+		return s.repo.FindItems(ctx, uid, cid, offset, limit)
+	}
+	c, err := s.Get(ctx, cid, viewer)
+	if err != nil {
+		return nil, err
+	}
+	if c.Uid != uid {
+		return nil, ErrCollectionNotFound
+	}
+	return s.repo.FindItems(ctx, uid, cid, offset, limit)
+}
+
+func (s *collectionService) MoveItem(ctx context.Context, biz string, bizId, uid, cid int64) error {
+	return s.repo.MoveItem(ctx, biz, bizId, uid, cid)
+}
+
+func validPage(offset int, limit int) bool {
+	return offset >= 0 && limit > 0 && limit <= maxPageLimit
+}
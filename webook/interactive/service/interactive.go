@@ -15,9 +15,22 @@ type InteractiveService interface {
 	Like(ctx context.Context, biz string, bizId int64, uid int64) error
 	// CancelLike 取消点赞
 	CancelLike(ctx context.Context, biz string, bizId int64, uid int64) error
+	// Collect 收藏到 cid 这个收藏夹，cid 为 0 是默认收藏夹
 	Collect(ctx context.Context, biz string, bizId, cid, uid int64) error
+	// CancelCollect 取消收藏，没有收藏过也不会报错
+	CancelCollect(ctx context.Context, biz string, bizId, uid int64) error
 	Get(ctx context.Context, biz string, bizId, uid int64) (domain.Interactive, error)
 	GetByIds(ctx context.Context, biz string, ids []int64) (map[int64]domain.Interactive, error)
+	// GetUserData 用户所有的点赞和收藏
+	GetUserData(ctx context.Context, uid int64) ([]domain.UserLike, []domain.UserCollection, error)
+	// ListUserLikes 我的点赞，不区分 biz，最近点赞的在前面
+	ListUserLikes(ctx context.Context, uid int64, offset int, limit int) ([]domain.UserLike, error)
+	// ListUserCollections 我的收藏，不区分 biz 和收藏夹，最近收藏的在前面
+	ListUserCollections(ctx context.Context, uid int64, offset int, limit int) ([]domain.UserCollection, error)
+	// DeleteUserData 用户注销之后删除他的点赞和收藏
+	DeleteUserData(ctx context.Context, uid int64) error
+	// MergeUserData 账号合并之后把 source 的点赞和收藏转到 target 名下
+	MergeUserData(ctx context.Context, sourceUid int64, targetUid int64) error
 }
 
 type interactiveService struct {
@@ -47,6 +60,11 @@ func (is *interactiveService) Collect(ctx context.Context,
 	return is.ir.AddCollectionItem(ctx, biz, bizId, cid, uid)
 }
 
+func (is *interactiveService) CancelCollect(ctx context.Context,
+	biz string, bizId, uid int64) error {
+	return is.ir.DeleteCollectionItem(ctx, biz, bizId, uid)
+}
+
 func (is *interactiveService) Get(
 	ctx context.Context, biz string, bizId, uid int64) (domain.Interactive, error) {
 	// 你也可以考虑将分发的逻辑也下沉到 repository 里面
@@ -78,3 +96,26 @@ func (is *interactiveService) GetByIds(ctx context.Context,
 	}
 	return res, nil
 }
+
+func (is *interactiveService) GetUserData(ctx context.Context,
+	uid int64) ([]domain.UserLike, []domain.UserCollection, error) {
+	return is.ir.GetUserData(ctx, uid)
+}
+
+func (is *interactiveService) ListUserLikes(ctx context.Context,
+	uid int64, offset int, limit int) ([]domain.UserLike, error) {
+	return is.ir.ListUserLikes(ctx, uid, offset, limit)
+}
+
+func (is *interactiveService) ListUserCollections(ctx context.Context,
+	uid int64, offset int, limit int) ([]domain.UserCollection, error) {
+	return is.ir.ListUserCollections(ctx, uid, offset, limit)
+}
+
+func (is *interactiveService) DeleteUserData(ctx context.Context, uid int64) error {
+	return is.ir.DeleteUserData(ctx, uid)
+}
+
+func (is *interactiveService) MergeUserData(ctx context.Context, sourceUid int64, targetUid int64) error {
+	return is.ir.MergeUserData(ctx, sourceUid, targetUid)
+}
@@ -21,7 +21,9 @@ var thirdPartySet = wire.NewSet(ioc.InitSrcDB,
 	ioc.InitLogger,
 	ioc.InitSaramaClient,
 	ioc.InitSaramaSyncProducer,
-	ioc.InitRedis)
+	ioc.InitRedis,
+	ioc.InitRBACPolicy,
+	ioc.InitJWTKeySet)
 
 var interactiveSvcSet = wire.NewSet(
 	dao.NewGORMInteractiveDAO,
@@ -30,11 +32,20 @@ var interactiveSvcSet = wire.NewSet(
 	service.NewInteractiveService,
 )
 
+var collectionSvcSet = wire.NewSet(
+	dao.NewGORMCollectionDAO,
+	repository.NewCachedCollectionRepository,
+	service.NewCollectionService,
+)
+
 func InitApp() *App {
 	wire.Build(thirdPartySet,
 		interactiveSvcSet,
+		collectionSvcSet,
 		grpc.NewInteractiveServiceServer,
 		events.NewInteractiveReadEventConsumer,
+		events.NewUserDeletedEventConsumer,
+		events.NewUserMergedEventConsumer,
 		ioc.InitInteractiveProducer,
 		ioc.InitFixerConsumer,
 		ioc.InitConsumers,
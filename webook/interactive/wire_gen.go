@@ -34,15 +34,22 @@ func InitApp() *App {
 	cmdable := ioc.InitRedis()
 	interactiveCache := cache.NewRedisInteractiveCache(cmdable)
 	interactiveRepository := repository.NewCachedInteractiveRepository(interactiveDAO, interactiveCache, loggerV1)
-	interactiveReadEventConsumer := events.NewInteractiveReadEventConsumer(client, loggerV1, interactiveRepository)
-	consumer := ioc.InitFixerConsumer(client, loggerV1, srcDB, dstDB)
-	v := ioc.InitConsumers(interactiveReadEventConsumer, consumer)
-	interactiveService := service.NewInteractiveService(interactiveRepository)
-	interactiveServiceServer := grpc.NewInteractiveServiceServer(interactiveService)
-	server := ioc.NewGrpcxServer(interactiveServiceServer, loggerV1)
 	syncProducer := ioc.InitSaramaSyncProducer(client)
+	interactiveReadEventConsumer := events.NewInteractiveReadEventConsumer(client, syncProducer, loggerV1, interactiveRepository)
+	interactiveService := service.NewInteractiveService(interactiveRepository)
+	userDeletedEventConsumer := events.NewUserDeletedEventConsumer(client, syncProducer, loggerV1, interactiveService)
+	userMergedEventConsumer := events.NewUserMergedEventConsumer(client, syncProducer, loggerV1, interactiveService)
+	consumer := ioc.InitFixerConsumer(client, syncProducer, loggerV1, srcDB, dstDB)
+	v := ioc.InitConsumers(interactiveReadEventConsumer, userDeletedEventConsumer, userMergedEventConsumer, consumer)
+	collectionDAO := dao.NewGORMCollectionDAO(db)
+	collectionRepository := repository.NewCachedCollectionRepository(collectionDAO, interactiveCache, loggerV1)
+	collectionService := service.NewCollectionService(collectionRepository)
+	interactiveServiceServer := grpc.NewInteractiveServiceServer(interactiveService, collectionService)
+	policy := ioc.InitRBACPolicy()
+	remoteKeySet := ioc.InitJWTKeySet()
+	server := ioc.NewGrpcxServer(interactiveServiceServer, loggerV1, policy, remoteKeySet)
 	producer := ioc.InitInteractiveProducer(syncProducer)
-	ginxServer := ioc.InitGinxServer(loggerV1, srcDB, dstDB, doubleWritePool, producer)
+	ginxServer := ioc.InitGinxServer(loggerV1, srcDB, dstDB, doubleWritePool, producer, policy, remoteKeySet)
 	app := &App{
 		consumers:   v,
 		server:      server,
@@ -53,6 +60,8 @@ func InitApp() *App {
 
 // wire.go:
 
-var thirdPartySet = wire.NewSet(ioc.InitSrcDB, ioc.InitDstDB, ioc.InitDoubleWritePool, ioc.InitBizDB, ioc.InitLogger, ioc.InitSaramaClient, ioc.InitSaramaSyncProducer, ioc.InitRedis)
+var thirdPartySet = wire.NewSet(ioc.InitSrcDB, ioc.InitDstDB, ioc.InitDoubleWritePool, ioc.InitBizDB, ioc.InitLogger, ioc.InitSaramaClient, ioc.InitSaramaSyncProducer, ioc.InitRedis, ioc.InitRBACPolicy, ioc.InitJWTKeySet)
 
 var interactiveSvcSet = wire.NewSet(dao.NewGORMInteractiveDAO, cache.NewRedisInteractiveCache, repository.NewCachedInteractiveRepository, service.NewInteractiveService)
+
+var collectionSvcSet = wire.NewSet(dao.NewGORMCollectionDAO, repository.NewCachedCollectionRepository, service.NewCollectionService)
@@ -40,6 +40,46 @@ func (i *InteractiveClient) GetByIds(ctx context.Context, in *intrv1.GetByIdsReq
 	return i.selectClient().GetByIds(ctx, in, opts...)
 }
 
+func (i *InteractiveClient) GetUserData(ctx context.Context, in *intrv1.GetUserDataRequest, opts ...grpc.CallOption) (*intrv1.GetUserDataResponse, error) {
+	return i.selectClient().GetUserData(ctx, in, opts...)
+}
+
+func (i *InteractiveClient) ListUserLikes(ctx context.Context, in *intrv1.ListUserLikesRequest, opts ...grpc.CallOption) (*intrv1.ListUserLikesResponse, error) {
+	return i.selectClient().ListUserLikes(ctx, in, opts...)
+}
+
+func (i *InteractiveClient) ListUserCollections(ctx context.Context, in *intrv1.ListUserCollectionsRequest, opts ...grpc.CallOption) (*intrv1.ListUserCollectionsResponse, error) {
+	return i.selectClient().ListUserCollections(ctx, in, opts...)
+}
+
+func (i *InteractiveClient) CancelCollect(ctx context.Context, in *intrv1.CancelCollectRequest, opts ...grpc.CallOption) (*intrv1.CancelCollectResponse, error) {
+	return i.selectClient().CancelCollect(ctx, in, opts...)
+}
+
+func (i *InteractiveClient) SaveCollection(ctx context.Context, in *intrv1.SaveCollectionRequest, opts ...grpc.CallOption) (*intrv1.SaveCollectionResponse, error) {
+	return i.selectClient().SaveCollection(ctx, in, opts...)
+}
+
+func (i *InteractiveClient) DeleteCollection(ctx context.Context, in *intrv1.DeleteCollectionRequest, opts ...grpc.CallOption) (*intrv1.DeleteCollectionResponse, error) {
+	return i.selectClient().DeleteCollection(ctx, in, opts...)
+}
+
+func (i *InteractiveClient) GetCollection(ctx context.Context, in *intrv1.GetCollectionRequest, opts ...grpc.CallOption) (*intrv1.GetCollectionResponse, error) {
+	return i.selectClient().GetCollection(ctx, in, opts...)
+}
+
+func (i *InteractiveClient) ListCollections(ctx context.Context, in *intrv1.ListCollectionsRequest, opts ...grpc.CallOption) (*intrv1.ListCollectionsResponse, error) {
+	return i.selectClient().ListCollections(ctx, in, opts...)
+}
+
+func (i *InteractiveClient) ListCollectionItems(ctx context.Context, in *intrv1.ListCollectionItemsRequest, opts ...grpc.CallOption) (*intrv1.ListCollectionItemsResponse, error) {
+	return i.selectClient().ListCollectionItems(ctx, in, opts...)
+}
+
+func (i *InteractiveClient) MoveCollectionItem(ctx context.Context, in *intrv1.MoveCollectionItemRequest, opts ...grpc.CallOption) (*intrv1.MoveCollectionItemResponse, error) {
+	return i.selectClient().MoveCollectionItem(ctx, in, opts...)
+}
+
 func (i *InteractiveClient) selectClient() intrv1.InteractiveServiceClient {
 	// [0, 100) 的随机数
 	num := rand.Int31n(100)
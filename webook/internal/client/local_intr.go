@@ -4,17 +4,20 @@ import (
 	"context"
 	intrv1 "webook/api/proto/gen/intr/v1"
 	"webook/interactive/domain"
+	intrgrpc "webook/interactive/grpc"
 	"webook/interactive/service"
 
 	"google.golang.org/grpc"
 )
 
 type LocalInteractiveServiceAdapter struct {
-	svc service.InteractiveService
+	svc  service.InteractiveService
+	csvc service.CollectionService
 }
 
-func NewLocalInteractiveServiceAdapter(svc service.InteractiveService) *LocalInteractiveServiceAdapter {
-	return &LocalInteractiveServiceAdapter{svc: svc}
+func NewLocalInteractiveServiceAdapter(svc service.InteractiveService,
+	csvc service.CollectionService) *LocalInteractiveServiceAdapter {
+	return &LocalInteractiveServiceAdapter{svc: svc, csvc: csvc}
 }
 
 func (l *LocalInteractiveServiceAdapter) IncrReadCnt(ctx context.Context, in *intrv1.IncrReadCntRequest, opts ...grpc.CallOption) (*intrv1.IncrReadCntResponse, error) {
@@ -34,7 +37,12 @@ func (l *LocalInteractiveServiceAdapter) CancelLike(ctx context.Context, in *int
 
 func (l *LocalInteractiveServiceAdapter) Collect(ctx context.Context, in *intrv1.CollectRequest, opts ...grpc.CallOption) (*intrv1.CollectResponse, error) {
 	err := l.svc.Collect(ctx, in.GetBiz(), in.GetBizId(), in.GetCid(), in.GetUid())
-	return &intrv1.CollectResponse{}, err
+	return &intrv1.CollectResponse{}, intrgrpc.ToStatus(err)
+}
+
+func (l *LocalInteractiveServiceAdapter) CancelCollect(ctx context.Context, in *intrv1.CancelCollectRequest, opts ...grpc.CallOption) (*intrv1.CancelCollectResponse, error) {
+	err := l.svc.CancelCollect(ctx, in.GetBiz(), in.GetBizId(), in.GetUid())
+	return &intrv1.CancelCollectResponse{}, err
 }
 
 func (l *LocalInteractiveServiceAdapter) Get(ctx context.Context, in *intrv1.GetRequest, opts ...grpc.CallOption) (*intrv1.GetResponse, error) {
@@ -61,6 +69,147 @@ func (l *LocalInteractiveServiceAdapter) GetByIds(ctx context.Context, in *intrv
 	}, nil
 }
 
+func (l *LocalInteractiveServiceAdapter) GetUserData(ctx context.Context, in *intrv1.GetUserDataRequest, opts ...grpc.CallOption) (*intrv1.GetUserDataResponse, error) {
+	likes, collections, err := l.svc.GetUserData(ctx, in.GetUid())
+	if err != nil {
+		return nil, err
+	}
+	res := &intrv1.GetUserDataResponse{
+		Likes:       make([]*intrv1.UserLike, 0, len(likes)),
+		Collections: make([]*intrv1.UserCollection, 0, len(collections)),
+	}
+	for _, like := range likes {
+		res.Likes = append(res.Likes, l.toUserLikeDTO(like))
+	}
+	for _, c := range collections {
+		res.Collections = append(res.Collections, l.toUserCollectionDTO(c))
+	}
+	return res, nil
+}
+
+func (l *LocalInteractiveServiceAdapter) ListUserLikes(ctx context.Context, in *intrv1.ListUserLikesRequest, opts ...grpc.CallOption) (*intrv1.ListUserLikesResponse, error) {
+	likes, err := l.svc.ListUserLikes(ctx, in.GetUid(), int(in.GetOffset()), int(in.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+	res := &intrv1.ListUserLikesResponse{
+		Likes: make([]*intrv1.UserLike, 0, len(likes)),
+	}
+	for _, like := range likes {
+		res.Likes = append(res.Likes, l.toUserLikeDTO(like))
+	}
+	return res, nil
+}
+
+func (l *LocalInteractiveServiceAdapter) ListUserCollections(ctx context.Context, in *intrv1.ListUserCollectionsRequest, opts ...grpc.CallOption) (*intrv1.ListUserCollectionsResponse, error) {
+	collections, err := l.svc.ListUserCollections(ctx, in.GetUid(), int(in.GetOffset()), int(in.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+	res := &intrv1.ListUserCollectionsResponse{
+		Collections: make([]*intrv1.UserCollection, 0, len(collections)),
+	}
+	for _, c := range collections {
+		res.Collections = append(res.Collections, l.toUserCollectionDTO(c))
+	}
+	return res, nil
+}
+
+func (l *LocalInteractiveServiceAdapter) SaveCollection(ctx context.Context, in *intrv1.SaveCollectionRequest, opts ...grpc.CallOption) (*intrv1.SaveCollectionResponse, error) {
+	c := in.GetCollection()
+	id, err := l.csvc.Save(ctx, domain.Collection{
+		Id:          c.GetId(),
+		Uid:         c.GetUid(),
+		Name:        c.GetName(),
+		Description: c.GetDescription(),
+		Visibility:  domain.CollectionVisibility(c.GetVisibility()),
+	})
+	if err != nil {
+		return nil, intrgrpc.ToStatus(err)
+	}
+	return &intrv1.SaveCollectionResponse{Id: id}, nil
+}
+
+func (l *LocalInteractiveServiceAdapter) DeleteCollection(ctx context.Context, in *intrv1.DeleteCollectionRequest, opts ...grpc.CallOption) (*intrv1.DeleteCollectionResponse, error) {
+	err := l.csvc.Delete(ctx, in.GetUid(), in.GetId())
+	return &intrv1.DeleteCollectionResponse{}, intrgrpc.ToStatus(err)
+}
+
+func (l *LocalInteractiveServiceAdapter) GetCollection(ctx context.Context, in *intrv1.GetCollectionRequest, opts ...grpc.CallOption) (*intrv1.GetCollectionResponse, error) {
+	c, err := l.csvc.Get(ctx, in.GetId(), in.GetViewer())
+	if err != nil {
+		return nil, intrgrpc.ToStatus(err)
+	}
+	return &intrv1.GetCollectionResponse{
+		Collection: l.toCollectionDTO(c),
+	}, nil
+}
+
+func (l *LocalInteractiveServiceAdapter) ListCollections(ctx context.Context, in *intrv1.ListCollectionsRequest, opts ...grpc.CallOption) (*intrv1.ListCollectionsResponse, error) {
+	cs, err := l.csvc.List(ctx, in.GetUid(), in.GetViewer(),
+		int(in.GetOffset()), int(in.GetLimit()))
+	if err != nil {
+		return nil, intrgrpc.ToStatus(err)
+	}
+	res := &intrv1.ListCollectionsResponse{
+		Collections: make([]*intrv1.Collection, 0, len(cs)),
+	}
+	for _, c := range cs {
+		res.Collections = append(res.Collections, l.toCollectionDTO(c))
+	}
+	return res, nil
+}
+
+func (l *LocalInteractiveServiceAdapter) ListCollectionItems(ctx context.Context, in *intrv1.ListCollectionItemsRequest, opts ...grpc.CallOption) (*intrv1.ListCollectionItemsResponse, error) {
+	items, err := l.csvc.ListItems(ctx, in.GetUid(), in.GetCid(), in.GetViewer(),
+		int(in.GetOffset()), int(in.GetLimit()))
+	if err != nil {
+		return nil, intrgrpc.ToStatus(err)
+	}
+	res := &intrv1.ListCollectionItemsResponse{
+		Items: make([]*intrv1.UserCollection, 0, len(items)),
+	}
+	for _, item := range items {
+		res.Items = append(res.Items, l.toUserCollectionDTO(item))
+	}
+	return res, nil
+}
+
+func (l *LocalInteractiveServiceAdapter) MoveCollectionItem(ctx context.Context, in *intrv1.MoveCollectionItemRequest, opts ...grpc.CallOption) (*intrv1.MoveCollectionItemResponse, error) {
+	err := l.csvc.MoveItem(ctx, in.GetBiz(), in.GetBizId(), in.GetUid(), in.GetCid())
+	return &intrv1.MoveCollectionItemResponse{}, intrgrpc.ToStatus(err)
+}
+
+func (l *LocalInteractiveServiceAdapter) toUserLikeDTO(like domain.UserLike) *intrv1.UserLike {
+	return &intrv1.UserLike{
+		Biz:   like.Biz,
+		BizId: like.BizId,
+		Ctime: like.Ctime.UnixMilli(),
+	}
+}
+
+func (l *LocalInteractiveServiceAdapter) toUserCollectionDTO(c domain.UserCollection) *intrv1.UserCollection {
+	return &intrv1.UserCollection{
+		Biz:   c.Biz,
+		BizId: c.BizId,
+		Cid:   c.Cid,
+		Ctime: c.Ctime.UnixMilli(),
+	}
+}
+
+func (l *LocalInteractiveServiceAdapter) toCollectionDTO(c domain.Collection) *intrv1.Collection {
+	return &intrv1.Collection{
+		Id:          c.Id,
+		Uid:         c.Uid,
+		Name:        c.Name,
+		Description: c.Description,
+		Visibility:  int32(c.Visibility),
+		ItemCnt:     c.ItemCnt,
+		Ctime:       c.Ctime.UnixMilli(),
+		Utime:       c.Utime.UnixMilli(),
+	}
+}
+
 func (l *LocalInteractiveServiceAdapter) toDTO(intr domain.Interactive) *intrv1.Interactive {
 	return &intrv1.Interactive{
 		Biz:        intr.Biz,
@@ -70,5 +219,7 @@ func (l *LocalInteractiveServiceAdapter) toDTO(intr domain.Interactive) *intrv1.
 		Collected:  intr.Collected,
 		Liked:      intr.Liked,
 		LikeCnt:    intr.LikeCnt,
+		UvCnt:      intr.UvCnt,
+		TodayUvCnt: intr.TodayUvCnt,
 	}
 }
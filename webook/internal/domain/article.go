@@ -1,6 +1,14 @@
 package domain
 
-import "time"
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"webook/pkg/diffx"
+	"webook/pkg/mdx"
+	"webook/pkg/seox"
+)
 
 type Article struct {
 	Id      int64
@@ -8,12 +16,49 @@ type Article struct {
 	Content string
 	Author  Author
 	Status  ArticleStatus
-	Ctime   time.Time
-	Utime   time.Time
+	// Version 乐观锁，保存的时候要带上读到的版本号，
+	// 保存成功之后就是 Version + 1
+	Version int64
+	// PublishAt 定时发表的时间
+	PublishAt time.Time
+	// Cover 封面，数据库里面只有 ID
+	Cover Media
+	// Rendered 发表的时候渲染，草稿没有
+	Rendered ArticleRendered
+	Ctime    time.Time
+	Utime    time.Time
+}
+
+// ArticleRendered Content 按照 Markdown 渲染之后的结果
+type ArticleRendered struct {
+	// Html 已经过滤过 XSS 了
+	Html string
+	Toc  []mdx.Heading
+	// Abstract 去掉了标记的摘要
+	Abstract string
 }
 
 func (a Article) Abstract() string {
-	str := []rune(a.Content)
+	// 发表过的文章已经算好了
+	if a.Rendered.Abstract != "" {
+		return a.Rendered.Abstract
+	}
+	return abstract(mdx.PlainText(a.Content))
+}
+
+// TextStats 正文去掉 Markdown 标记之后的字数
+func (a Article) TextStats() seox.TextStats {
+	return seox.Count(mdx.PlainText(a.Content))
+}
+
+// Slug 链接里面 ID 后面的那一段，只是为了好看和 SEO，查询只用 ID
+func (a Article) Slug() string {
+	return seox.Slug(a.Title)
+}
+
+// abstract 只取部分纯文本作为摘要
+func abstract(text string) string {
+	str := []rune(text)
 	// 只取部分作为摘要
 	if len(str) > 128 {
 		str = str[:128]
@@ -21,6 +66,51 @@ func (a Article) Abstract() string {
 	return string(str)
 }
 
+// mediaRefPattern 正文里面用 ![说明](media:123) 引用上传的文件
+var mediaRefPattern = regexp.MustCompile(`\(media:(\d+)\)`)
+
+// MediaIds 封面和正文里面引用的文件，已经去重
+func (a Article) MediaIds() []int64 {
+	var res []int64
+	seen := map[int64]struct{}{}
+	add := func(id int64) {
+		if _, ok := seen[id]; ok || id <= 0 {
+			return
+		}
+		seen[id] = struct{}{}
+		res = append(res, id)
+	}
+	add(a.Cover.Id)
+	for _, m := range mediaRefPattern.FindAllStringSubmatch(a.Content, -1) {
+		id, err := strconv.ParseInt(m[1], 10, 64)
+		if err == nil {
+			add(id)
+		}
+	}
+	return res
+}
+
+// RenderArticle 把 Markdown 渲染成 HTML，同时生成目录和摘要。
+// media:ID 会被换成 media 里面的地址，不在 media 里面的会被过滤掉
+func RenderArticle(content string, media map[int64]Media) (ArticleRendered, error) {
+	doc, err := mdx.Render(content, mdx.WithLinkResolver(func(dest string) string {
+		idStr, ok := strings.CutPrefix(dest, "media:")
+		if !ok {
+			return ""
+		}
+		id, _ := strconv.ParseInt(idStr, 10, 64)
+		return media[id].Url
+	}))
+	if err != nil {
+		return ArticleRendered{}, err
+	}
+	return ArticleRendered{
+		Html:     doc.HTML,
+		Toc:      doc.TOC,
+		Abstract: abstract(doc.Text),
+	}, nil
+}
+
 type ArticleStatus uint8
 
 func (s ArticleStatus) ToUint8() uint8 {
@@ -36,9 +126,49 @@ const (
 	ArticleStatusPublished
 	// ArticleStatusPrivate 仅自己可见
 	ArticleStatusPrivate
+	// ArticleStatusScheduled 定时发表，到了 PublishAt 之后会自动发表
+	ArticleStatusScheduled
+	// ArticleStatusPendingReview 没有通过自动审核，等人工审核。
+	// 通过之后发表，如果 PublishAt 还没到就变成定时发表
+	ArticleStatusPendingReview
 )
 
 type Author struct {
 	Id   int64
 	Name string
 }
+
+type RevisionKind uint8
+
+func (k RevisionKind) ToUint8() uint8 {
+	return uint8(k)
+}
+
+const (
+	RevisionKindUnknown RevisionKind = iota
+	// RevisionKindSave 保存草稿，恢复历史版本也算保存
+	RevisionKindSave
+	// RevisionKindPublish 发表
+	RevisionKindPublish
+)
+
+// ArticleRevision 文章某一次保存或者发表之后的样子
+type ArticleRevision struct {
+	Id        int64
+	ArticleId int64
+	Author    Author
+	Title     string
+	Content   string
+	// Status 产生这个版本的时候文章的状态
+	Status ArticleStatus
+	Kind   RevisionKind
+	Ctime  time.Time
+}
+
+// ArticleDiff 两个历史版本之间的差异
+type ArticleDiff struct {
+	From    ArticleRevision
+	To      ArticleRevision
+	Title   []diffx.Line
+	Content []diffx.Line
+}
@@ -0,0 +1,37 @@
+package domain
+
+import "time"
+
+type ArticleReviewStatus uint8
+
+const (
+	ArticleReviewStatusUnknown ArticleReviewStatus = iota
+	ArticleReviewStatusPending
+	ArticleReviewStatusApproved
+	ArticleReviewStatusRejected
+	// ArticleReviewStatusCanceled 作者在审核期间改了文章，或者又提交了一次
+	ArticleReviewStatusCanceled
+)
+
+func (s ArticleReviewStatus) ToUint8() uint8 {
+	return uint8(s)
+}
+
+// ArticleReview 人工审核的一条记录，每次没有通过自动审核的发表都会产生一条
+type ArticleReview struct {
+	Id        int64
+	ArticleId int64
+	Author    Author
+	// Version 提交审核的时候文章的版本号，审核的是这个版本的内容
+	Version int64
+	Title   string
+	// Reasons 自动审核没有通过的理由
+	Reasons []string
+	Status  ArticleReviewStatus
+	// Reviewer 审核的人，还没审核就是 0
+	Reviewer int64
+	// Comment 审核的人写的意见，拒绝的时候会告诉作者
+	Comment string
+	Ctime   time.Time
+	Utime   time.Time
+}
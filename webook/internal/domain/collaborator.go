@@ -0,0 +1,86 @@
+package domain
+
+import "time"
+
+// ArticleRole 用户对一篇草稿的权限，数值越大权限越大
+type ArticleRole uint8
+
+const (
+	ArticleRoleNone ArticleRole = iota
+	// ArticleRoleViewer 只能看草稿
+	ArticleRoleViewer
+	// ArticleRoleEditor 可以修改草稿，但是不能发表、撤回，也不能邀请别人
+	ArticleRoleEditor
+	// ArticleRoleOwner 作者本人，不会存在协作者里面
+	ArticleRoleOwner
+)
+
+func (r ArticleRole) ToUint8() uint8 {
+	return uint8(r)
+}
+
+func (r ArticleRole) CanView() bool {
+	return r >= ArticleRoleViewer
+}
+
+func (r ArticleRole) CanEdit() bool {
+	return r >= ArticleRoleEditor
+}
+
+// Valid 邀请协作者的时候只能给这两种
+func (r ArticleRole) Valid() bool {
+	return r == ArticleRoleViewer || r == ArticleRoleEditor
+}
+
+// ArticleCollaborator 作者邀请的协作者
+type ArticleCollaborator struct {
+	ArticleId int64
+	Uid       int64
+	Role      ArticleRole
+	// Inviter 目前只有作者能邀请
+	Inviter int64
+	Ctime   time.Time
+	Utime   time.Time
+}
+
+type ArticleAuditAction uint8
+
+const (
+	ArticleAuditActionUnknown ArticleAuditAction = iota
+	// ArticleAuditActionSave 保存草稿，Detail 是改了哪些字段
+	ArticleAuditActionSave
+	// ArticleAuditActionInvite 邀请协作者或者修改协作者的权限
+	ArticleAuditActionInvite
+	// ArticleAuditActionRemove 移除协作者
+	ArticleAuditActionRemove
+)
+
+func (a ArticleAuditAction) ToUint8() uint8 {
+	return uint8(a)
+}
+
+func (a ArticleAuditAction) String() string {
+	switch a {
+	case ArticleAuditActionSave:
+		return "save"
+	case ArticleAuditActionInvite:
+		return "invite"
+	case ArticleAuditActionRemove:
+		return "remove"
+	default:
+		return "unknown"
+	}
+}
+
+// ArticleAuditLog 谁在什么时候对草稿做了什么
+type ArticleAuditLog struct {
+	Id        int64
+	ArticleId int64
+	// Uid 操作的人
+	Uid    int64
+	Action ArticleAuditAction
+	// Version 操作之后文章的版本号，只有保存才有
+	Version int64
+	Detail  string
+	Ctime   time.Time
+}
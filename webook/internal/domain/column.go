@@ -0,0 +1,20 @@
+package domain
+
+import "time"
+
+// Column 专栏，创作者把自己的文章按顺序组织成一个系列
+type Column struct {
+	Id          int64
+	Author      Author
+	Title       string
+	Description string
+	Ctime       time.Time
+	Utime       time.Time
+}
+
+// ColumnNav 文章在专栏里面的上一篇和下一篇，只算已经发表的，没有就是零值
+type ColumnNav struct {
+	Column Column
+	Prev   Article
+	Next   Article
+}
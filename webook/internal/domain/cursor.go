@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidArticleCursor = errors.New("非法的分页游标")
+
+// ArticleCursor 文章列表的游标，列表按照 (Utime, Id) 倒序，
+// 下一页从游标的下一条开始。零值代表第一页
+type ArticleCursor struct {
+	Utime time.Time
+	Id    int64
+}
+
+// CursorOf 以 art 为上一页的最后一条
+func CursorOf(art Article) ArticleCursor {
+	return ArticleCursor{
+		Utime: art.Utime,
+		Id:    art.Id,
+	}
+}
+
+// NextArticleCursor 没有取够 limit 条就说明没有下一页了，返回零值
+func NextArticleCursor(arts []Article, limit int) ArticleCursor {
+	if len(arts) == 0 || len(arts) < limit {
+		return ArticleCursor{}
+	}
+	return CursorOf(arts[len(arts)-1])
+}
+
+func (c ArticleCursor) IsZero() bool {
+	return c.Utime.IsZero() && c.Id == 0
+}
+
+// Encode 编码成前端透传的字符串，前端不需要知道里面是什么
+func (c ArticleCursor) Encode() string {
+	if c.IsZero() {
+		return ""
+	}
+	raw := strconv.FormatInt(c.Utime.UnixMilli(), 10) + "_" + strconv.FormatInt(c.Id, 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// ParseArticleCursor 空字符串就是第一页
+func ParseArticleCursor(token string) (ArticleCursor, error) {
+	if token == "" {
+		return ArticleCursor{}, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return ArticleCursor{}, ErrInvalidArticleCursor
+	}
+	utimeStr, idStr, ok := strings.Cut(string(raw), "_")
+	if !ok {
+		return ArticleCursor{}, ErrInvalidArticleCursor
+	}
+	utime, err := strconv.ParseInt(utimeStr, 10, 64)
+	if err != nil || utime <= 0 {
+		return ArticleCursor{}, ErrInvalidArticleCursor
+	}
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil || id < 0 {
+		return ArticleCursor{}, ErrInvalidArticleCursor
+	}
+	return ArticleCursor{
+		Utime: time.UnixMilli(utime),
+		Id:    id,
+	}, nil
+}
@@ -0,0 +1,29 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+// Media 用户上传的图片或者视频，文章通过 ID 引用
+type Media struct {
+	Id  int64
+	Uid int64
+	// Key 对象存储里面的 key
+	Key string
+	// ThumbKey 缩略图的 key，只有图片才有
+	ThumbKey    string
+	ContentType string
+	Size        int64
+	// Width 和 Height 只有图片才有
+	Width  int
+	Height int
+	// Url 和 ThumbUrl 由对象存储决定，不会保存到数据库
+	Url      string
+	ThumbUrl string
+	Ctime    time.Time
+}
+
+func (m Media) IsImage() bool {
+	return strings.HasPrefix(m.ContentType, "image/")
+}
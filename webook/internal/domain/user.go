@@ -3,14 +3,75 @@ package domain
 import "time"
 
 type User struct {
-	Id       int64
+	Id    int64
+	Email string
+	// EmailVerified 邮箱是否已经验证过
+	EmailVerified bool
+	Phone         string
+	Password      string
+	Nickname      string
+	Birthday      string
+	AboutMe       string
+	Ctime         time.Time
+
+	// WechatInfo 微信登录是最早接入的，信息直接存在用户表上，
+	// 同时也会出现在 ExternalIdentities 里面
+	WechatInfo WechatInfo
+	// ExternalIdentities 绑定的所有第三方账号
+	ExternalIdentities []ExternalIdentity
+	TwoFactor          TwoFactor
+	// Roles 用户的角色，具体有哪些权限看 rbac.Policy 的配置
+	Roles []string
+	// DeleteAt 申请了注销之后，到这个时间点就会真的注销，零值表示没有申请
+	DeleteAt time.Time
+	// Deleted 已经注销的用户只保留 ID
+	Deleted bool
+}
+
+// ExternalIdentity 第三方登录的身份，一个第三方只能绑定一个
+type ExternalIdentity struct {
+	// Provider 第三方的名字，例如 wechat、github
+	Provider string
+	// Subject 用户在第三方那边的唯一标识
+	Subject string
+	// UnionId 同一个第三方多个应用之间共享的标识，没有就是空
+	UnionId  string
 	Email    string
-	Phone    string
-	Password string
 	Nickname string
-	Birthday string
-	AboutMe  string
-	Ctime    time.Time
+}
 
-	WechatInfo WechatInfo
+// IdentityType 登录方式
+type IdentityType string
+
+const (
+	IdentityPhone  IdentityType = "phone"
+	IdentityEmail  IdentityType = "email"
+	IdentityWechat IdentityType = "wechat"
+)
+
+// Identities 用户绑定了哪些登录方式
+func (u User) Identities() []IdentityType {
+	var res []IdentityType
+	if u.Phone != "" {
+		res = append(res, IdentityPhone)
+	}
+	// 邮箱要有密码才能登录
+	if u.Email != "" && u.Password != "" {
+		res = append(res, IdentityEmail)
+	}
+	for _, ei := range u.ExternalIdentities {
+		res = append(res, IdentityType(ei.Provider))
+	}
+	return res
+}
+
+// TwoFactor 两步验证（TOTP）的信息
+type TwoFactor struct {
+	// Secret base32 编码的密钥，有值但是没有开启说明正在绑定
+	Secret  string
+	Enabled bool
+	// RecoveryCodes 恢复码的哈希，每个只能用一次
+	RecoveryCodes []string
+	// LastStep 最近一次用过的验证码所在的周期，小于等于它的验证码都不能再用
+	LastStep int64
 }
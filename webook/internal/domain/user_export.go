@@ -0,0 +1,27 @@
+package domain
+
+import "time"
+
+type UserExportStatus uint8
+
+const (
+	// UserExportStatusUnknown 没有导出过，或者导出的文件已经过期了
+	UserExportStatusUnknown UserExportStatus = iota
+	UserExportStatusPending
+	UserExportStatusDone
+	UserExportStatusFailed
+)
+
+func (s UserExportStatus) ToUint8() uint8 {
+	return uint8(s)
+}
+
+// UserExport 用户导出个人数据的任务，一个用户同一时间只有一个
+type UserExport struct {
+	Uid    int64
+	Status UserExportStatus
+	// Key 导出的压缩包在对象存储里面的 key，只有 Done 的时候才有
+	Key   string
+	Ctime time.Time
+	Utime time.Time
+}
@@ -12,6 +12,26 @@ const (
 	UserInvalidOrPassword = 401002
 	// UserDuplicateEmail 邮箱冲突
 	UserDuplicateEmail = 401003
+	// UserInvalidToken 邮件链接里面的 token 不对或者过期了
+	UserInvalidToken = 401004
+	// UserEmailNotVerified 邮箱还没有验证
+	UserEmailNotVerified = 401005
+	// UserSendTooMany 邮件发送太频繁
+	UserSendTooMany = 401006
+	// UserLocked 失败次数太多，账号或者 IP 暂时被锁定
+	UserLocked = 401007
+	// UserCaptchaRequired 需要验证码，或者验证码不对
+	UserCaptchaRequired = 401008
+	// UserInvalidTwoFactorCode 两步验证码或者恢复码不对
+	UserInvalidTwoFactorCode = 401009
+	// UserIdentityConflict 手机号、邮箱或者微信已经绑定在别的账号上
+	UserIdentityConflict = 401010
+	// UserLastIdentity 不能解绑最后一种登录方式
+	UserLastIdentity = 401011
+	// UserExportInProgress 上一次导出还没有完成
+	UserExportInProgress = 401012
+	// UserExportNotReady 没有可以下载的导出数据
+	UserExportNotReady = 401013
 )
 
 // Article 部分，模块代码使用 02
@@ -2,19 +2,38 @@ package article
 
 import (
 	"encoding/json"
+	"strconv"
 
 	"github.com/IBM/sarama"
 )
 
-const TopicReadEvent = "article_read"
+const (
+	TopicReadEvent = "article_read"
+	// TopicSyncArticle 文章发表或者撤回之后发出，搜索服务用来更新索引。
+	// 消息的 key 是文章 ID，topic 要开启 compact，这样重放整个 topic 就能重建索引
+	TopicSyncArticle = "sync_article_event"
+)
 
+//go:generate mockgen -source=./producer.go -package=evtmocks -destination=./mocks/producer.mock.go Producer
 type Producer interface {
 	ProduceReadEvent(evt ReadEvent) error
+	ProduceSyncEvent(evt SyncEvent) error
 }
 
 type ReadEvent struct {
 	Aid int64
 	Uid int64
+	// Ctime 阅读的时间，毫秒数。每天的 UV 按照它来算，不受消费延迟的影响
+	Ctime int64
+}
+
+// SyncEvent 字段和搜索服务的 Article 保持一致，
+// Content 是去掉 Markdown 标记之后的纯文本，搜索服务不需要原文
+type SyncEvent struct {
+	Id      int64  `json:"id"`
+	Title   string `json:"title"`
+	Status  int32  `json:"status"`
+	Content string `json:"content"`
 }
 
 type SaramaSyncProducer struct {
@@ -38,3 +57,16 @@ func (s *SaramaSyncProducer) ProduceReadEvent(evt ReadEvent) error {
 	})
 	return err
 }
+
+func (s *SaramaSyncProducer) ProduceSyncEvent(evt SyncEvent) error {
+	val, err := json.Marshal(evt)
+	if err != nil {
+		return err
+	}
+	_, _, err = s.producer.SendMessage(&sarama.ProducerMessage{
+		Topic: TopicSyncArticle,
+		Key:   sarama.StringEncoder(strconv.FormatInt(evt.Id, 10)),
+		Value: sarama.StringEncoder(val),
+	})
+	return err
+}
@@ -0,0 +1,59 @@
+package user
+
+import (
+	"context"
+	"time"
+	"webook/pkg/logger"
+	"webook/pkg/saramax"
+
+	"github.com/IBM/sarama"
+)
+
+// Exporter 真正导出数据的是 service.UserDataService，
+// 这里单独定义一个接口是为了避免 service 和 events 循环引用
+type Exporter interface {
+	Export(ctx context.Context, uid int64) error
+}
+
+type ExportEventConsumer struct {
+	client   sarama.Client
+	producer sarama.SyncProducer
+	exporter Exporter
+	l        logger.LoggerV1
+}
+
+// NewExportEventConsumer producer 用来投递死信队列
+func NewExportEventConsumer(client sarama.Client, producer sarama.SyncProducer,
+	l logger.LoggerV1, exporter Exporter) *ExportEventConsumer {
+	return &ExportEventConsumer{
+		client:   client,
+		producer: producer,
+		exporter: exporter,
+		l:        l,
+	}
+}
+
+func (c *ExportEventConsumer) Start() error {
+	cg, err := sarama.NewConsumerGroupFromClient("webook_user_export",
+		c.client)
+	if err != nil {
+		return err
+	}
+	go func() {
+		err := cg.Consume(context.Background(),
+			[]string{TopicUserExport},
+			saramax.NewHandler(c.l, c.Consume,
+				saramax.WithDeadLetter(saramax.NewDeadLetter(c.producer, ""))))
+		if err != nil {
+			c.l.Error("退出了消费循环异常", logger.Error(err))
+		}
+	}()
+	return err
+}
+
+func (c *ExportEventConsumer) Consume(msg *sarama.ConsumerMessage, evt ExportEvent) error {
+	// 要去好几个服务拉数据，所以超时时间给长一点
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute*5)
+	defer cancel()
+	return c.exporter.Export(ctx, evt.Uid)
+}
@@ -0,0 +1,70 @@
+package user
+
+import (
+	"encoding/json"
+
+	"github.com/IBM/sarama"
+)
+
+const (
+	// TopicUserDeleted 用户真正注销之后发出，评论、关注、点赞收藏这些服务各自清理数据
+	TopicUserDeleted = "user_deleted"
+	// TopicUserExport 用户申请导出个人数据，在 webook 里面异步处理
+	TopicUserExport = "user_export"
+	// TopicUserMerged 两个账号合并之后发出，评论、关注、点赞收藏这些服务各自把数据转到 target 上
+	TopicUserMerged = "user_merged"
+)
+
+type Producer interface {
+	ProduceDeletedEvent(evt DeletedEvent) error
+	ProduceExportEvent(evt ExportEvent) error
+	ProduceMergedEvent(evt MergedEvent) error
+}
+
+type DeletedEvent struct {
+	Uid int64
+}
+
+type ExportEvent struct {
+	Uid int64
+}
+
+// MergedEvent SourceUid 这个账号已经被删掉了，数据都要归到 TargetUid 名下
+type MergedEvent struct {
+	SourceUid int64
+	TargetUid int64
+}
+
+type SaramaSyncProducer struct {
+	producer sarama.SyncProducer
+}
+
+func NewSaramaSyncProducer(producer sarama.SyncProducer) Producer {
+	return &SaramaSyncProducer{
+		producer: producer,
+	}
+}
+
+func (s *SaramaSyncProducer) ProduceDeletedEvent(evt DeletedEvent) error {
+	return s.produce(TopicUserDeleted, evt)
+}
+
+func (s *SaramaSyncProducer) ProduceExportEvent(evt ExportEvent) error {
+	return s.produce(TopicUserExport, evt)
+}
+
+func (s *SaramaSyncProducer) ProduceMergedEvent(evt MergedEvent) error {
+	return s.produce(TopicUserMerged, evt)
+}
+
+func (s *SaramaSyncProducer) produce(topic string, evt any) error {
+	val, err := json.Marshal(evt)
+	if err != nil {
+		return err
+	}
+	_, _, err = s.producer.SendMessage(&sarama.ProducerMessage{
+		Topic: topic,
+		Value: sarama.StringEncoder(val),
+	})
+	return err
+}
@@ -8,6 +8,7 @@ import (
 	"testing"
 	"webook/internal/integration/startup"
 	"webook/internal/repository/dao"
+	"webook/internal/web"
 	ijwt "webook/internal/web/jwt"
 
 	"github.com/gin-gonic/gin"
@@ -53,7 +54,7 @@ func (s *ArticleHandlerSuite) TestEdit() {
 		art Article
 
 		wantCode int
-		wantRes  Result[int64]
+		wantRes  Result[web.ArticleEditVo]
 	}{
 		{
 			name:   "新建帖子",
@@ -74,6 +75,7 @@ func (s *ArticleHandlerSuite) TestEdit() {
 					Content:  "内容",
 					AuthorId: 123,
 					Status:   1,
+					Version:  1,
 				}, art)
 			},
 			art: Article{
@@ -81,9 +83,9 @@ func (s *ArticleHandlerSuite) TestEdit() {
 				Content: "内容",
 			},
 			wantCode: http.StatusOK,
-			wantRes: Result[int64]{
-				// 希望 ID 是 1
-				Data: 1,
+			wantRes: Result[web.ArticleEditVo]{
+				// 希望 ID 是 1，新建的文章版本号从 1 开始
+				Data: web.ArticleEditVo{Id: 1, Version: 1},
 			},
 		},
 		{
@@ -95,9 +97,10 @@ func (s *ArticleHandlerSuite) TestEdit() {
 					Content:  "内容",
 					AuthorId: 123,
 					// 假设这是一个已经发表的帖子
-					Status: 2,
-					Ctime:  456,
-					Utime:  789,
+					Status:  2,
+					Version: 3,
+					Ctime:   456,
+					Utime:   789,
 				}).Error
 				assert.NoError(t, err)
 			},
@@ -113,18 +116,20 @@ func (s *ArticleHandlerSuite) TestEdit() {
 					Content:  "新的内容",
 					AuthorId: 123,
 					// 更新后是为发表状态
-					Status: 1,
-					Ctime:  456,
+					Status:  1,
+					Version: 4,
+					Ctime:   456,
 				}, art)
 			},
 			art: Article{
 				Id:      2,
 				Title:   "新的标题",
 				Content: "新的内容",
+				Version: 3,
 			},
 			wantCode: http.StatusOK,
-			wantRes: Result[int64]{
-				Data: 2,
+			wantRes: Result[web.ArticleEditVo]{
+				Data: web.ArticleEditVo{Id: 2, Version: 4},
 			},
 		},
 		{
@@ -136,6 +141,7 @@ func (s *ArticleHandlerSuite) TestEdit() {
 					Content:  "内容",
 					AuthorId: 234,
 					Status:   2,
+					Version:  1,
 					Ctime:    456,
 					Utime:    789,
 				}).Error
@@ -151,6 +157,7 @@ func (s *ArticleHandlerSuite) TestEdit() {
 					Content:  "内容",
 					AuthorId: 234,
 					Status:   2,
+					Version:  1,
 					Ctime:    456,
 					Utime:    789,
 				}, art)
@@ -159,10 +166,12 @@ func (s *ArticleHandlerSuite) TestEdit() {
 				Id:      3,
 				Title:   "新的标题",
 				Content: "新的内容",
+				Version: 1,
 			},
 			wantCode: http.StatusOK,
-			wantRes: Result[int64]{
-				Msg: "系统错误",
+			wantRes: Result[web.ArticleEditVo]{
+				Code: 4,
+				Msg:  "没有权限操作这篇文章",
 			},
 		},
 	}
@@ -190,7 +199,7 @@ func (s *ArticleHandlerSuite) TestEdit() {
 				return
 			}
 
-			var res Result[int64]
+			var res Result[web.ArticleEditVo]
 			err = json.Unmarshal(recorder.Body.Bytes(), &res)
 			assert.NoError(t, err)
 			assert.Equal(t, tc.wantRes, res)
@@ -211,7 +220,7 @@ func (s *ArticleHandlerSuite) TestArticle_Publish() {
 
 		// 预期响应
 		wantCode   int
-		wantResult Result[int64]
+		wantResult Result[web.ArticleEditVo]
 	}{
 		{
 			name: "新建帖子并发表",
@@ -242,8 +251,8 @@ func (s *ArticleHandlerSuite) TestArticle_Publish() {
 				Content: "随便试试",
 			},
 			wantCode: 200,
-			wantResult: Result[int64]{
-				Data: 1,
+			wantResult: Result[web.ArticleEditVo]{
+				Data: web.ArticleEditVo{Id: 1, Version: 1},
 			},
 		},
 		{
@@ -257,6 +266,7 @@ func (s *ArticleHandlerSuite) TestArticle_Publish() {
 					Content:  "我的内容",
 					Ctime:    456,
 					Status:   1,
+					Version:  1,
 					Utime:    234,
 					AuthorId: 123,
 				})
@@ -269,6 +279,7 @@ func (s *ArticleHandlerSuite) TestArticle_Publish() {
 				assert.Equal(t, "新的内容", art.Content)
 				assert.Equal(t, uint8(2), art.Status)
 				assert.Equal(t, int64(123), art.AuthorId)
+				assert.Equal(t, int64(2), art.Version)
 				// 创建时间没变
 				assert.Equal(t, int64(456), art.Ctime)
 				// 更新时间变了
@@ -286,10 +297,11 @@ func (s *ArticleHandlerSuite) TestArticle_Publish() {
 				Id:      2,
 				Title:   "新的标题",
 				Content: "新的内容",
+				Version: 1,
 			},
 			wantCode: 200,
-			wantResult: Result[int64]{
-				Data: 2,
+			wantResult: Result[web.ArticleEditVo]{
+				Data: web.ArticleEditVo{Id: 2, Version: 2},
 			},
 		},
 		{
@@ -301,6 +313,7 @@ func (s *ArticleHandlerSuite) TestArticle_Publish() {
 					Content:  "我的内容",
 					Ctime:    456,
 					Status:   1,
+					Version:  1,
 					Utime:    234,
 					AuthorId: 123,
 				}
@@ -315,6 +328,7 @@ func (s *ArticleHandlerSuite) TestArticle_Publish() {
 				assert.Equal(t, "新的内容", art.Content)
 				assert.Equal(t, int64(123), art.AuthorId)
 				assert.Equal(t, uint8(2), art.Status)
+				assert.Equal(t, int64(2), art.Version)
 				// 创建时间没变
 				assert.Equal(t, int64(456), art.Ctime)
 				// 更新时间变了
@@ -335,10 +349,11 @@ func (s *ArticleHandlerSuite) TestArticle_Publish() {
 				Id:      3,
 				Title:   "新的标题",
 				Content: "新的内容",
+				Version: 1,
 			},
 			wantCode: 200,
-			wantResult: Result[int64]{
-				Data: 3,
+			wantResult: Result[web.ArticleEditVo]{
+				Data: web.ArticleEditVo{Id: 3, Version: 2},
 			},
 		},
 		{
@@ -351,6 +366,7 @@ func (s *ArticleHandlerSuite) TestArticle_Publish() {
 					Ctime:   456,
 					Utime:   234,
 					Status:  1,
+					Version: 1,
 					// 注意。这个 AuthorID 我们设置为另外一个人的ID
 					AuthorId: 789,
 				}
@@ -393,9 +409,10 @@ func (s *ArticleHandlerSuite) TestArticle_Publish() {
 				Id:      4,
 				Title:   "新的标题",
 				Content: "新的内容",
+				Version: 1,
 			},
 			wantCode: 200,
-			wantResult: Result[int64]{
+			wantResult: Result[web.ArticleEditVo]{
 				Code: 5,
 				Msg:  "系统错误",
 			},
@@ -422,8 +439,8 @@ func (s *ArticleHandlerSuite) TestArticle_Publish() {
 				return
 			}
 			// 反序列化为结果
-			// 利用泛型来限定结果必须是 int64
-			var result Result[int64]
+			// 利用泛型来限定结果的类型
+			var result Result[web.ArticleEditVo]
 			err = json.Unmarshal(recorder.Body.Bytes(), &result)
 			assert.NoError(t, err)
 			assert.Equal(t, tc.wantResult, result)
@@ -446,4 +463,5 @@ type Article struct {
 	Id      int64
 	Title   string `json:"title"`
 	Content string `json:"content"`
+	Version int64  `json:"version"`
 }
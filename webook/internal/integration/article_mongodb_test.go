@@ -10,6 +10,7 @@ import (
 	"time"
 	"webook/internal/integration/startup"
 	"webook/internal/repository/dao"
+	"webook/internal/web"
 	ijwt "webook/internal/web/jwt"
 
 	"github.com/bwmarrin/snowflake"
@@ -58,7 +59,7 @@ func (s *ArticleMongoDBHandlerSuite) TestArticle_Publish() {
 
 		// 预期响应
 		wantCode   int
-		wantResult Result[int64]
+		wantResult Result[web.ArticleEditVo]
 	}{
 		{
 			name: "新建帖子并发表",
@@ -97,8 +98,8 @@ func (s *ArticleMongoDBHandlerSuite) TestArticle_Publish() {
 				Content: "随便试试",
 			},
 			wantCode: 200,
-			wantResult: Result[int64]{
-				Data: 1,
+			wantResult: Result[web.ArticleEditVo]{
+				Data: web.ArticleEditVo{Id: 1, Version: 1},
 			},
 		},
 		{
@@ -114,6 +115,7 @@ func (s *ArticleMongoDBHandlerSuite) TestArticle_Publish() {
 					Content:  "我的内容",
 					Ctime:    456,
 					Status:   1,
+					Version:  1,
 					Utime:    234,
 					AuthorId: 123,
 				})
@@ -130,6 +132,7 @@ func (s *ArticleMongoDBHandlerSuite) TestArticle_Publish() {
 				assert.Equal(t, "新的内容", art.Content)
 				assert.Equal(t, uint8(2), art.Status)
 				assert.Equal(t, int64(123), art.AuthorId)
+				assert.Equal(t, int64(2), art.Version)
 				// 创建时间没变
 				assert.Equal(t, int64(456), art.Ctime)
 				// 更新时间变了
@@ -148,10 +151,11 @@ func (s *ArticleMongoDBHandlerSuite) TestArticle_Publish() {
 				Id:      2,
 				Title:   "新的标题",
 				Content: "新的内容",
+				Version: 1,
 			},
 			wantCode: 200,
-			wantResult: Result[int64]{
-				Data: 2,
+			wantResult: Result[web.ArticleEditVo]{
+				Data: web.ArticleEditVo{Id: 2, Version: 2},
 			},
 		},
 		{
@@ -165,6 +169,7 @@ func (s *ArticleMongoDBHandlerSuite) TestArticle_Publish() {
 					Content:  "我的内容",
 					Ctime:    456,
 					Status:   1,
+					Version:  1,
 					Utime:    234,
 					AuthorId: 123,
 				}
@@ -184,6 +189,7 @@ func (s *ArticleMongoDBHandlerSuite) TestArticle_Publish() {
 				assert.Equal(t, "新的内容", art.Content)
 				assert.Equal(t, int64(123), art.AuthorId)
 				assert.Equal(t, uint8(2), art.Status)
+				assert.Equal(t, int64(2), art.Version)
 				// 创建时间没变
 				assert.Equal(t, int64(456), art.Ctime)
 				// 更新时间变了
@@ -205,10 +211,11 @@ func (s *ArticleMongoDBHandlerSuite) TestArticle_Publish() {
 				Id:      3,
 				Title:   "新的标题",
 				Content: "新的内容",
+				Version: 1,
 			},
 			wantCode: 200,
-			wantResult: Result[int64]{
-				Data: 3,
+			wantResult: Result[web.ArticleEditVo]{
+				Data: web.ArticleEditVo{Id: 3, Version: 2},
 			},
 		},
 		{
@@ -223,6 +230,7 @@ func (s *ArticleMongoDBHandlerSuite) TestArticle_Publish() {
 					Ctime:   456,
 					Utime:   234,
 					Status:  1,
+					Version: 1,
 					// 注意。这个 AuthorID 我们设置为另外一个人的ID
 					AuthorId: 789,
 				}
@@ -271,9 +279,10 @@ func (s *ArticleMongoDBHandlerSuite) TestArticle_Publish() {
 				Id:      4,
 				Title:   "新的标题",
 				Content: "新的内容",
+				Version: 1,
 			},
 			wantCode: 200,
-			wantResult: Result[int64]{
+			wantResult: Result[web.ArticleEditVo]{
 				Code: 5,
 				Msg:  "系统错误",
 			},
@@ -300,14 +309,15 @@ func (s *ArticleMongoDBHandlerSuite) TestArticle_Publish() {
 				return
 			}
 			// 反序列化为结果
-			// 利用泛型来限定结果必须是 int64
-			var result Result[int64]
+			var result Result[web.ArticleEditVo]
 			err = json.Unmarshal(recorder.Body.Bytes(), &result)
 			assert.NoError(t, err)
-			assert.NoError(t, err)
-			if tc.wantResult.Data > 0 {
-				// 你只能断定有 ID
-				assert.True(t, result.Data > 0)
+			if tc.wantResult.Data.Id > 0 {
+				// 新建的 ID 是雪花算法生成的，只能断定有 ID
+				assert.True(t, result.Data.Id > 0)
+				assert.Equal(t, tc.wantResult.Data.Version, result.Data.Version)
+			} else {
+				assert.Equal(t, tc.wantResult, result)
 			}
 			tc.after(t)
 		})
@@ -325,7 +335,7 @@ func (s *ArticleMongoDBHandlerSuite) TestEdit() {
 		art Article
 
 		wantCode int
-		wantRes  Result[int64]
+		wantRes  Result[web.ArticleEditVo]
 	}{
 		{
 			name:   "新建帖子",
@@ -350,6 +360,7 @@ func (s *ArticleMongoDBHandlerSuite) TestEdit() {
 					Content:  "我的内容",
 					AuthorId: 123,
 					Status:   1,
+					Version:  1,
 				}, art)
 			},
 			art: Article{
@@ -357,9 +368,9 @@ func (s *ArticleMongoDBHandlerSuite) TestEdit() {
 				Content: "我的内容",
 			},
 			wantCode: http.StatusOK,
-			wantRes: Result[int64]{
+			wantRes: Result[web.ArticleEditVo]{
 				// 我希望你的 ID 是 1
-				Data: 1,
+				Data: web.ArticleEditVo{Id: 1, Version: 1},
 			},
 		},
 		{
@@ -374,9 +385,10 @@ func (s *ArticleMongoDBHandlerSuite) TestEdit() {
 					Content:  "我的内容",
 					AuthorId: 123,
 					// 假设这是一个已经发表了的帖子
-					Status: 2,
-					Ctime:  456,
-					Utime:  789,
+					Status:  2,
+					Version: 3,
+					Ctime:   456,
+					Utime:   789,
 				})
 				assert.NoError(t, err)
 			},
@@ -397,19 +409,21 @@ func (s *ArticleMongoDBHandlerSuite) TestEdit() {
 					Content:  "新的内容",
 					AuthorId: 123,
 					// 更新之后，是未发表状态
-					Status: 1,
-					Ctime:  456,
+					Status:  1,
+					Version: 4,
+					Ctime:   456,
 				}, art)
 			},
 			art: Article{
 				Id:      11,
 				Title:   "新的标题",
 				Content: "新的内容",
+				Version: 3,
 			},
 			wantCode: http.StatusOK,
-			wantRes: Result[int64]{
+			wantRes: Result[web.ArticleEditVo]{
 				// 我希望你的 ID 是 11
-				Data: 11,
+				Data: web.ArticleEditVo{Id: 11, Version: 4},
 			},
 		},
 		{
@@ -425,6 +439,7 @@ func (s *ArticleMongoDBHandlerSuite) TestEdit() {
 					// 模拟别人
 					AuthorId: 1024,
 					Status:   2,
+					Version:  1,
 					Ctime:    456,
 					Utime:    789,
 				})
@@ -444,6 +459,7 @@ func (s *ArticleMongoDBHandlerSuite) TestEdit() {
 					Content:  "我的内容",
 					AuthorId: 1024,
 					Status:   2,
+					Version:  1,
 					Ctime:    456,
 					Utime:    789,
 				}, art)
@@ -452,10 +468,12 @@ func (s *ArticleMongoDBHandlerSuite) TestEdit() {
 				Id:      22,
 				Title:   "新的标题",
 				Content: "新的内容",
+				Version: 1,
 			},
 			wantCode: http.StatusOK,
-			wantRes: Result[int64]{
-				Msg: "系统错误",
+			wantRes: Result[web.ArticleEditVo]{
+				Code: 4,
+				Msg:  "没有权限操作这篇文章",
 			},
 		},
 	}
@@ -482,12 +500,15 @@ func (s *ArticleMongoDBHandlerSuite) TestEdit() {
 			if tc.wantCode != http.StatusOK {
 				return
 			}
-			var res Result[int64]
+			var res Result[web.ArticleEditVo]
 			err = json.NewDecoder(recorder.Body).Decode(&res)
 			assert.NoError(t, err)
-			if tc.wantRes.Data > 0 {
+			if tc.wantRes.Data.Id > 0 {
 				// 你只能断定有 ID
-				assert.True(t, res.Data > 0)
+				assert.True(t, res.Data.Id > 0)
+				assert.Equal(t, tc.wantRes.Data.Version, res.Data.Version)
+			} else {
+				assert.Equal(t, tc.wantRes, res)
 			}
 		})
 	}
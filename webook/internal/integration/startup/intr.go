@@ -9,8 +9,9 @@ import (
 	"google.golang.org/grpc"
 )
 
-func InitIntrClient(svc service.InteractiveService) intrv1.InteractiveServiceClient {
-	return client.NewLocalInteractiveServiceAdapter(svc)
+func InitIntrClient(svc service.InteractiveService,
+	csvc service.CollectionService) intrv1.InteractiveServiceClient {
+	return client.NewLocalInteractiveServiceAdapter(svc, csvc)
 }
 
 type DoNothingInteractiveServiceClient struct {
@@ -43,3 +44,45 @@ func (d *DoNothingInteractiveServiceClient) GetByIds(ctx context.Context, in *in
 		Intrs: map[int64]*intrv1.Interactive{},
 	}, nil
 }
+
+func (d *DoNothingInteractiveServiceClient) GetUserData(ctx context.Context, in *intrv1.GetUserDataRequest, opts ...grpc.CallOption) (*intrv1.GetUserDataResponse, error) {
+	return &intrv1.GetUserDataResponse{}, nil
+}
+
+func (d *DoNothingInteractiveServiceClient) ListUserLikes(ctx context.Context, in *intrv1.ListUserLikesRequest, opts ...grpc.CallOption) (*intrv1.ListUserLikesResponse, error) {
+	return &intrv1.ListUserLikesResponse{}, nil
+}
+
+func (d *DoNothingInteractiveServiceClient) ListUserCollections(ctx context.Context, in *intrv1.ListUserCollectionsRequest, opts ...grpc.CallOption) (*intrv1.ListUserCollectionsResponse, error) {
+	return &intrv1.ListUserCollectionsResponse{}, nil
+}
+
+func (d *DoNothingInteractiveServiceClient) CancelCollect(ctx context.Context, in *intrv1.CancelCollectRequest, opts ...grpc.CallOption) (*intrv1.CancelCollectResponse, error) {
+	return &intrv1.CancelCollectResponse{}, nil
+}
+
+func (d *DoNothingInteractiveServiceClient) SaveCollection(ctx context.Context, in *intrv1.SaveCollectionRequest, opts ...grpc.CallOption) (*intrv1.SaveCollectionResponse, error) {
+	return &intrv1.SaveCollectionResponse{}, nil
+}
+
+func (d *DoNothingInteractiveServiceClient) DeleteCollection(ctx context.Context, in *intrv1.DeleteCollectionRequest, opts ...grpc.CallOption) (*intrv1.DeleteCollectionResponse, error) {
+	return &intrv1.DeleteCollectionResponse{}, nil
+}
+
+func (d *DoNothingInteractiveServiceClient) GetCollection(ctx context.Context, in *intrv1.GetCollectionRequest, opts ...grpc.CallOption) (*intrv1.GetCollectionResponse, error) {
+	return &intrv1.GetCollectionResponse{
+		Collection: &intrv1.Collection{},
+	}, nil
+}
+
+func (d *DoNothingInteractiveServiceClient) ListCollections(ctx context.Context, in *intrv1.ListCollectionsRequest, opts ...grpc.CallOption) (*intrv1.ListCollectionsResponse, error) {
+	return &intrv1.ListCollectionsResponse{}, nil
+}
+
+func (d *DoNothingInteractiveServiceClient) ListCollectionItems(ctx context.Context, in *intrv1.ListCollectionItemsRequest, opts ...grpc.CallOption) (*intrv1.ListCollectionItemsResponse, error) {
+	return &intrv1.ListCollectionItemsResponse{}, nil
+}
+
+func (d *DoNothingInteractiveServiceClient) MoveCollectionItem(ctx context.Context, in *intrv1.MoveCollectionItemRequest, opts ...grpc.CallOption) (*intrv1.MoveCollectionItemResponse, error) {
+	return &intrv1.MoveCollectionItemResponse{}, nil
+}
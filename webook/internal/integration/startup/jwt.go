@@ -0,0 +1,7 @@
+package startup
+
+import ijwt "webook/internal/web/jwt"
+
+func InitRefreshKey() ijwt.RefreshKey {
+	return ijwt.RefreshKey("WiXLWadWG44Rr2qP6VUDLod0dzAnRI34")
+}
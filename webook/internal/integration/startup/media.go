@@ -0,0 +1,20 @@
+package startup
+
+import (
+	"os"
+	"path/filepath"
+	"webook/internal/service"
+	"webook/pkg/objstore"
+)
+
+func InitMediaConfig() service.MediaConfig {
+	return service.MediaConfig{
+		MaxSize:    1 << 20,
+		ThumbWidth: 32,
+	}
+}
+
+// InitObjectStore 集成测试用本地存储
+func InitObjectStore() objstore.Store {
+	return objstore.NewLocalStore(filepath.Join(os.TempDir(), "webook_media"), "/media/files")
+}
@@ -0,0 +1,10 @@
+package startup
+
+import "webook/internal/web"
+
+func InitSiteConfig() web.SiteConfig {
+	return web.SiteConfig{
+		Name:    "webook",
+		BaseURL: "http://localhost:3000",
+	}
+}
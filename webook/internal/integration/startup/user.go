@@ -0,0 +1,15 @@
+package startup
+
+import (
+	"time"
+	"webook/internal/service"
+)
+
+func InitUserConfig() service.UserConfig {
+	return service.UserConfig{
+		TokenKey:                []byte("p8Qh2JmZc4TnVx7KdR1sLw9YbE6uGfA3"),
+		LinkPrefix:              "http://localhost:3000",
+		VerifyEmailExpiration:   24 * time.Hour,
+		ResetPasswordExpiration: 30 * time.Minute,
+	}
+}
@@ -0,0 +1,44 @@
+package startup
+
+import (
+	"context"
+	"time"
+	commentv1 "webook/api/proto/gen/comment/v1"
+	followv1 "webook/api/proto/gen/follow/v1"
+	"webook/internal/service"
+
+	"google.golang.org/grpc"
+)
+
+func InitUserDataConfig() service.UserDataConfig {
+	return service.UserDataConfig{
+		ExportExpiration:    time.Hour,
+		DeletionGracePeriod: 24 * time.Hour,
+	}
+}
+
+func InitCommentClient() commentv1.CommentServiceClient {
+	return &DoNothingCommentServiceClient{}
+}
+
+func InitFollowClient() followv1.FollowServiceClient {
+	return &DoNothingFollowServiceClient{}
+}
+
+// DoNothingCommentServiceClient 集成测试里面不启动评论服务，只实现导出数据用到的方法
+type DoNothingCommentServiceClient struct {
+	commentv1.CommentServiceClient
+}
+
+func (d *DoNothingCommentServiceClient) GetUserComments(ctx context.Context, in *commentv1.GetUserCommentsRequest, opts ...grpc.CallOption) (*commentv1.GetUserCommentsResponse, error) {
+	return &commentv1.GetUserCommentsResponse{}, nil
+}
+
+// DoNothingFollowServiceClient 集成测试里面不启动关注服务，只实现导出数据用到的方法
+type DoNothingFollowServiceClient struct {
+	followv1.FollowServiceClient
+}
+
+func (d *DoNothingFollowServiceClient) GetFollowee(ctx context.Context, in *followv1.GetFolloweeRequest, opts ...grpc.CallOption) (*followv1.GetFolloweeResponse, error) {
+	return &followv1.GetFolloweeResponse{}, nil
+}
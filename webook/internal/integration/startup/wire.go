@@ -8,6 +8,7 @@ import (
 	dao2 "webook/interactive/repository/dao"
 	service2 "webook/interactive/service"
 	"webook/internal/events/article"
+	"webook/internal/events/user"
 	"webook/internal/job"
 	"webook/internal/repository"
 	"webook/internal/repository/cache"
@@ -30,20 +31,44 @@ var thirdPartySet = wire.NewSet( // 第三方依赖
 var userSvcProvider = wire.NewSet(
 	dao.NewUserDAO,
 	cache.NewUserCache,
+	cache.NewUserTokenCache,
 	repository.NewUserRepository,
+	repository.NewUserTokenRepository,
+	ioc.InitEmailService,
+	InitUserConfig,
 	service.NewUserService)
 
 var articlSvcProvider = wire.NewSet(
 	repository.NewArticleRepository,
 	cache.NewArticleRedisCache,
 	dao.NewArticleGORMDAO,
+	dao.NewGORMArticleCollaboratorDAO,
+	repository.NewArticleCollaboratorRepository,
+	dao.NewGORMArticleReviewDAO,
+	repository.NewArticleReviewRepository,
+	ioc.InitModerator,
 	service.NewArticleService)
 
+var mediaSvcProvider = wire.NewSet(
+	dao.NewGORMMediaDAO,
+	repository.NewMediaRepository,
+	InitObjectStore,
+	InitMediaConfig,
+	service.NewMediaService)
+
+var columnSvcProvider = wire.NewSet(
+	dao.NewGORMColumnDAO,
+	repository.NewColumnRepository,
+	service.NewColumnService)
+
 var interactiveSvcSet = wire.NewSet(
 	dao2.NewGORMInteractiveDAO,
 	cache2.NewRedisInteractiveCache,
 	repository2.NewCachedInteractiveRepository,
 	service2.NewInteractiveService,
+	dao2.NewGORMCollectionDAO,
+	repository2.NewCachedCollectionRepository,
+	service2.NewCollectionService,
 	ioc.InitIntrClient,
 )
 
@@ -57,6 +82,8 @@ func InitWebServer() *gin.Engine {
 		thirdPartySet,
 		userSvcProvider,
 		articlSvcProvider,
+		mediaSvcProvider,
+		columnSvcProvider,
 		interactiveSvcSet,
 
 		// Cache
@@ -66,17 +93,46 @@ func InitWebServer() *gin.Engine {
 		repository.NewCodeRepository,
 
 		article.NewSaramaSyncProducer,
+		user.NewSaramaSyncProducer,
+
+		// 导出个人数据和注销
+		cache.NewUserExportCache,
+		repository.NewUserExportRepository,
+		InitCommentClient,
+		InitFollowClient,
+		InitUserDataConfig,
+		service.NewUserDataService,
 
 		// Service
 		ioc.InitSMSService,
+		ioc.InitCaptchaService,
 		ioc.InitWechatService,
+		ioc.InitOAuth2Registry,
+		ioc.InitRBACPolicy,
+		ioc.InitRBACBuilder,
 		service.NewCodeService,
+		service.NewTwoFactorService,
+		service.NewArticleReviewService,
 
 		// Handler
 		web.NewUserHandler,
 		web.NewOAuth2WechatHandler,
+		web.NewOAuth2Handler,
+		web.NewAdminHandler,
+		web.NewUserDataHandler,
+		web.NewMediaHandler,
+		web.NewColumnHandler,
+		web.NewArticleReviewHandler,
 		web.NewArticleHandler,
-
+		web.NewFeedHandler,
+		web.NewCollectionHandler,
+		web.NewUserInteractiveHandler,
+		web.NewJWKSHandler,
+		InitSiteConfig,
+
+		ioc.InitLoginGuard,
+		ioc.InitJWTKeySet,
+		InitRefreshKey,
 		ijwt.NewRedisJWTHandler,
 
 		ioc.InitGinMiddlewares,
@@ -85,15 +141,22 @@ func InitWebServer() *gin.Engine {
 	return gin.Default()
 }
 
-func InitArticleHandler(dao dao.ArticleDAO) *web.ArticleHandler {
+func InitArticleHandler(artDAO dao.ArticleDAO) *web.ArticleHandler {
 	wire.Build(
 		thirdPartySet,
 		userSvcProvider,
 		interactiveSvcSet,
+		mediaSvcProvider,
 		service.NewArticleService,
 		cache.NewArticleRedisCache,
 		web.NewArticleHandler,
+		InitSiteConfig,
 		article.NewSaramaSyncProducer,
+		dao.NewGORMArticleCollaboratorDAO,
+		repository.NewArticleCollaboratorRepository,
+		dao.NewGORMArticleReviewDAO,
+		repository.NewArticleReviewRepository,
+		ioc.InitModerator,
 		repository.NewArticleRepository)
 	return &web.ArticleHandler{}
 }
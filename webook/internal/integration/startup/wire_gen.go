@@ -14,6 +14,7 @@ import (
 	dao2 "webook/interactive/repository/dao"
 	service2 "webook/interactive/service"
 	"webook/internal/events/article"
+	"webook/internal/events/user"
 	"webook/internal/job"
 	"webook/internal/repository"
 	"webook/internal/repository/cache"
@@ -28,57 +29,118 @@ import (
 
 func InitWebServer() *gin.Engine {
 	cmdable := InitRedis()
-	handler := jwt.NewRedisJWTHandler(cmdable)
 	loggerV1 := InitLogger()
+	keySet := ioc.InitJWTKeySet(loggerV1)
+	refreshKey := InitRefreshKey()
+	handler := jwt.NewRedisJWTHandler(cmdable, loggerV1, keySet, refreshKey)
 	v := ioc.InitGinMiddlewares(cmdable, handler, loggerV1)
 	db := InitDB()
 	userDAO := dao.NewUserDAO(db)
 	userCache := cache.NewUserCache(cmdable)
 	userRepository := repository.NewUserRepository(userDAO, userCache)
-	userService := service.NewUserService(userRepository)
+	userTokenCache := cache.NewUserTokenCache(cmdable)
+	userTokenRepository := repository.NewUserTokenRepository(userTokenCache)
+	emailService := ioc.InitEmailService()
+	userConfig := InitUserConfig()
+	client := InitSaramaClient()
+	syncProducer := InitSyncProducer(client)
+	userProducer := user.NewSaramaSyncProducer(syncProducer)
+	userService := service.NewUserService(userRepository, userTokenRepository, emailService, userProducer, userConfig)
 	codeCache := cache.NewCodeCache(cmdable)
 	codeRepository := repository.NewCodeRepository(codeCache)
 	smsService := ioc.InitSMSService()
 	codeService := service.NewCodeService(codeRepository, smsService)
-	userHandler := web.NewUserHandler(userService, codeService, handler)
+	twoFactorService := service.NewTwoFactorService(userRepository)
+	captchaService := ioc.InitCaptchaService()
+	guard := ioc.InitLoginGuard(cmdable)
+	userHandler := web.NewUserHandler(userService, codeService, twoFactorService, captchaService, guard, handler)
 	articleDAO := dao.NewArticleGORMDAO(db)
 	articleCache := cache.NewArticleRedisCache(cmdable)
 	articleRepository := repository.NewArticleRepository(articleDAO, userRepository, articleCache)
-	client := InitSaramaClient()
-	syncProducer := InitSyncProducer(client)
+	articleCollaboratorDAO := dao.NewGORMArticleCollaboratorDAO(db)
+	articleCollaboratorRepository := repository.NewArticleCollaboratorRepository(articleCollaboratorDAO)
+	mediaDAO := dao.NewGORMMediaDAO(db)
+	mediaRepository := repository.NewMediaRepository(mediaDAO)
+	store := InitObjectStore()
+	mediaConfig := InitMediaConfig()
+	mediaService := service.NewMediaService(mediaRepository, store, mediaConfig, loggerV1)
+	articleReviewDAO := dao.NewGORMArticleReviewDAO(db)
+	articleReviewRepository := repository.NewArticleReviewRepository(articleReviewDAO)
+	moderator := ioc.InitModerator(loggerV1)
 	producer := article.NewSaramaSyncProducer(syncProducer)
-	articleService := service.NewArticleService(articleRepository, producer, loggerV1)
+	articleService := service.NewArticleService(articleRepository, articleCollaboratorRepository, mediaService, articleReviewRepository, moderator, producer, loggerV1)
 	interactiveDAO := dao2.NewGORMInteractiveDAO(db)
 	interactiveCache := cache2.NewRedisInteractiveCache(cmdable)
 	interactiveRepository := repository2.NewCachedInteractiveRepository(interactiveDAO, interactiveCache, loggerV1)
 	interactiveService := service2.NewInteractiveService(interactiveRepository)
-	interactiveServiceClient := ioc.InitIntrClient(interactiveService)
-	articleHandler := web.NewArticleHandler(articleService, interactiveServiceClient, loggerV1)
+	collectionDAO := dao2.NewGORMCollectionDAO(db)
+	collectionRepository := repository2.NewCachedCollectionRepository(collectionDAO, interactiveCache, loggerV1)
+	collectionService := service2.NewCollectionService(collectionRepository)
+	interactiveServiceClient := ioc.InitIntrClient(interactiveService, collectionService)
+	siteConfig := InitSiteConfig()
+	articleHandler := web.NewArticleHandler(articleService, interactiveServiceClient, siteConfig, loggerV1)
 	wechatService := ioc.InitWechatService(loggerV1)
 	oAuth2WechatHandler := web.NewOAuth2WechatHandler(wechatService, handler, userService)
-	engine := ioc.InitWebServer(v, userHandler, articleHandler, oAuth2WechatHandler)
+	registry := ioc.InitOAuth2Registry()
+	oAuth2Handler := web.NewOAuth2Handler(registry, handler, userService)
+	policy := ioc.InitRBACPolicy()
+	builder := ioc.InitRBACBuilder(policy)
+	adminHandler := web.NewAdminHandler(userService, handler, policy, builder)
+	userExportCache := cache.NewUserExportCache(cmdable)
+	userExportRepository := repository.NewUserExportRepository(userExportCache)
+	commentServiceClient := InitCommentClient()
+	followServiceClient := InitFollowClient()
+	userDataConfig := InitUserDataConfig()
+	userDataService := service.NewUserDataService(userRepository, articleRepository, userExportRepository, interactiveServiceClient, commentServiceClient, followServiceClient, twoFactorService, store, userProducer, userDataConfig)
+	userDataHandler := web.NewUserDataHandler(userDataService, handler, guard)
+	mediaHandler := web.NewMediaHandler(mediaService, mediaConfig, loggerV1)
+	columnDAO := dao.NewGORMColumnDAO(db)
+	columnRepository := repository.NewColumnRepository(columnDAO)
+	columnService := service.NewColumnService(columnRepository, articleRepository, loggerV1)
+	columnHandler := web.NewColumnHandler(columnService, loggerV1)
+	articleReviewService := service.NewArticleReviewService(articleReviewRepository, articleRepository, userRepository, emailService, producer, loggerV1)
+	articleReviewHandler := web.NewArticleReviewHandler(articleReviewService, builder)
+	feedHandler := web.NewFeedHandler(articleService, siteConfig, loggerV1)
+	collectionHandler := web.NewCollectionHandler(interactiveServiceClient, loggerV1)
+	userInteractiveHandler := web.NewUserInteractiveHandler(interactiveServiceClient, articleService, loggerV1)
+	jwksHandler := web.NewJWKSHandler(keySet)
+	engine := ioc.InitWebServer(v, userHandler, articleHandler, oAuth2WechatHandler, oAuth2Handler, adminHandler, userDataHandler, mediaHandler, columnHandler, articleReviewHandler, feedHandler, collectionHandler, userInteractiveHandler, jwksHandler)
 	return engine
 }
 
-func InitArticleHandler(dao3 dao.ArticleDAO) *web.ArticleHandler {
+func InitArticleHandler(artDAO dao.ArticleDAO) *web.ArticleHandler {
 	db := InitDB()
 	userDAO := dao.NewUserDAO(db)
 	cmdable := InitRedis()
 	userCache := cache.NewUserCache(cmdable)
 	userRepository := repository.NewUserRepository(userDAO, userCache)
 	articleCache := cache.NewArticleRedisCache(cmdable)
-	articleRepository := repository.NewArticleRepository(dao3, userRepository, articleCache)
+	articleRepository := repository.NewArticleRepository(artDAO, userRepository, articleCache)
+	articleCollaboratorDAO := dao.NewGORMArticleCollaboratorDAO(db)
+	articleCollaboratorRepository := repository.NewArticleCollaboratorRepository(articleCollaboratorDAO)
+	mediaDAO := dao.NewGORMMediaDAO(db)
+	mediaRepository := repository.NewMediaRepository(mediaDAO)
+	store := InitObjectStore()
+	mediaConfig := InitMediaConfig()
+	loggerV1 := InitLogger()
+	mediaService := service.NewMediaService(mediaRepository, store, mediaConfig, loggerV1)
+	articleReviewDAO := dao.NewGORMArticleReviewDAO(db)
+	articleReviewRepository := repository.NewArticleReviewRepository(articleReviewDAO)
+	moderator := ioc.InitModerator(loggerV1)
 	client := InitSaramaClient()
 	syncProducer := InitSyncProducer(client)
 	producer := article.NewSaramaSyncProducer(syncProducer)
-	loggerV1 := InitLogger()
-	articleService := service.NewArticleService(articleRepository, producer, loggerV1)
+	articleService := service.NewArticleService(articleRepository, articleCollaboratorRepository, mediaService, articleReviewRepository, moderator, producer, loggerV1)
 	interactiveDAO := dao2.NewGORMInteractiveDAO(db)
 	interactiveCache := cache2.NewRedisInteractiveCache(cmdable)
 	interactiveRepository := repository2.NewCachedInteractiveRepository(interactiveDAO, interactiveCache, loggerV1)
 	interactiveService := service2.NewInteractiveService(interactiveRepository)
-	interactiveServiceClient := ioc.InitIntrClient(interactiveService)
-	articleHandler := web.NewArticleHandler(articleService, interactiveServiceClient, loggerV1)
+	collectionDAO := dao2.NewGORMCollectionDAO(db)
+	collectionRepository := repository2.NewCachedCollectionRepository(collectionDAO, interactiveCache, loggerV1)
+	collectionService := service2.NewCollectionService(collectionRepository)
+	interactiveServiceClient := ioc.InitIntrClient(interactiveService, collectionService)
+	siteConfig := InitSiteConfig()
+	articleHandler := web.NewArticleHandler(articleService, interactiveServiceClient, siteConfig, loggerV1)
 	return articleHandler
 }
 
@@ -100,10 +162,16 @@ var thirdPartySet = wire.NewSet(
 	InitSaramaClient,
 	InitSyncProducer)
 
-var userSvcProvider = wire.NewSet(dao.NewUserDAO, cache.NewUserCache, repository.NewUserRepository, service.NewUserService)
+var userSvcProvider = wire.NewSet(dao.NewUserDAO, cache.NewUserCache, cache.NewUserTokenCache, repository.NewUserRepository, repository.NewUserTokenRepository, ioc.InitEmailService, InitUserConfig, service.NewUserService)
+
+var articlSvcProvider = wire.NewSet(repository.NewArticleRepository, cache.NewArticleRedisCache, dao.NewArticleGORMDAO, dao.NewGORMArticleCollaboratorDAO, repository.NewArticleCollaboratorRepository, dao.NewGORMArticleReviewDAO, repository.NewArticleReviewRepository, ioc.InitModerator, service.NewArticleService)
+
+var mediaSvcProvider = wire.NewSet(dao.NewGORMMediaDAO, repository.NewMediaRepository, InitObjectStore,
+	InitMediaConfig, service.NewMediaService,
+)
 
-var articlSvcProvider = wire.NewSet(repository.NewArticleRepository, cache.NewArticleRedisCache, dao.NewArticleGORMDAO, service.NewArticleService)
+var columnSvcProvider = wire.NewSet(dao.NewGORMColumnDAO, repository.NewColumnRepository, service.NewColumnService)
 
-var interactiveSvcSet = wire.NewSet(dao2.NewGORMInteractiveDAO, cache2.NewRedisInteractiveCache, repository2.NewCachedInteractiveRepository, service2.NewInteractiveService, ioc.InitIntrClient)
+var interactiveSvcSet = wire.NewSet(dao2.NewGORMInteractiveDAO, cache2.NewRedisInteractiveCache, repository2.NewCachedInteractiveRepository, service2.NewInteractiveService, dao2.NewGORMCollectionDAO, repository2.NewCachedCollectionRepository, service2.NewCollectionService, ioc.InitIntrClient)
 
 var jobProviderSet = wire.NewSet(service.NewCronJobService, repository.NewPreemptJobRepository, dao.NewGORMJobDAO)
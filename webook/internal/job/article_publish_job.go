@@ -0,0 +1,41 @@
+package job
+
+import (
+	"context"
+	"time"
+	"webook/internal/domain"
+	"webook/internal/service"
+	"webook/pkg/logger"
+)
+
+// ArticlePublishJob 定时发表文章，注册在 LocalFuncExecutor 上由 Scheduler 调度
+// Scheduler 抢占任务保证同一时刻只有一个实例在跑，
+// 即便续约失败导致两个实例同时跑，DAO 里面的 CAS 也保证每篇文章只发表一次
+type ArticlePublishJob struct {
+	svc     service.ArticleService
+	l       logger.LoggerV1
+	timeout time.Duration
+}
+
+func NewArticlePublishJob(svc service.ArticleService,
+	l logger.LoggerV1, timeout time.Duration) *ArticlePublishJob {
+	return &ArticlePublishJob{
+		svc:     svc,
+		l:       l,
+		timeout: timeout,
+	}
+}
+
+func (j *ArticlePublishJob) Name() string {
+	return "article_scheduled_publish"
+}
+
+func (j *ArticlePublishJob) Exec(ctx context.Context, _ domain.Job) error {
+	ctx, cancel := context.WithTimeout(ctx, j.timeout)
+	defer cancel()
+	cnt, err := j.svc.PublishDue(ctx, time.Now())
+	if cnt > 0 {
+		j.l.Info("定时发表文章", logger.Int64("cnt", int64(cnt)))
+	}
+	return err
+}
@@ -46,6 +46,8 @@ func (l *LocalFuncExecutor) Exec(ctx context.Context, j domain.Job) error {
 
 type Scheduler struct {
 	dbTimeout time.Duration
+	// idleInterval 没有抢到任务的时候，隔多久再抢
+	idleInterval time.Duration
 
 	svc service.CronJobService
 
@@ -57,11 +59,12 @@ type Scheduler struct {
 
 func NewScheduler(svc service.CronJobService, l logger.LoggerV1) *Scheduler {
 	return &Scheduler{
-		svc:       svc,
-		dbTimeout: time.Second,
-		limiter:   semaphore.NewWeighted(100),
-		l:         l,
-		executors: map[string]Executor{},
+		svc:          svc,
+		dbTimeout:    time.Second,
+		idleInterval: time.Second,
+		limiter:      semaphore.NewWeighted(100),
+		l:            l,
+		executors:    map[string]Executor{},
 	}
 }
 
@@ -83,8 +86,13 @@ func (s *Scheduler) Schedule(ctx context.Context) error {
 		j, err := s.svc.Preempt(dbCtx)
 		cancel()
 		if err != nil {
-			// 有 Error
-			// 最简单的做法就是直接下一轮，也可以睡一段时间
+			// 有 Error，一般是没有可以执行的任务
+			// 睡一段时间再下一轮，避免一直查数据库
+			s.limiter.Release(1)
+			select {
+			case <-ctx.Done():
+			case <-time.After(s.idleInterval):
+			}
 			continue
 		}
 
@@ -95,6 +103,8 @@ func (s *Scheduler) Schedule(ctx context.Context) error {
 			s.l.Error("找不到执行器",
 				logger.Int64("jid", j.Id),
 				logger.String("executor", j.Executor))
+			s.limiter.Release(1)
+			j.CancelFunc()
 			continue
 		}
 
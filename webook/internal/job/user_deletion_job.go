@@ -0,0 +1,47 @@
+package job
+
+import (
+	"context"
+	"time"
+	"webook/internal/service"
+	"webook/pkg/logger"
+)
+
+// UserDeletionJob 定时注销冷静期已经过了的用户，顺便清理过期的导出数据
+// 多个实例同时跑也没关系，匿名化、下游的清理和删除过期文件都是幂等的
+type UserDeletionJob struct {
+	svc     service.UserDataService
+	l       logger.LoggerV1
+	timeout time.Duration
+}
+
+func NewUserDeletionJob(svc service.UserDataService,
+	l logger.LoggerV1, timeout time.Duration) *UserDeletionJob {
+	return &UserDeletionJob{
+		svc:     svc,
+		l:       l,
+		timeout: timeout,
+	}
+}
+
+func (j *UserDeletionJob) Name() string {
+	return "user_deletion"
+}
+
+func (j *UserDeletionJob) Run() error {
+	ctx, cancel := context.WithTimeout(context.Background(), j.timeout)
+	defer cancel()
+	now := time.Now()
+	cnt, err := j.svc.DeleteDue(ctx, now)
+	if cnt > 0 {
+		j.l.Info("注销用户", logger.Int64("cnt", int64(cnt)))
+	}
+	if err != nil {
+		return err
+	}
+	cnt, err = j.svc.ExpireExports(ctx, now)
+	if cnt > 0 {
+		j.l.Info("删除过期的导出数据", logger.Int64("cnt", int64(cnt)))
+	}
+	return err
+}
@@ -2,24 +2,53 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 	"webook/internal/domain"
 	"webook/internal/repository/cache"
 	"webook/internal/repository/dao"
+	"webook/pkg/mdx"
 
 	"github.com/ecodeclub/ekit/slice"
 )
 
+var (
+	ErrArticleNotFound         = dao.ErrDataNotFound
+	ErrArticleRevisionNotFound = dao.ErrDataNotFound
+	ErrScheduledArticleNotDue  = dao.ErrScheduledArticleNotDue
+	ErrArticleVersionConflict  = dao.ErrArticleVersionConflict
+	ErrArticleNotPending       = dao.ErrArticleNotPending
+)
+
 //go:generate mockgen -source=./article.go -package=repomocks -destination=./mocks/article.mock.go ArticleRepository
 type ArticleRepository interface {
 	Create(ctx context.Context, art domain.Article) (int64, error)
 	Update(ctx context.Context, art domain.Article) error
 	Sync(ctx context.Context, art domain.Article) (int64, error)
 	SyncStatus(ctx context.Context, uid int64, id int64, status domain.ArticleStatus) error
-	GetByAuthor(ctx context.Context, uid int64, offset int, limit int) ([]domain.Article, error)
+	// GetByAuthor colId 为 0 就是全部文章
+	GetByAuthor(ctx context.Context, uid int64, colId int64, cursor domain.ArticleCursor, limit int) ([]domain.Article, error)
 	GetById(ctx context.Context, id int64) (domain.Article, error)
 	GetPubById(ctx context.Context, id int64) (domain.Article, error)
-	ListPub(ctx context.Context, start time.Time, offset int, limit int) ([]domain.Article, error)
+	// GetPubByIds 只返回已经发表的，顺序和 ids 无关，只有 ID 和标题
+	GetPubByIds(ctx context.Context, ids []int64) ([]domain.Article, error)
+	ListPub(ctx context.Context, cursor domain.ArticleCursor, limit int) ([]domain.Article, error)
+	// ListPubByAuthor 某个作者已经发表的文章，带上了作者的昵称
+	ListPubByAuthor(ctx context.Context, uid int64, cursor domain.ArticleCursor, limit int) ([]domain.Article, error)
+
+	// ListRevisions 只会返回 uid 自己的文章的历史版本
+	ListRevisions(ctx context.Context, uid int64, artId int64, offset int, limit int) ([]domain.ArticleRevision, error)
+	GetRevision(ctx context.Context, id int64) (domain.ArticleRevision, error)
+
+	// ListDueScheduled 到了发表时间的定时发表的文章
+	ListDueScheduled(ctx context.Context, now time.Time, limit int) ([]domain.Article, error)
+	// PublishScheduled 发表定时发表的文章，只有一个调用者能成功
+	PublishScheduled(ctx context.Context, art domain.Article, now time.Time) error
+
+	// ApprovePending 审核通过，art.Version 是提交审核的时候的版本号
+	ApprovePending(ctx context.Context, art domain.Article, now time.Time) error
+	// RejectPending 审核不通过，文章回到未发表状态
+	RejectPending(ctx context.Context, art domain.Article) error
 }
 
 type CachedArticleRepository struct {
@@ -54,21 +83,27 @@ func (ar *CachedArticleRepository) Create(ctx context.Context, art domain.Articl
 func (ar *CachedArticleRepository) Update(ctx context.Context, art domain.Article) error {
 	err := ar.ad.UpdateById(ctx, ar.toEntity(art))
 	if err == nil {
-		er := ar.ac.DelFirstPage(ctx, art.Author.Id)
-		if er != nil {
-			// 也要记录日志
-		}
+		ar.delCache(ctx, art.Author.Id, art.Id)
 	}
 	return err
 }
 
+// delCache 草稿变了，第一页和详情的缓存都要删掉
+func (ar *CachedArticleRepository) delCache(ctx context.Context, uid int64, id int64) {
+	er := ar.ac.DelFirstPage(ctx, uid)
+	if er != nil {
+		// 也要记录日志
+	}
+	er = ar.ac.Del(ctx, id)
+	if er != nil {
+		// 也要记录日志
+	}
+}
+
 func (ar *CachedArticleRepository) Sync(ctx context.Context, art domain.Article) (int64, error) {
 	id, err := ar.ad.Sync(ctx, ar.toEntity(art))
 	if err == nil {
-		er := ar.ac.DelFirstPage(ctx, art.Author.Id)
-		if er != nil {
-			// 也要记录日志
-		}
+		ar.delCache(ctx, art.Author.Id, id)
 	}
 	// 在这里尝试，设置缓存
 	go func() {
@@ -92,21 +127,82 @@ func (ar *CachedArticleRepository) Sync(ctx context.Context, art domain.Article)
 	return id, err
 }
 
+func (ar *CachedArticleRepository) ListDueScheduled(ctx context.Context, now time.Time, limit int) ([]domain.Article, error) {
+	arts, err := ar.ad.FindDueScheduled(ctx, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map[dao.Article, domain.Article](arts, func(idx int, src dao.Article) domain.Article {
+		return ar.toDomain(src)
+	}), nil
+}
+
+func (ar *CachedArticleRepository) PublishScheduled(ctx context.Context, art domain.Article, now time.Time) error {
+	err := ar.ad.PublishScheduled(ctx, art.Id, now)
+	if err != nil {
+		return err
+	}
+	ar.delCache(ctx, art.Author.Id, art.Id)
+	ar.preCachePub(art.Id)
+	return nil
+}
+
+// preCachePub 和 Sync 一样预加载线上库的缓存，内容以数据库为准
+func (ar *CachedArticleRepository) preCachePub(id int64) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		pub, er := ar.ad.GetPubById(ctx, id)
+		if er != nil {
+			return
+		}
+		user, er := ar.ur.FindById(ctx, pub.AuthorId)
+		if er != nil {
+			return
+		}
+		res := ar.toDomain(dao.Article(pub))
+		res.Author.Name = user.Nickname
+		er = ar.ac.SetPub(ctx, res)
+		if er != nil {
+			// 记录日志
+		}
+	}()
+}
+
+func (ar *CachedArticleRepository) ApprovePending(ctx context.Context, art domain.Article, now time.Time) error {
+	err := ar.ad.ApprovePending(ctx, art.Id, art.Version, now)
+	if err != nil {
+		return err
+	}
+	ar.delCache(ctx, art.Author.Id, art.Id)
+	// 变成定时发表的时候线上库还是原来的内容，预加载的也是原来的，没发表过就直接失败
+	ar.preCachePub(art.Id)
+	return nil
+}
+
+func (ar *CachedArticleRepository) RejectPending(ctx context.Context, art domain.Article) error {
+	err := ar.ad.RejectPending(ctx, art.Id, art.Version)
+	if err == nil {
+		ar.delCache(ctx, art.Author.Id, art.Id)
+	}
+	return err
+}
+
 func (ar *CachedArticleRepository) SyncStatus(ctx context.Context, uid int64, id int64, status domain.ArticleStatus) error {
 	err := ar.ad.SyncStatus(ctx, uid, id, status.ToUint8())
 	if err == nil {
-		er := ar.ac.DelFirstPage(ctx, uid)
-		if er != nil {
-			// 也要记录日志
-		}
+		ar.delCache(ctx, uid, id)
 	}
 	return err
 }
 
-func (ar *CachedArticleRepository) GetByAuthor(ctx context.Context, uid int64, offset int, limit int) ([]domain.Article, error) {
+func (ar *CachedArticleRepository) GetByAuthor(ctx context.Context, uid int64, colId int64,
+	cursor domain.ArticleCursor, limit int) ([]domain.Article, error) {
 	// 首先第一步，判定要不要查询缓存
 	// 事实上， limit <= 100 都可以查询缓存
-	if offset == 0 && limit == 100 {
+	// 只缓存不按照专栏过滤的第一页
+	useCache := colId == 0 && cursor.IsZero() && limit == 100
+	if useCache {
 		res, err := ar.ac.GetFirstPage(ctx, uid)
 		if err == nil {
 			return res, err
@@ -115,7 +211,7 @@ func (ar *CachedArticleRepository) GetByAuthor(ctx context.Context, uid int64, o
 			// 缓存未命中，你是可以忽略的
 		}
 	}
-	arts, err := ar.ad.GetByAuthor(ctx, uid, offset, limit)
+	arts, err := ar.ad.GetByAuthor(ctx, uid, colId, ar.toCursor(cursor), limit)
 	if err != nil {
 		return nil, err
 	}
@@ -126,7 +222,7 @@ func (ar *CachedArticleRepository) GetByAuthor(ctx context.Context, uid int64, o
 	go func() {
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 		defer cancel()
-		if offset == 0 && limit == 100 {
+		if useCache {
 			// 缓存回写失败，不一定是大问题，但有可能是大问题
 			err = ar.ac.SetFirstPage(ctx, uid, res)
 			if err != nil {
@@ -191,8 +287,19 @@ func (ar *CachedArticleRepository) GetPubById(ctx context.Context, id int64) (do
 	return res, nil
 }
 
-func (car *CachedArticleRepository) ListPub(ctx context.Context, start time.Time, offset int, limit int) ([]domain.Article, error) {
-	arts, err := car.ad.ListPub(ctx, start, offset, limit)
+func (car *CachedArticleRepository) GetPubByIds(ctx context.Context, ids []int64) ([]domain.Article, error) {
+	arts, err := car.ad.GetPubByIds(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map[dao.PublishedArticle, domain.Article](arts,
+		func(idx int, src dao.PublishedArticle) domain.Article {
+			return car.toDomain(dao.Article(src))
+		}), nil
+}
+
+func (car *CachedArticleRepository) ListPub(ctx context.Context, cursor domain.ArticleCursor, limit int) ([]domain.Article, error) {
+	arts, err := car.ad.ListPub(ctx, car.toCursor(cursor), limit)
 	if err != nil {
 		return nil, err
 	}
@@ -202,17 +309,106 @@ func (car *CachedArticleRepository) ListPub(ctx context.Context, start time.Time
 		}), nil
 }
 
+func (car *CachedArticleRepository) ListPubByAuthor(ctx context.Context, uid int64,
+	cursor domain.ArticleCursor, limit int) ([]domain.Article, error) {
+	arts, err := car.ad.ListPubByAuthor(ctx, uid, car.toCursor(cursor), limit)
+	if err != nil || len(arts) == 0 {
+		// 用户不存在和没有发表过文章是一样的，都是空的
+		return nil, err
+	}
+	author, err := car.ur.FindById(ctx, uid)
+	if err != nil && err != ErrUserNotFound {
+		return nil, err
+	}
+	return slice.Map[dao.PublishedArticle, domain.Article](arts,
+		func(idx int, src dao.PublishedArticle) domain.Article {
+			res := car.toDomain(dao.Article(src))
+			res.Author.Name = author.Nickname
+			return res
+		}), nil
+}
+
+func (ar *CachedArticleRepository) ListRevisions(ctx context.Context, uid int64, artId int64,
+	offset int, limit int) ([]domain.ArticleRevision, error) {
+	revs, err := ar.ad.GetRevisions(ctx, artId, uid, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map[dao.ArticleRevision, domain.ArticleRevision](revs,
+		func(idx int, src dao.ArticleRevision) domain.ArticleRevision {
+			return ar.revisionToDomain(src)
+		}), nil
+}
+
+func (ar *CachedArticleRepository) GetRevision(ctx context.Context, id int64) (domain.ArticleRevision, error) {
+	rev, err := ar.ad.GetRevisionById(ctx, id)
+	if err != nil {
+		return domain.ArticleRevision{}, err
+	}
+	return ar.revisionToDomain(rev), nil
+}
+
+func (ar *CachedArticleRepository) revisionToDomain(rev dao.ArticleRevision) domain.ArticleRevision {
+	return domain.ArticleRevision{
+		Id:        rev.Id,
+		ArticleId: rev.ArticleId,
+		Author: domain.Author{
+			Id: rev.AuthorId,
+		},
+		Title:   rev.Title,
+		Content: rev.Content,
+		Status:  domain.ArticleStatus(rev.Status),
+		Kind:    domain.RevisionKind(rev.Kind),
+		Ctime:   time.UnixMilli(rev.Ctime),
+	}
+}
+
+func (car *CachedArticleRepository) toCursor(cursor domain.ArticleCursor) dao.Cursor {
+	if cursor.IsZero() {
+		return dao.Cursor{}
+	}
+	return dao.Cursor{
+		Utime: cursor.Utime.UnixMilli(),
+		Id:    cursor.Id,
+	}
+}
+
 func (car *CachedArticleRepository) toEntity(art domain.Article) dao.Article {
+	var publishAt int64
+	if !art.PublishAt.IsZero() {
+		publishAt = art.PublishAt.UnixMilli()
+	}
+	var toc string
+	if len(art.Rendered.Toc) > 0 {
+		// 不会出错
+		val, _ := json.Marshal(art.Rendered.Toc)
+		toc = string(val)
+	}
 	return dao.Article{
-		Id:       art.Id,
-		Title:    art.Title,
-		Content:  art.Content,
-		AuthorId: art.Author.Id,
-		Status:   art.Status.ToUint8(),
+		Id:        art.Id,
+		Title:     art.Title,
+		Content:   art.Content,
+		AuthorId:  art.Author.Id,
+		Status:    art.Status.ToUint8(),
+		Version:   art.Version,
+		PublishAt: publishAt,
+		CoverId:   art.Cover.Id,
+		Html:      art.Rendered.Html,
+		Toc:       toc,
+		Abstract:  art.Rendered.Abstract,
 	}
 }
 
 func (c *CachedArticleRepository) toDomain(art dao.Article) domain.Article {
+	var publishAt time.Time
+	if art.PublishAt > 0 {
+		publishAt = time.UnixMilli(art.PublishAt)
+	}
+	var toc []mdx.Heading
+	if art.Toc != "" {
+		// 解析失败就当作没有目录
+		_ = json.Unmarshal([]byte(art.Toc), &toc)
+	}
 	return domain.Article{
 		Id:      art.Id,
 		Title:   art.Title,
@@ -220,9 +416,19 @@ func (c *CachedArticleRepository) toDomain(art dao.Article) domain.Article {
 		Author: domain.Author{
 			Id: art.AuthorId,
 		},
-		Ctime:  time.UnixMilli(art.Ctime),
-		Utime:  time.UnixMilli(art.Utime),
-		Status: domain.ArticleStatus(art.Status),
+		Ctime:     time.UnixMilli(art.Ctime),
+		Utime:     time.UnixMilli(art.Utime),
+		Status:    domain.ArticleStatus(art.Status),
+		Version:   art.Version,
+		PublishAt: publishAt,
+		Cover: domain.Media{
+			Id: art.CoverId,
+		},
+		Rendered: domain.ArticleRendered{
+			Html:     art.Html,
+			Toc:      toc,
+			Abstract: art.Abstract,
+		},
 	}
 }
 
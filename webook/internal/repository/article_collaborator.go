@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"webook/internal/domain"
+	"webook/internal/repository/dao"
+
+	"github.com/ecodeclub/ekit/slice"
+)
+
+var ErrCollaboratorNotFound = dao.ErrDataNotFound
+
+//go:generate mockgen -source=./article_collaborator.go -package=repomocks -destination=./mocks/article_collaborator.mock.go ArticleCollaboratorRepository
+type ArticleCollaboratorRepository interface {
+	// Save 邀请协作者，已经是协作者了就修改权限
+	Save(ctx context.Context, c domain.ArticleCollaborator) error
+	Delete(ctx context.Context, artId int64, uid int64) error
+	Find(ctx context.Context, artId int64, uid int64) (domain.ArticleCollaborator, error)
+	FindByArticle(ctx context.Context, artId int64) ([]domain.ArticleCollaborator, error)
+
+	AddAuditLog(ctx context.Context, l domain.ArticleAuditLog) error
+	ListAuditLogs(ctx context.Context, artId int64, offset int, limit int) ([]domain.ArticleAuditLog, error)
+}
+
+type GORMArticleCollaboratorRepository struct {
+	dao dao.ArticleCollaboratorDAO
+}
+
+func NewArticleCollaboratorRepository(dao dao.ArticleCollaboratorDAO) ArticleCollaboratorRepository {
+	return &GORMArticleCollaboratorRepository{
+		dao: dao,
+	}
+}
+
+func (r *GORMArticleCollaboratorRepository) Save(ctx context.Context, c domain.ArticleCollaborator) error {
+	return r.dao.Upsert(ctx, dao.ArticleCollaborator{
+		ArticleId: c.ArticleId,
+		Uid:       c.Uid,
+		Role:      c.Role.ToUint8(),
+		Inviter:   c.Inviter,
+	})
+}
+
+func (r *GORMArticleCollaboratorRepository) Delete(ctx context.Context, artId int64, uid int64) error {
+	return r.dao.Delete(ctx, artId, uid)
+}
+
+func (r *GORMArticleCollaboratorRepository) Find(ctx context.Context, artId int64, uid int64) (domain.ArticleCollaborator, error) {
+	c, err := r.dao.Find(ctx, artId, uid)
+	if err != nil {
+		return domain.ArticleCollaborator{}, err
+	}
+	return r.toDomain(c), nil
+}
+
+func (r *GORMArticleCollaboratorRepository) FindByArticle(ctx context.Context, artId int64) ([]domain.ArticleCollaborator, error) {
+	cs, err := r.dao.FindByArticle(ctx, artId)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map[dao.ArticleCollaborator, domain.ArticleCollaborator](cs,
+		func(idx int, src dao.ArticleCollaborator) domain.ArticleCollaborator {
+			return r.toDomain(src)
+		}), nil
+}
+
+func (r *GORMArticleCollaboratorRepository) AddAuditLog(ctx context.Context, l domain.ArticleAuditLog) error {
+	return r.dao.InsertAuditLog(ctx, dao.ArticleAuditLog{
+		ArticleId: l.ArticleId,
+		Uid:       l.Uid,
+		Action:    l.Action.ToUint8(),
+		Version:   l.Version,
+		Detail:    l.Detail,
+	})
+}
+
+func (r *GORMArticleCollaboratorRepository) ListAuditLogs(ctx context.Context, artId int64,
+	offset int, limit int) ([]domain.ArticleAuditLog, error) {
+	logs, err := r.dao.FindAuditLogs(ctx, artId, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map[dao.ArticleAuditLog, domain.ArticleAuditLog](logs,
+		func(idx int, src dao.ArticleAuditLog) domain.ArticleAuditLog {
+			return domain.ArticleAuditLog{
+				Id:        src.Id,
+				ArticleId: src.ArticleId,
+				Uid:       src.Uid,
+				Action:    domain.ArticleAuditAction(src.Action),
+				Version:   src.Version,
+				Detail:    src.Detail,
+				Ctime:     time.UnixMilli(src.Ctime),
+			}
+		}), nil
+}
+
+func (r *GORMArticleCollaboratorRepository) toDomain(c dao.ArticleCollaborator) domain.ArticleCollaborator {
+	return domain.ArticleCollaborator{
+		ArticleId: c.ArticleId,
+		Uid:       c.Uid,
+		Role:      domain.ArticleRole(c.Role),
+		Inviter:   c.Inviter,
+		Ctime:     time.UnixMilli(c.Ctime),
+		Utime:     time.UnixMilli(c.Utime),
+	}
+}
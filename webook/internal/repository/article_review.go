@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+	"webook/internal/domain"
+	"webook/internal/repository/dao"
+
+	"github.com/ecodeclub/ekit/slice"
+)
+
+var (
+	ErrArticleReviewNotFound = dao.ErrDataNotFound
+	ErrArticleReviewResolved = dao.ErrArticleReviewResolved
+)
+
+//go:generate mockgen -source=./article_review.go -package=repomocks -destination=./mocks/article_review.mock.go ArticleReviewRepository
+type ArticleReviewRepository interface {
+	// Create 提交审核，同一篇文章之前还没处理的会被取消
+	Create(ctx context.Context, r domain.ArticleReview) (int64, error)
+	FindById(ctx context.Context, id int64) (domain.ArticleReview, error)
+	ListPending(ctx context.Context, offset int, limit int) ([]domain.ArticleReview, error)
+	// Resolve 只有待审核的记录能处理，r.Status 是处理的结果
+	Resolve(ctx context.Context, r domain.ArticleReview) error
+	SetStatus(ctx context.Context, id int64, status domain.ArticleReviewStatus) error
+}
+
+type GORMArticleReviewRepository struct {
+	dao dao.ArticleReviewDAO
+}
+
+func NewArticleReviewRepository(dao dao.ArticleReviewDAO) ArticleReviewRepository {
+	return &GORMArticleReviewRepository{
+		dao: dao,
+	}
+}
+
+func (r *GORMArticleReviewRepository) Create(ctx context.Context, review domain.ArticleReview) (int64, error) {
+	reasons, err := json.Marshal(review.Reasons)
+	if err != nil {
+		return 0, err
+	}
+	return r.dao.Insert(ctx, dao.ArticleReview{
+		ArticleId: review.ArticleId,
+		AuthorId:  review.Author.Id,
+		Version:   review.Version,
+		Title:     review.Title,
+		Reasons:   string(reasons),
+	})
+}
+
+func (r *GORMArticleReviewRepository) FindById(ctx context.Context, id int64) (domain.ArticleReview, error) {
+	review, err := r.dao.FindById(ctx, id)
+	if err != nil {
+		return domain.ArticleReview{}, err
+	}
+	return r.toDomain(review), nil
+}
+
+func (r *GORMArticleReviewRepository) ListPending(ctx context.Context, offset int, limit int) ([]domain.ArticleReview, error) {
+	reviews, err := r.dao.FindPending(ctx, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map[dao.ArticleReview, domain.ArticleReview](reviews,
+		func(idx int, src dao.ArticleReview) domain.ArticleReview {
+			return r.toDomain(src)
+		}), nil
+}
+
+func (r *GORMArticleReviewRepository) Resolve(ctx context.Context, review domain.ArticleReview) error {
+	return r.dao.Resolve(ctx, review.Id, review.Status.ToUint8(), review.Reviewer, review.Comment)
+}
+
+func (r *GORMArticleReviewRepository) SetStatus(ctx context.Context, id int64, status domain.ArticleReviewStatus) error {
+	return r.dao.SetStatus(ctx, id, status.ToUint8())
+}
+
+func (r *GORMArticleReviewRepository) toDomain(review dao.ArticleReview) domain.ArticleReview {
+	var reasons []string
+	// 解析失败了也不影响审核，审核的人还是能看到文章
+	_ = json.Unmarshal([]byte(review.Reasons), &reasons)
+	return domain.ArticleReview{
+		Id:        review.Id,
+		ArticleId: review.ArticleId,
+		Author: domain.Author{
+			Id: review.AuthorId,
+		},
+		Version:  review.Version,
+		Title:    review.Title,
+		Reasons:  reasons,
+		Status:   domain.ArticleReviewStatus(review.Status),
+		Reviewer: review.Reviewer,
+		Comment:  review.Comment,
+		Ctime:    time.UnixMilli(review.Ctime),
+		Utime:    time.UnixMilli(review.Utime),
+	}
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"webook/internal/domain"
+	"webook/internal/repository/cache"
+	cachemocks "webook/internal/repository/cache/mocks"
+	"webook/internal/repository/dao"
+	daomocks "webook/internal/repository/dao/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCachedArticleRepository_ListPubByAuthor(t *testing.T) {
+	now := time.UnixMilli(time.Now().UnixMilli())
+	testCases := []struct {
+		name string
+
+		mock func(ctrl *gomock.Controller) (dao.ArticleDAO, cache.UserCache, dao.UserDAO)
+
+		wantArts []domain.Article
+		wantErr  error
+	}{
+		{
+			name: "查询成功",
+			mock: func(ctrl *gomock.Controller) (dao.ArticleDAO, cache.UserCache, dao.UserDAO) {
+				ad := daomocks.NewMockArticleDAO(ctrl)
+				ad.EXPECT().ListPubByAuthor(gomock.Any(), int64(123), gomock.Any(), 10).
+					Return([]dao.PublishedArticle{
+						{Id: 1, Title: "我的标题", AuthorId: 123, Status: 2,
+							Ctime: now.UnixMilli(), Utime: now.UnixMilli()},
+					}, nil)
+				uc := cachemocks.NewMockUserCache(ctrl)
+				uc.EXPECT().Get(gomock.Any(), int64(123)).
+					Return(domain.User{Id: 123, Nickname: "大明"}, nil)
+				return ad, uc, daomocks.NewMockUserDAO(ctrl)
+			},
+			wantArts: []domain.Article{
+				{
+					Id:     1,
+					Title:  "我的标题",
+					Author: domain.Author{Id: 123, Name: "大明"},
+					Status: domain.ArticleStatusPublished,
+					Ctime:  now,
+					Utime:  now,
+				},
+			},
+		},
+		{
+			name: "没有文章，不用查作者",
+			mock: func(ctrl *gomock.Controller) (dao.ArticleDAO, cache.UserCache, dao.UserDAO) {
+				ad := daomocks.NewMockArticleDAO(ctrl)
+				ad.EXPECT().ListPubByAuthor(gomock.Any(), int64(123), gomock.Any(), 10).
+					Return(nil, nil)
+				return ad, cachemocks.NewMockUserCache(ctrl), daomocks.NewMockUserDAO(ctrl)
+			},
+		},
+		{
+			name: "查询文章失败",
+			mock: func(ctrl *gomock.Controller) (dao.ArticleDAO, cache.UserCache, dao.UserDAO) {
+				ad := daomocks.NewMockArticleDAO(ctrl)
+				ad.EXPECT().ListPubByAuthor(gomock.Any(), int64(123), gomock.Any(), 10).
+					Return(nil, errors.New("mock db 错误"))
+				return ad, cachemocks.NewMockUserCache(ctrl), daomocks.NewMockUserDAO(ctrl)
+			},
+			wantErr: errors.New("mock db 错误"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ad, uc, ud := tc.mock(ctrl)
+			repo := NewArticleRepository(ad, NewUserRepository(ud, uc), nil)
+			arts, err := repo.ListPubByAuthor(context.Background(), 123, domain.ArticleCursor{}, 10)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantArts, arts)
+		})
+	}
+}
@@ -17,6 +17,8 @@ type ArticleCache interface {
 	DelFirstPage(ctx context.Context, uid int64) error
 	Get(ctx context.Context, id int64) (domain.Article, error)
 	Set(ctx context.Context, art domain.Article) error
+	// Del 草稿改了之后要删掉，不然读到的版本号是旧的
+	Del(ctx context.Context, id int64) error
 	GetPub(ctx context.Context, id int64) (domain.Article, error)
 	SetPub(ctx context.Context, res domain.Article) error
 }
@@ -77,6 +79,10 @@ func (ac *ArticleRedisCache) Set(ctx context.Context, art domain.Article) error
 	return ac.client.Set(ctx, ac.key(art.Id), val, time.Minute*10).Err()
 }
 
+func (ac *ArticleRedisCache) Del(ctx context.Context, id int64) error {
+	return ac.client.Del(ctx, ac.key(id)).Err()
+}
+
 func (ac *ArticleRedisCache) GetPub(ctx context.Context, id int64) (domain.Article, error) {
 	val, err := ac.client.Get(ctx, ac.pubKey(id)).Bytes()
 	if err != nil {
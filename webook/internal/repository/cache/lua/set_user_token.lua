@@ -0,0 +1,20 @@
+local key = KEYS[1]
+-- 随机生成的 nonce
+local val = ARGV[1]
+-- 过期时间，单位秒
+local expiration = tonumber(ARGV[2])
+-- 两次发送之间至少间隔多久，单位秒
+local interval = tonumber(ARGV[3])
+
+local ttl = tonumber(redis.call("ttl", key))
+if ttl == -1 then
+  -- key 存在但是没有过期时间
+  return -1
+elseif ttl == -2 or ttl < expiration - interval then
+  -- 重新发送之后，之前发出去的 token 就失效了
+  redis.call("set", key, val, "EX", expiration)
+  return 0
+else
+  -- 发送太频繁
+  return -2
+end
@@ -0,0 +1,17 @@
+local key = KEYS[1]
+-- token 里面带着的 nonce
+local expected = ARGV[1]
+
+local val = redis.call("get", key)
+if val == false then
+  -- 不存在或者已经过期，也可能是已经用过了
+  return -1
+end
+
+if val ~= expected then
+  -- 不是最新发出去的那个 token
+  return -2
+end
+-- 一个 token 只能用一次
+redis.call("del", key)
+return 0
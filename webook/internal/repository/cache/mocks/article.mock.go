@@ -40,6 +40,20 @@ func (m *MockArticleCache) EXPECT() *MockArticleCacheMockRecorder {
 	return m.recorder
 }
 
+// Del mocks base method.
+func (m *MockArticleCache) Del(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Del", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Del indicates an expected call of Del.
+func (mr *MockArticleCacheMockRecorder) Del(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Del", reflect.TypeOf((*MockArticleCache)(nil).Del), ctx, id)
+}
+
 // DelFirstPage mocks base method.
 func (m *MockArticleCache) DelFirstPage(ctx context.Context, uid int64) error {
 	m.ctrl.T.Helper()
@@ -16,6 +16,7 @@ var ErrKeyNotExist = redis.Nil
 type UserCache interface {
 	Get(ctx context.Context, uid int64) (domain.User, error)
 	Set(ctx context.Context, du domain.User) error
+	Del(ctx context.Context, uid int64) error
 }
 
 type RedisUserCache struct {
@@ -45,6 +46,10 @@ func (uc *RedisUserCache) Set(ctx context.Context, du domain.User) error {
 	return uc.cmd.Set(ctx, key, data, uc.expiration).Err()
 }
 
+func (uc *RedisUserCache) Del(ctx context.Context, uid int64) error {
+	return uc.cmd.Del(ctx, uc.key(uid)).Err()
+}
+
 func (c *RedisUserCache) key(uid int64) string {
 	return fmt.Sprintf("info:uid:%d", uid)
 }
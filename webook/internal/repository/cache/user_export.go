@@ -0,0 +1,52 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+	"webook/internal/domain"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// UserExportCache 导出任务的状态只需要保留到文件过期，所以直接放在 Redis 里面
+//
+//go:generate mockgen -source=./user_export.go -package=cachemocks -destination=./mocks/user_export.mock.go UserExportCache
+type UserExportCache interface {
+	// Get 没有导出任务的时候返回 ErrKeyNotExist
+	Get(ctx context.Context, uid int64) (domain.UserExport, error)
+	Set(ctx context.Context, export domain.UserExport, expiration time.Duration) error
+}
+
+type RedisUserExportCache struct {
+	cmd redis.Cmdable
+}
+
+func NewUserExportCache(cmd redis.Cmdable) UserExportCache {
+	return &RedisUserExportCache{
+		cmd: cmd,
+	}
+}
+
+func (c *RedisUserExportCache) Get(ctx context.Context, uid int64) (domain.UserExport, error) {
+	data, err := c.cmd.Get(ctx, c.key(uid)).Bytes()
+	if err != nil {
+		return domain.UserExport{}, err
+	}
+	var res domain.UserExport
+	err = json.Unmarshal(data, &res)
+	return res, err
+}
+
+func (c *RedisUserExportCache) Set(ctx context.Context, export domain.UserExport, expiration time.Duration) error {
+	data, err := json.Marshal(export)
+	if err != nil {
+		return err
+	}
+	return c.cmd.Set(ctx, c.key(export.Uid), data, expiration).Err()
+}
+
+func (c *RedisUserExportCache) key(uid int64) string {
+	return fmt.Sprintf("user:export:%d", uid)
+}
@@ -0,0 +1,94 @@
+package cache
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	//go:embed lua/set_user_token.lua
+	luaSetUserToken string
+	//go:embed lua/verify_user_token.lua
+	luaVerifyUserToken string
+
+	ErrUserTokenSendTooMany = errors.New("发送太频繁")
+	ErrUserTokenInvalid     = errors.New("token 不存在、已过期或者已经使用过")
+)
+
+// UserTokenCache 存储邮箱验证、重置密码这一类一次性 token 的 nonce
+//
+//go:generate mockgen -source=./user_token.go -package=cachemocks -destination=./mocks/user_token.mock.go UserTokenCache
+type UserTokenCache interface {
+	// Set 同一个 biz 下，一个用户只有最新的 nonce 是有效的
+	Set(ctx context.Context, biz string, uid int64, nonce string, expiration time.Duration) error
+	// Verify 校验通过之后 nonce 就会被删除
+	Verify(ctx context.Context, biz string, uid int64, nonce string) error
+	// Throttle 按照 target（比如说邮箱）限制发送频率，不管用户存不存在都要先调用，
+	// 这样频率限制的响应就不会暴露用户是否存在
+	Throttle(ctx context.Context, biz string, target string) error
+}
+
+type RedisUserTokenCache struct {
+	cmd redis.Cmdable
+	// interval 两次发送之间的最小间隔
+	interval time.Duration
+}
+
+func NewUserTokenCache(cmd redis.Cmdable) UserTokenCache {
+	return &RedisUserTokenCache{
+		cmd:      cmd,
+		interval: time.Minute,
+	}
+}
+
+func (c *RedisUserTokenCache) Set(ctx context.Context, biz string, uid int64,
+	nonce string, expiration time.Duration) error {
+	res, err := c.cmd.Eval(ctx, luaSetUserToken, []string{c.key(biz, uid)},
+		nonce, int64(expiration.Seconds()), int64(c.interval.Seconds())).Int()
+	if err != nil {
+		return err
+	}
+	switch res {
+	case -1:
+		return errors.New("token 存在，但是没有过期时间")
+	case -2:
+		return ErrUserTokenSendTooMany
+	default:
+		return nil
+	}
+}
+
+func (c *RedisUserTokenCache) Verify(ctx context.Context, biz string, uid int64, nonce string) error {
+	res, err := c.cmd.Eval(ctx, luaVerifyUserToken, []string{c.key(biz, uid)}, nonce).Int()
+	if err != nil {
+		return err
+	}
+	if res != 0 {
+		return ErrUserTokenInvalid
+	}
+	return nil
+}
+
+func (c *RedisUserTokenCache) Throttle(ctx context.Context, biz string, target string) error {
+	ok, err := c.cmd.SetNX(ctx, c.throttleKey(biz, target), 1, c.interval).Result()
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrUserTokenSendTooMany
+	}
+	return nil
+}
+
+func (c *RedisUserTokenCache) throttleKey(biz string, target string) string {
+	return fmt.Sprintf("user_token:throttle:%s:%s", biz, target)
+}
+
+func (c *RedisUserTokenCache) key(biz string, uid int64) string {
+	return fmt.Sprintf("user_token:%s:%d", biz, uid)
+}
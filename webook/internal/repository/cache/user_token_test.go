@@ -0,0 +1,141 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"webook/internal/repository/cache/redismocks"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRedisUserTokenCache_Verify(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		mock func(ctrl *gomock.Controller) redis.Cmdable
+
+		wantErr error
+	}{
+		{
+			name: "校验通过",
+			mock: func(ctrl *gomock.Controller) redis.Cmdable {
+				res := redismocks.NewMockCmdable(ctrl)
+				cmd := redis.NewCmd(context.Background())
+				cmd.SetVal(int64(0))
+				res.EXPECT().Eval(gomock.Any(), luaVerifyUserToken,
+					[]string{"user_token:reset_password:123"}, []any{"nonce"}).
+					Return(cmd)
+				return res
+			},
+		},
+		{
+			name: "已经用过或者过期",
+			mock: func(ctrl *gomock.Controller) redis.Cmdable {
+				res := redismocks.NewMockCmdable(ctrl)
+				cmd := redis.NewCmd(context.Background())
+				cmd.SetVal(int64(-1))
+				res.EXPECT().Eval(gomock.Any(), luaVerifyUserToken,
+					[]string{"user_token:reset_password:123"}, []any{"nonce"}).
+					Return(cmd)
+				return res
+			},
+			wantErr: ErrUserTokenInvalid,
+		},
+		{
+			name: "不是最新的 token",
+			mock: func(ctrl *gomock.Controller) redis.Cmdable {
+				res := redismocks.NewMockCmdable(ctrl)
+				cmd := redis.NewCmd(context.Background())
+				cmd.SetVal(int64(-2))
+				res.EXPECT().Eval(gomock.Any(), luaVerifyUserToken,
+					[]string{"user_token:reset_password:123"}, []any{"nonce"}).
+					Return(cmd)
+				return res
+			},
+			wantErr: ErrUserTokenInvalid,
+		},
+		{
+			name: "redis 返回 error",
+			mock: func(ctrl *gomock.Controller) redis.Cmdable {
+				res := redismocks.NewMockCmdable(ctrl)
+				cmd := redis.NewCmd(context.Background())
+				cmd.SetErr(errors.New("redis错误"))
+				res.EXPECT().Eval(gomock.Any(), luaVerifyUserToken,
+					[]string{"user_token:reset_password:123"}, []any{"nonce"}).
+					Return(cmd)
+				return res
+			},
+			wantErr: errors.New("redis错误"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := NewUserTokenCache(tc.mock(ctrl))
+			err := c.Verify(context.Background(), "reset_password", 123, "nonce")
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+func TestRedisUserTokenCache_Set(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	cmdable := redismocks.NewMockCmdable(ctrl)
+	cmd := redis.NewCmd(context.Background())
+	cmd.SetVal(int64(-2))
+	cmdable.EXPECT().Eval(gomock.Any(), luaSetUserToken,
+		[]string{"user_token:verify_email:123"}, []any{"nonce", int64(1800), int64(60)}).
+		Return(cmd)
+	c := NewUserTokenCache(cmdable)
+	err := c.Set(context.Background(), "verify_email", 123, "nonce", 30*time.Minute)
+	assert.Equal(t, ErrUserTokenSendTooMany, err)
+}
+
+func TestRedisUserTokenCache_Throttle(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		mock func(ctrl *gomock.Controller) redis.Cmdable
+
+		wantErr error
+	}{
+		{
+			name: "可以发送",
+			mock: func(ctrl *gomock.Controller) redis.Cmdable {
+				res := redismocks.NewMockCmdable(ctrl)
+				cmd := redis.NewBoolCmd(context.Background())
+				cmd.SetVal(true)
+				res.EXPECT().SetNX(gomock.Any(), "user_token:throttle:reset_password:a@qq.com",
+					1, time.Minute).Return(cmd)
+				return res
+			},
+		},
+		{
+			name: "发送太频繁",
+			mock: func(ctrl *gomock.Controller) redis.Cmdable {
+				res := redismocks.NewMockCmdable(ctrl)
+				cmd := redis.NewBoolCmd(context.Background())
+				cmd.SetVal(false)
+				res.EXPECT().SetNX(gomock.Any(), "user_token:throttle:reset_password:a@qq.com",
+					1, time.Minute).Return(cmd)
+				return res
+			},
+			wantErr: ErrUserTokenSendTooMany,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			c := NewUserTokenCache(tc.mock(ctrl))
+			err := c.Throttle(context.Background(), "reset_password", "a@qq.com")
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"webook/internal/domain"
+	"webook/internal/repository/dao"
+
+	"github.com/ecodeclub/ekit/slice"
+)
+
+var (
+	ErrColumnNotFound         = dao.ErrDataNotFound
+	ErrArticleAlreadyInColumn = dao.ErrArticleAlreadyInColumn
+	ErrColumnArticlesMismatch = dao.ErrColumnArticlesMismatch
+)
+
+//go:generate mockgen -source=./column.go -package=repomocks -destination=./mocks/column.mock.go ColumnRepository
+type ColumnRepository interface {
+	Create(ctx context.Context, c domain.Column) (int64, error)
+	Update(ctx context.Context, c domain.Column) error
+	Delete(ctx context.Context, uid int64, id int64) error
+	FindById(ctx context.Context, id int64) (domain.Column, error)
+	FindByAuthor(ctx context.Context, uid int64) ([]domain.Column, error)
+
+	AddArticle(ctx context.Context, colId int64, artId int64) error
+	RemoveArticle(ctx context.Context, colId int64, artId int64) error
+	Reorder(ctx context.Context, colId int64, artIds []int64) error
+	ListPubArticles(ctx context.Context, colId int64, offset int, limit int) ([]domain.Article, error)
+	// FindNav 文章不在任何专栏里面的时候返回 ErrColumnNotFound
+	FindNav(ctx context.Context, artId int64) (domain.ColumnNav, error)
+}
+
+type GORMColumnRepository struct {
+	dao dao.ColumnDAO
+}
+
+func NewColumnRepository(dao dao.ColumnDAO) ColumnRepository {
+	return &GORMColumnRepository{
+		dao: dao,
+	}
+}
+
+func (r *GORMColumnRepository) Create(ctx context.Context, c domain.Column) (int64, error) {
+	return r.dao.Insert(ctx, r.toEntity(c))
+}
+
+func (r *GORMColumnRepository) Update(ctx context.Context, c domain.Column) error {
+	return r.dao.UpdateById(ctx, r.toEntity(c))
+}
+
+func (r *GORMColumnRepository) Delete(ctx context.Context, uid int64, id int64) error {
+	return r.dao.Delete(ctx, uid, id)
+}
+
+func (r *GORMColumnRepository) FindById(ctx context.Context, id int64) (domain.Column, error) {
+	c, err := r.dao.FindById(ctx, id)
+	if err != nil {
+		return domain.Column{}, err
+	}
+	return r.toDomain(c), nil
+}
+
+func (r *GORMColumnRepository) FindByAuthor(ctx context.Context, uid int64) ([]domain.Column, error) {
+	cs, err := r.dao.FindByAuthor(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map[dao.Column, domain.Column](cs, func(idx int, src dao.Column) domain.Column {
+		return r.toDomain(src)
+	}), nil
+}
+
+func (r *GORMColumnRepository) AddArticle(ctx context.Context, colId int64, artId int64) error {
+	return r.dao.AddArticle(ctx, colId, artId)
+}
+
+func (r *GORMColumnRepository) RemoveArticle(ctx context.Context, colId int64, artId int64) error {
+	return r.dao.RemoveArticle(ctx, colId, artId)
+}
+
+func (r *GORMColumnRepository) Reorder(ctx context.Context, colId int64, artIds []int64) error {
+	return r.dao.Reorder(ctx, colId, artIds)
+}
+
+func (r *GORMColumnRepository) ListPubArticles(ctx context.Context, colId int64,
+	offset int, limit int) ([]domain.Article, error) {
+	arts, err := r.dao.ListPubArticles(ctx, colId, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map[dao.PublishedArticle, domain.Article](arts,
+		func(idx int, src dao.PublishedArticle) domain.Article {
+			return r.articleToDomain(src)
+		}), nil
+}
+
+func (r *GORMColumnRepository) FindNav(ctx context.Context, artId int64) (domain.ColumnNav, error) {
+	ca, err := r.dao.FindByArticle(ctx, artId)
+	if err != nil {
+		return domain.ColumnNav{}, err
+	}
+	c, err := r.dao.FindById(ctx, ca.ColumnId)
+	if err != nil {
+		return domain.ColumnNav{}, err
+	}
+	prev, next, err := r.dao.FindPubNeighbors(ctx, ca)
+	if err != nil {
+		return domain.ColumnNav{}, err
+	}
+	res := domain.ColumnNav{
+		Column: r.toDomain(c),
+	}
+	if prev.Id > 0 {
+		res.Prev = r.articleToDomain(prev)
+	}
+	if next.Id > 0 {
+		res.Next = r.articleToDomain(next)
+	}
+	return res, nil
+}
+
+func (r *GORMColumnRepository) toEntity(c domain.Column) dao.Column {
+	return dao.Column{
+		Id:          c.Id,
+		AuthorId:    c.Author.Id,
+		Title:       c.Title,
+		Description: c.Description,
+	}
+}
+
+func (r *GORMColumnRepository) toDomain(c dao.Column) domain.Column {
+	return domain.Column{
+		Id: c.Id,
+		Author: domain.Author{
+			Id: c.AuthorId,
+		},
+		Title:       c.Title,
+		Description: c.Description,
+		Ctime:       time.UnixMilli(c.Ctime),
+		Utime:       time.UnixMilli(c.Utime),
+	}
+}
+
+// articleToDomain 列表和导航只用得上摘要，不需要渲染好的内容
+func (r *GORMColumnRepository) articleToDomain(art dao.PublishedArticle) domain.Article {
+	return domain.Article{
+		Id:      art.Id,
+		Title:   art.Title,
+		Content: art.Content,
+		Author: domain.Author{
+			Id: art.AuthorId,
+		},
+		Status: domain.ArticleStatus(art.Status),
+		Cover: domain.Media{
+			Id: art.CoverId,
+		},
+		Rendered: domain.ArticleRendered{
+			Abstract: art.Abstract,
+		},
+		Ctime: time.UnixMilli(art.Ctime),
+		Utime: time.UnixMilli(art.Utime),
+	}
+}
@@ -15,12 +15,67 @@ type ArticleDAO interface {
 	UpdateById(ctx context.Context, art Article) error
 	Sync(ctx context.Context, entity Article) (int64, error)
 	SyncStatus(ctx context.Context, uid int64, id int64, status uint8) error
-	GetByAuthor(ctx context.Context, uid int64, offset int, limit int) ([]Article, error)
+	// GetByAuthor 按照 (utime, id) 倒序，从 cursor 的下一条开始取，
+	// colId 不为 0 的时候只取这个专栏里面的文章
+	GetByAuthor(ctx context.Context, uid int64, colId int64, cursor Cursor, limit int) ([]Article, error)
 	GetById(ctx context.Context, id int64) (Article, error)
 	GetPubById(ctx context.Context, id int64) (PublishedArticle, error)
-	ListPub(ctx context.Context, start time.Time, offset int, limit int) ([]PublishedArticle, error)
+	// GetPubByIds 只返回已经发表的，不存在或者已经撤回的会被忽略。
+	// 只用来展示列表，所以只查 id 和 title，不查内容
+	GetPubByIds(ctx context.Context, ids []int64) ([]PublishedArticle, error)
+	ListPub(ctx context.Context, cursor Cursor, limit int) ([]PublishedArticle, error)
+	// ListPubByAuthor 某个作者已经发表的文章，顺序和 ListPub 一样
+	ListPubByAuthor(ctx context.Context, uid int64, cursor Cursor, limit int) ([]PublishedArticle, error)
+
+	// GetRevisions 按照 ID 倒序返回某篇文章的历史版本
+	GetRevisions(ctx context.Context, artId int64, authorId int64, offset int, limit int) ([]ArticleRevision, error)
+	GetRevisionById(ctx context.Context, id int64) (ArticleRevision, error)
+
+	// FindDueScheduled 找出发表时间已经到了的定时发表的文章
+	FindDueScheduled(ctx context.Context, now time.Time, limit int) ([]Article, error)
+	// PublishScheduled 发表定时发表的文章，如果文章已经不是定时发表状态，
+	// 或者还没到发表时间，返回 ErrScheduledArticleNotDue
+	PublishScheduled(ctx context.Context, id int64, now time.Time) error
+
+	// ApprovePending 审核通过。文章已经不是待审核状态，或者 version 不对，
+	// 都说明作者在审核期间改过了，返回 ErrArticleNotPending。
+	// 定时发表的时间还没到就变成定时发表，否则直接发表
+	ApprovePending(ctx context.Context, id int64, version int64, now time.Time) error
+	// RejectPending 审核不通过，文章回到未发表状态
+	RejectPending(ctx context.Context, id int64, version int64) error
+}
+
+var (
+	// ErrScheduledArticleNotDue 多个实例同时发表，或者作者中途改了文章，都会返回这个错误
+	ErrScheduledArticleNotDue = errors.New("文章不是待发表状态")
+	// ErrArticleVersionConflict 保存的时候带上的版本号不是最新的，说明别人先改了
+	ErrArticleVersionConflict = errors.New("文章已经被别人修改了")
+	// ErrArticleNotPending 审核的时候文章已经不是提交审核的那个版本了
+	ErrArticleNotPending = errors.New("文章不是待审核状态")
+)
+
+// Cursor 游标分页，零值代表第一页。
+// 用 offset 翻到后面越来越慢，翻页中间文章被修改了还会重复或者漏掉
+type Cursor struct {
+	Utime int64
+	Id    int64
 }
 
+// where 取排在游标后面的，也就是 (utime, id) 比游标小的
+func (c Cursor) where(db *gorm.DB) *gorm.DB {
+	if c.Utime == 0 && c.Id == 0 {
+		return db
+	}
+	return db.Where("utime < ? OR (utime = ? AND id < ?)", c.Utime, c.Utime, c.Id)
+}
+
+const (
+	articleStatusUnpublished   uint8 = 1
+	articleStatusPublished     uint8 = 2
+	articleStatusScheduled     uint8 = 4
+	articleStatusPendingReview uint8 = 5
+)
+
 type ArticleGORMDAO struct {
 	db *gorm.DB
 }
@@ -32,70 +87,230 @@ func NewArticleGORMDAO(db *gorm.DB) ArticleDAO {
 }
 
 func (ad *ArticleGORMDAO) Insert(ctx context.Context, art Article) (int64, error) {
+	var id int64
+	err := ad.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		id, err = ad.insert(tx, art)
+		if err != nil {
+			return err
+		}
+		art.Id = id
+		return ad.insertRevision(tx, art, RevisionKindSave)
+	})
+	return id, err
+}
+
+func (ad *ArticleGORMDAO) insert(tx *gorm.DB, art Article) (int64, error) {
 	now := time.Now().UnixMilli()
 	art.Ctime = now
 	art.Utime = now
-	err := ad.db.WithContext(ctx).Create(&art).Error
+	art.Version = 1
+	err := tx.Create(&art).Error
 	return art.Id, err
 }
 
 func (ad *ArticleGORMDAO) UpdateById(ctx context.Context, art Article) error {
+	return ad.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := ad.updateById(tx, art)
+		if err != nil {
+			return err
+		}
+		return ad.insertRevision(tx, art, RevisionKindSave)
+	})
+}
+
+// updateById 乐观锁，只有 art.Version 是最新的版本号才能更新成功
+func (ad *ArticleGORMDAO) updateById(tx *gorm.DB, art Article) error {
 	now := time.Now().UnixMilli()
-	res := ad.db.WithContext(ctx).Model(&Article{}).
-		Where("id = ? AND author_id = ?", art.Id, art.AuthorId).
+	res := tx.Model(&Article{}).
+		Where("id = ? AND author_id = ? AND version = ?", art.Id, art.AuthorId, art.Version).
 		Updates(map[string]any{
-			"title":   art.Title,
-			"content": art.Content,
-			"status":  art.Status,
-			"utime":   now,
+			"version":    gorm.Expr("version + 1"),
+			"title":      art.Title,
+			"content":    art.Content,
+			"status":     art.Status,
+			"publish_at": art.PublishAt,
+			"cover_id":   art.CoverId,
+			"html":       art.Html,
+			"toc":        art.Toc,
+			"abstract":   art.Abstract,
+			"utime":      now,
 		})
 	if res.Error != nil {
 		return res.Error
 	}
 	if res.RowsAffected == 0 {
+		var cnt int64
+		err := tx.Model(&Article{}).
+			Where("id = ? AND author_id = ?", art.Id, art.AuthorId).
+			Count(&cnt).Error
+		if err != nil {
+			return err
+		}
+		if cnt > 0 {
+			return ErrArticleVersionConflict
+		}
 		return errors.New("更新失败，ID不对或者作者不对")
 	}
 	return nil
 }
 
+// insertRevision 历史版本只会插入，不会修改
+func (ad *ArticleGORMDAO) insertRevision(tx *gorm.DB, art Article, kind uint8) error {
+	return tx.Create(&ArticleRevision{
+		ArticleId: art.Id,
+		AuthorId:  art.AuthorId,
+		Title:     art.Title,
+		Content:   art.Content,
+		Status:    art.Status,
+		Kind:      kind,
+		Ctime:     time.Now().UnixMilli(),
+	}).Error
+}
+
 func (ad *ArticleGORMDAO) Sync(ctx context.Context, art Article) (int64, error) {
 	id := art.Id
 	err := ad.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		var (
 			err error
 		)
-		dao := NewArticleGORMDAO(tx)
+		// 发表只记录一个发表的版本，所以这里不调用 UpdateById 和 Insert
 		if id > 0 {
-			err = dao.UpdateById(ctx, art)
+			err = ad.updateById(tx, art)
 		} else {
-			id, err = dao.Insert(ctx, art)
+			id, err = ad.insert(tx, art)
 		}
 		if err != nil {
 			return err
 		}
 		art.Id = id
-		now := time.Now().UnixMilli()
-		pubArt := PublishedArticle(art)
-		pubArt.Ctime = now
-		pubArt.Utime = now
-		err = tx.Clauses(clause.OnConflict{
-			// 对MySQL不起效，但是可以兼容别的方言
-			// INSERT xxx ON DUPLICATE KEY SET `title`=?
-			// 别的方言：
-			// sqlite INSERT XXX ON CONFLICT DO UPDATES WHERE
-			Columns: []clause.Column{{Name: "id"}},
-			DoUpdates: clause.Assignments(map[string]interface{}{
-				"title":   pubArt.Title,
-				"content": pubArt.Content,
-				"status":  pubArt.Status,
-				"utime":   now,
-			}),
-		}).Create(&pubArt).Error
-		return err
+		return ad.upsertLive(tx, art)
 	})
 	return id, err
 }
 
+// upsertLive 写入线上库并记录一个发表的版本
+func (ad *ArticleGORMDAO) upsertLive(tx *gorm.DB, art Article) error {
+	now := time.Now().UnixMilli()
+	pubArt := PublishedArticle(art)
+	pubArt.Ctime = now
+	pubArt.Utime = now
+	err := tx.Clauses(clause.OnConflict{
+		// 对MySQL不起效，但是可以兼容别的方言
+		// INSERT xxx ON DUPLICATE KEY SET `title`=?
+		// 别的方言：
+		// sqlite INSERT XXX ON CONFLICT DO UPDATES WHERE
+		Columns: []clause.Column{{Name: "id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"title":    pubArt.Title,
+			"content":  pubArt.Content,
+			"status":   pubArt.Status,
+			"cover_id": pubArt.CoverId,
+			"html":     pubArt.Html,
+			"toc":      pubArt.Toc,
+			"abstract": pubArt.Abstract,
+			"utime":    now,
+		}),
+	}).Create(&pubArt).Error
+	if err != nil {
+		return err
+	}
+	return ad.insertRevision(tx, art, RevisionKindPublish)
+}
+
+func (ad *ArticleGORMDAO) PublishScheduled(ctx context.Context, id int64, now time.Time) error {
+	return ad.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 利用状态做 CAS，保证只有一个实例能够发表成功
+		res := tx.Model(&Article{}).
+			Where("id = ? AND status = ? AND publish_at <= ?",
+				id, articleStatusScheduled, now.UnixMilli()).
+			Updates(map[string]any{
+				"status":     articleStatusPublished,
+				"publish_at": 0,
+				"utime":      now.UnixMilli(),
+			})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrScheduledArticleNotDue
+		}
+		var art Article
+		err := tx.Where("id = ?", id).First(&art).Error
+		if err != nil {
+			return err
+		}
+		return ad.upsertLive(tx, art)
+	})
+}
+
+func (ad *ArticleGORMDAO) ApprovePending(ctx context.Context, id int64, version int64, now time.Time) error {
+	return ad.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var art Article
+		err := tx.Where("id = ? AND status = ? AND version = ?",
+			id, articleStatusPendingReview, version).First(&art).Error
+		if err == gorm.ErrRecordNotFound {
+			return ErrArticleNotPending
+		}
+		if err != nil {
+			return err
+		}
+		updates := map[string]any{
+			"status": articleStatusScheduled,
+			"utime":  now.UnixMilli(),
+		}
+		if art.PublishAt <= now.UnixMilli() {
+			updates["status"] = articleStatusPublished
+			updates["publish_at"] = 0
+		}
+		// 和 PublishScheduled 一样用状态和版本号做 CAS，作者同时在改也不会发表错内容
+		res := tx.Model(&Article{}).
+			Where("id = ? AND status = ? AND version = ?",
+				id, articleStatusPendingReview, version).
+			Updates(updates)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrArticleNotPending
+		}
+		if updates["status"] == articleStatusScheduled {
+			return nil
+		}
+		art.Status = articleStatusPublished
+		art.PublishAt = 0
+		return ad.upsertLive(tx, art)
+	})
+}
+
+func (ad *ArticleGORMDAO) RejectPending(ctx context.Context, id int64, version int64) error {
+	res := ad.db.WithContext(ctx).Model(&Article{}).
+		Where("id = ? AND status = ? AND version = ?",
+			id, articleStatusPendingReview, version).
+		Updates(map[string]any{
+			"status":     articleStatusUnpublished,
+			"publish_at": 0,
+			"utime":      time.Now().UnixMilli(),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrArticleNotPending
+	}
+	return nil
+}
+
+func (ad *ArticleGORMDAO) FindDueScheduled(ctx context.Context, now time.Time, limit int) ([]Article, error) {
+	var res []Article
+	err := ad.db.WithContext(ctx).
+		Where("status = ? AND publish_at <= ?", articleStatusScheduled, now.UnixMilli()).
+		Order("publish_at ASC").
+		Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
 func (ad *ArticleGORMDAO) SyncStatus(ctx context.Context, uid int64, id int64, status uint8) error {
 	now := time.Now().UnixMilli()
 	return ad.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
@@ -120,12 +335,18 @@ func (ad *ArticleGORMDAO) SyncStatus(ctx context.Context, uid int64, id int64, s
 	})
 }
 
-func (ad *ArticleGORMDAO) GetByAuthor(ctx context.Context, uid int64, offset int, limit int) ([]Article, error) {
+func (ad *ArticleGORMDAO) GetByAuthor(ctx context.Context, uid int64, colId int64, cursor Cursor, limit int) ([]Article, error) {
 	var arts []Article
-	err := ad.db.WithContext(ctx).
-		Where("author_id = ?", uid).
-		Offset(offset).Limit(limit).
-		Order("utime DESC").
+	db := ad.db.WithContext(ctx).
+		Where("author_id = ?", uid)
+	if colId > 0 {
+		db = db.Where("id IN (?)", ad.db.Model(&ColumnArticle{}).
+			Select("article_id").
+			Where("column_id = ?", colId))
+	}
+	err := cursor.where(db).
+		Order("utime DESC, id DESC").
+		Limit(limit).
 		Find(&arts).Error
 	return arts, err
 }
@@ -145,27 +366,95 @@ func (ad *ArticleGORMDAO) GetPubById(ctx context.Context, id int64) (PublishedAr
 	return res, err
 }
 
-func (ad *ArticleGORMDAO) ListPub(ctx context.Context, start time.Time, offset int, limit int) ([]PublishedArticle, error) {
+func (ad *ArticleGORMDAO) GetPubByIds(ctx context.Context, ids []int64) ([]PublishedArticle, error) {
+	var res []PublishedArticle
+	err := ad.db.WithContext(ctx).
+		Select("id", "title").
+		Where("id IN ? AND status = ?", ids, articleStatusPublished).
+		Find(&res).Error
+	return res, err
+}
+
+func (ad *ArticleGORMDAO) ListPub(ctx context.Context, cursor Cursor, limit int) ([]PublishedArticle, error) {
+	var res []PublishedArticle
+	err := cursor.where(ad.db.WithContext(ctx).
+		Where("status = ?", articleStatusPublished)).
+		Order("utime DESC, id DESC").
+		Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (ad *ArticleGORMDAO) ListPubByAuthor(ctx context.Context, uid int64, cursor Cursor, limit int) ([]PublishedArticle, error) {
 	var res []PublishedArticle
-	const ArticleStatusPublished = 2
+	err := cursor.where(ad.db.WithContext(ctx).
+		Where("author_id = ? AND status = ?", uid, articleStatusPublished)).
+		Order("utime DESC, id DESC").
+		Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (ad *ArticleGORMDAO) GetRevisions(ctx context.Context, artId int64, authorId int64,
+	offset int, limit int) ([]ArticleRevision, error) {
+	var res []ArticleRevision
 	err := ad.db.WithContext(ctx).
-		Where("utime < ? AND status = ?",
-			start.UnixMilli(), ArticleStatusPublished).
+		Where("article_id = ? AND author_id = ?", artId, authorId).
+		Order("id DESC").
 		Offset(offset).Limit(limit).
 		Find(&res).Error
 	return res, err
 }
 
+func (ad *ArticleGORMDAO) GetRevisionById(ctx context.Context, id int64) (ArticleRevision, error) {
+	var res ArticleRevision
+	err := ad.db.WithContext(ctx).
+		Where("id = ?", id).First(&res).Error
+	return res, err
+}
+
 type Article struct {
 	Id      int64  `gorm:"primaryKey,autoIncrement" bson:"id,omitempty"`
 	Title   string `gorm:"type=varchar(4096)" bson:"title,omitempty"`
 	Content string `gorm:"type=BLOB" bson:"content,omitempty"`
-	// 我要根据创作者ID来查询
-	AuthorId int64 `gorm:"index" bson:"author_id,omitempty"`
+	// 我要根据创作者ID来查询，按照更新时间倒序翻页
+	AuthorId int64 `gorm:"index:author_utime" bson:"author_id,omitempty"`
 	Status   uint8 `bson:"status,omitempty"`
-	Ctime    int64 `bson:"ctime,omitempty"`
+	// Version 乐观锁，每次更新草稿加一
+	Version int64 `bson:"version,omitempty"`
+	// PublishAt 定时发表的时间，只有定时发表的文章才有
+	PublishAt int64 `gorm:"index" bson:"publish_at,omitempty"`
+	// CoverId 封面，引用的是 Media
+	CoverId int64 `bson:"cover_id,omitempty"`
+	// Html 发表的时候按照 Markdown 渲染好的内容，和线上库一起存
+	Html string `gorm:"type=BLOB" bson:"html,omitempty"`
+	// Toc 目录，JSON 格式
+	Toc      string `gorm:"type=BLOB" bson:"toc,omitempty"`
+	Abstract string `gorm:"type=varchar(1024)" bson:"abstract,omitempty"`
+	Ctime    int64  `bson:"ctime,omitempty"`
 	// 更新时间
-	Utime int64 `bson:"utime,omitempty"`
+	Utime int64 `gorm:"index:author_utime;index" bson:"utime,omitempty"`
 }
 
 type PublishedArticle Article
+
+const (
+	// RevisionKindSave 保存草稿产生的版本，恢复历史版本也算保存
+	RevisionKindSave uint8 = iota + 1
+	// RevisionKindPublish 发表产生的版本
+	RevisionKindPublish
+)
+
+// ArticleRevision 文章的历史版本，每次保存和发表都会插入一条，不会修改
+type ArticleRevision struct {
+	Id int64 `gorm:"primaryKey,autoIncrement" bson:"id,omitempty"`
+	// 查询的时候总是带上作者，防止看到别人的历史版本
+	ArticleId int64  `gorm:"index:article_author" bson:"article_id,omitempty"`
+	AuthorId  int64  `gorm:"index:article_author" bson:"author_id,omitempty"`
+	Title     string `gorm:"type=varchar(4096)" bson:"title,omitempty"`
+	Content   string `gorm:"type=BLOB" bson:"content,omitempty"`
+	// Status 产生这个版本的时候文章的状态
+	Status uint8 `bson:"status,omitempty"`
+	Kind   uint8 `bson:"kind,omitempty"`
+	Ctime  int64 `bson:"ctime,omitempty"`
+}
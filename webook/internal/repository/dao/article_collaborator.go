@@ -0,0 +1,114 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+//go:generate mockgen -source=./article_collaborator.go -package=daomocks -destination=./mocks/article_collaborator.mock.go ArticleCollaboratorDAO
+type ArticleCollaboratorDAO interface {
+	// Upsert 邀请协作者，已经是协作者了就修改权限
+	Upsert(ctx context.Context, c ArticleCollaborator) error
+	Delete(ctx context.Context, artId int64, uid int64) error
+	Find(ctx context.Context, artId int64, uid int64) (ArticleCollaborator, error)
+	FindByArticle(ctx context.Context, artId int64) ([]ArticleCollaborator, error)
+
+	InsertAuditLog(ctx context.Context, l ArticleAuditLog) error
+	// FindAuditLogs 按照 ID 倒序
+	FindAuditLogs(ctx context.Context, artId int64, offset int, limit int) ([]ArticleAuditLog, error)
+}
+
+type GORMArticleCollaboratorDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMArticleCollaboratorDAO(db *gorm.DB) ArticleCollaboratorDAO {
+	return &GORMArticleCollaboratorDAO{
+		db: db,
+	}
+}
+
+func (d *GORMArticleCollaboratorDAO) Upsert(ctx context.Context, c ArticleCollaborator) error {
+	now := time.Now().UnixMilli()
+	c.Ctime = now
+	c.Utime = now
+	return d.db.WithContext(ctx).Clauses(clause.OnConflict{
+		DoUpdates: clause.Assignments(map[string]any{
+			"role":    c.Role,
+			"inviter": c.Inviter,
+			"utime":   now,
+		}),
+	}).Create(&c).Error
+}
+
+func (d *GORMArticleCollaboratorDAO) Delete(ctx context.Context, artId int64, uid int64) error {
+	res := d.db.WithContext(ctx).
+		Where("article_id = ? AND uid = ?", artId, uid).
+		Delete(&ArticleCollaborator{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrDataNotFound
+	}
+	return nil
+}
+
+func (d *GORMArticleCollaboratorDAO) Find(ctx context.Context, artId int64, uid int64) (ArticleCollaborator, error) {
+	var res ArticleCollaborator
+	err := d.db.WithContext(ctx).
+		Where("article_id = ? AND uid = ?", artId, uid).
+		First(&res).Error
+	return res, err
+}
+
+func (d *GORMArticleCollaboratorDAO) FindByArticle(ctx context.Context, artId int64) ([]ArticleCollaborator, error) {
+	var res []ArticleCollaborator
+	err := d.db.WithContext(ctx).
+		Where("article_id = ?", artId).
+		Order("id ASC").
+		Find(&res).Error
+	return res, err
+}
+
+func (d *GORMArticleCollaboratorDAO) InsertAuditLog(ctx context.Context, l ArticleAuditLog) error {
+	l.Ctime = time.Now().UnixMilli()
+	return d.db.WithContext(ctx).Create(&l).Error
+}
+
+func (d *GORMArticleCollaboratorDAO) FindAuditLogs(ctx context.Context, artId int64,
+	offset int, limit int) ([]ArticleAuditLog, error) {
+	var res []ArticleAuditLog
+	err := d.db.WithContext(ctx).
+		Where("article_id = ?", artId).
+		Order("id DESC").
+		Offset(offset).Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+// ArticleCollaborator 作者邀请的协作者，作者本人不在这里面
+type ArticleCollaborator struct {
+	Id        int64 `gorm:"primaryKey,autoIncrement"`
+	ArticleId int64 `gorm:"uniqueIndex:article_uid"`
+	// 查询某个人参与协作的文章
+	Uid     int64 `gorm:"uniqueIndex:article_uid;index"`
+	Role    uint8
+	Inviter int64
+	Ctime   int64
+	Utime   int64
+}
+
+// ArticleAuditLog 草稿的操作记录，只会插入
+type ArticleAuditLog struct {
+	Id        int64 `gorm:"primaryKey,autoIncrement"`
+	ArticleId int64 `gorm:"index"`
+	Uid       int64
+	Action    uint8
+	Version   int64
+	Detail    string `gorm:"type:varchar(1024)"`
+	Ctime     int64
+}
@@ -0,0 +1,120 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	articleReviewStatusPending  uint8 = 1
+	articleReviewStatusCanceled uint8 = 4
+)
+
+// ErrArticleReviewResolved 别的审核员已经处理过了
+var ErrArticleReviewResolved = errors.New("审核记录已经处理过了")
+
+//go:generate mockgen -source=./article_review.go -package=daomocks -destination=./mocks/article_review.mock.go ArticleReviewDAO
+type ArticleReviewDAO interface {
+	// Insert 同一篇文章之前还没处理的审核记录会被取消，只审核最新提交的
+	Insert(ctx context.Context, r ArticleReview) (int64, error)
+	FindById(ctx context.Context, id int64) (ArticleReview, error)
+	// FindPending 按照 ID 正序，先提交的先审核
+	FindPending(ctx context.Context, offset int, limit int) ([]ArticleReview, error)
+	// Resolve 只能处理待审核的记录，已经处理过了返回 ErrArticleReviewResolved
+	Resolve(ctx context.Context, id int64, status uint8, reviewer int64, comment string) error
+	// SetStatus 不管原来是什么状态，处理失败的时候用来回滚或者取消
+	SetStatus(ctx context.Context, id int64, status uint8) error
+}
+
+type GORMArticleReviewDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMArticleReviewDAO(db *gorm.DB) ArticleReviewDAO {
+	return &GORMArticleReviewDAO{
+		db: db,
+	}
+}
+
+func (d *GORMArticleReviewDAO) Insert(ctx context.Context, r ArticleReview) (int64, error) {
+	now := time.Now().UnixMilli()
+	r.Status = articleReviewStatusPending
+	r.Ctime = now
+	r.Utime = now
+	err := d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&ArticleReview{}).
+			Where("article_id = ? AND status = ?", r.ArticleId, articleReviewStatusPending).
+			Updates(map[string]any{
+				"status": articleReviewStatusCanceled,
+				"utime":  now,
+			}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Create(&r).Error
+	})
+	return r.Id, err
+}
+
+func (d *GORMArticleReviewDAO) FindById(ctx context.Context, id int64) (ArticleReview, error) {
+	var res ArticleReview
+	err := d.db.WithContext(ctx).Where("id = ?", id).First(&res).Error
+	return res, err
+}
+
+func (d *GORMArticleReviewDAO) FindPending(ctx context.Context, offset int, limit int) ([]ArticleReview, error) {
+	var res []ArticleReview
+	err := d.db.WithContext(ctx).
+		Where("status = ?", articleReviewStatusPending).
+		Order("id ASC").
+		Offset(offset).Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (d *GORMArticleReviewDAO) Resolve(ctx context.Context, id int64, status uint8,
+	reviewer int64, comment string) error {
+	res := d.db.WithContext(ctx).Model(&ArticleReview{}).
+		Where("id = ? AND status = ?", id, articleReviewStatusPending).
+		Updates(map[string]any{
+			"status":   status,
+			"reviewer": reviewer,
+			"comment":  comment,
+			"utime":    time.Now().UnixMilli(),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrArticleReviewResolved
+	}
+	return nil
+}
+
+func (d *GORMArticleReviewDAO) SetStatus(ctx context.Context, id int64, status uint8) error {
+	return d.db.WithContext(ctx).Model(&ArticleReview{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"status": status,
+			"utime":  time.Now().UnixMilli(),
+		}).Error
+}
+
+// ArticleReview 人工审核的队列，处理完了也不删，留着当记录
+type ArticleReview struct {
+	Id        int64 `gorm:"primaryKey,autoIncrement"`
+	ArticleId int64 `gorm:"index"`
+	AuthorId  int64
+	Version   int64
+	Title     string `gorm:"type:varchar(4096)"`
+	// Reasons JSON 数组
+	Reasons  string `gorm:"type:varchar(1024)"`
+	Status   uint8  `gorm:"index"`
+	Reviewer int64
+	Comment  string `gorm:"type:varchar(1024)"`
+	Ctime    int64
+	Utime    int64
+}
@@ -0,0 +1,306 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+func TestArticleGORMDAO_GetByAuthor(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(t *testing.T) *sql.DB
+
+		uid    int64
+		colId  int64
+		cursor Cursor
+		limit  int
+
+		wantIds []int64
+	}{
+		{
+			name: "第一页",
+			mock: func(t *testing.T) *sql.DB {
+				db, mock, err := sqlmock.New()
+				require.NoError(t, err)
+				mock.ExpectQuery(regexp.QuoteMeta(
+					"SELECT * FROM `articles` WHERE author_id = ? ORDER BY utime DESC, id DESC LIMIT 2")).
+					WithArgs(123).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "utime"}).
+						AddRow(3, 300).AddRow(2, 200))
+				return db
+			},
+			uid:     123,
+			limit:   2,
+			wantIds: []int64{3, 2},
+		},
+		{
+			name: "从游标的下一条开始",
+			mock: func(t *testing.T) *sql.DB {
+				db, mock, err := sqlmock.New()
+				require.NoError(t, err)
+				mock.ExpectQuery(regexp.QuoteMeta(
+					"SELECT * FROM `articles` WHERE author_id = ? AND (utime < ? OR (utime = ? AND id < ?)) "+
+						"ORDER BY utime DESC, id DESC LIMIT 2")).
+					WithArgs(123, 200, 200, 2).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "utime"}).
+						AddRow(1, 200))
+				return db
+			},
+			uid:     123,
+			cursor:  Cursor{Utime: 200, Id: 2},
+			limit:   2,
+			wantIds: []int64{1},
+		},
+		{
+			name: "只取专栏里面的",
+			mock: func(t *testing.T) *sql.DB {
+				db, mock, err := sqlmock.New()
+				require.NoError(t, err)
+				mock.ExpectQuery(regexp.QuoteMeta(
+					"SELECT * FROM `articles` WHERE author_id = ? AND "+
+						"id IN (SELECT `article_id` FROM `column_articles` WHERE column_id = ?) "+
+						"ORDER BY utime DESC, id DESC LIMIT 2")).
+					WithArgs(123, 7).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "utime"}).
+						AddRow(5, 500))
+				return db
+			},
+			uid:     123,
+			colId:   7,
+			limit:   2,
+			wantIds: []int64{5},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sqlDB := tc.mock(t)
+			db, err := gorm.Open(mysql.New(mysql.Config{
+				Conn:                      sqlDB,
+				SkipInitializeWithVersion: true,
+			}), &gorm.Config{
+				DisableAutomaticPing:   true,
+				SkipDefaultTransaction: true,
+			})
+			require.NoError(t, err)
+			dao := NewArticleGORMDAO(db)
+			arts, err := dao.GetByAuthor(context.Background(), tc.uid, tc.colId, tc.cursor, tc.limit)
+			require.NoError(t, err)
+			ids := make([]int64, 0, len(arts))
+			for _, art := range arts {
+				ids = append(ids, art.Id)
+			}
+			assert.Equal(t, tc.wantIds, ids)
+		})
+	}
+}
+
+func TestArticleGORMDAO_ListPubByAuthor(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `published_articles` WHERE (author_id = ? AND status = ?) "+
+			"AND (utime < ? OR (utime = ? AND id < ?)) ORDER BY utime DESC, id DESC LIMIT 2")).
+		WithArgs(123, 2, 200, 200, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "utime"}).
+			AddRow(1, 200))
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      db,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+	})
+	require.NoError(t, err)
+	arts, err := NewArticleGORMDAO(gormDB).ListPubByAuthor(context.Background(),
+		123, Cursor{Utime: 200, Id: 2}, 2)
+	require.NoError(t, err)
+	require.Len(t, arts, 1)
+	assert.Equal(t, int64(1), arts[0].Id)
+}
+
+func TestCursor_bsonFilter(t *testing.T) {
+	filter := bson.D{bson.E{Key: "author_id", Value: int64(123)}}
+	// 第一页不加条件
+	assert.Equal(t, filter, Cursor{}.bsonFilter(filter))
+	assert.Equal(t, bson.D{
+		bson.E{Key: "author_id", Value: int64(123)},
+		bson.E{Key: "$or", Value: bson.A{
+			bson.D{bson.E{Key: "utime", Value: bson.M{"$lt": int64(200)}}},
+			bson.D{bson.E{Key: "utime", Value: int64(200)}, bson.E{Key: "id", Value: bson.M{"$lt": int64(2)}}},
+		}},
+	}, Cursor{Utime: 200, Id: 2}.bsonFilter(filter))
+}
+
+func TestArticleGORMDAO_GetPubByIds(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT `id`,`title` FROM `published_articles` WHERE id IN (?,?) AND status = ?")).
+		WithArgs(1, 2, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title"}).
+			AddRow(1, "标题"))
+	gormDB, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      db,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+	})
+	require.NoError(t, err)
+	arts, err := NewArticleGORMDAO(gormDB).GetPubByIds(context.Background(), []int64{1, 2})
+	require.NoError(t, err)
+	require.Len(t, arts, 1)
+	assert.Equal(t, "标题", arts[0].Title)
+}
+
+func TestArticleGORMDAO_UpdateById(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(t *testing.T) *sql.DB
+
+		art Article
+
+		wantErr error
+	}{
+		{
+			name: "更新成功",
+			mock: func(t *testing.T) *sql.DB {
+				db, mock, err := sqlmock.New()
+				require.NoError(t, err)
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE `articles` SET .*`version`=version \\+ 1.* WHERE id = \\? AND author_id = \\? AND version = \\?").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("INSERT INTO `article_revisions` .*").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+				return db
+			},
+			art: Article{Id: 1, AuthorId: 123, Version: 3},
+		},
+		{
+			name: "版本号不是最新的",
+			mock: func(t *testing.T) *sql.DB {
+				db, mock, err := sqlmock.New()
+				require.NoError(t, err)
+				mock.ExpectBegin()
+				mock.ExpectExec("UPDATE `articles` SET .*").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				// 文章是存在的
+				mock.ExpectQuery("SELECT count\\(\\*\\) FROM `articles` WHERE id = \\? AND author_id = \\?").
+					WithArgs(1, 123).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+				mock.ExpectRollback()
+				return db
+			},
+			art:     Article{Id: 1, AuthorId: 123, Version: 2},
+			wantErr: ErrArticleVersionConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sqlDB := tc.mock(t)
+			db, err := gorm.Open(mysql.New(mysql.Config{
+				Conn:                      sqlDB,
+				SkipInitializeWithVersion: true,
+			}), &gorm.Config{
+				DisableAutomaticPing:   true,
+				SkipDefaultTransaction: true,
+			})
+			require.NoError(t, err)
+			dao := NewArticleGORMDAO(db)
+			err = dao.UpdateById(context.Background(), tc.art)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+func TestArticleGORMDAO_ApprovePending(t *testing.T) {
+	now := time.UnixMilli(1000)
+	testCases := []struct {
+		name string
+		mock func(t *testing.T) *sql.DB
+
+		wantErr error
+	}{
+		{
+			name: "直接发表",
+			mock: func(t *testing.T) *sql.DB {
+				db, mock, err := sqlmock.New()
+				require.NoError(t, err)
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT \\* FROM `articles` WHERE id = \\? AND status = \\? AND version = \\?").
+					WithArgs(1, articleStatusPendingReview, 3).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "author_id", "status", "version", "publish_at"}).
+						AddRow(1, 123, articleStatusPendingReview, 3, 0))
+				mock.ExpectExec("UPDATE `articles` SET `publish_at`=\\?,`status`=\\?,`utime`=\\? WHERE id = \\? AND status = \\? AND version = \\?").
+					WithArgs(0, articleStatusPublished, now.UnixMilli(), 1, articleStatusPendingReview, 3).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec("INSERT INTO `published_articles` .*").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec("INSERT INTO `article_revisions` .*").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+				return db
+			},
+		},
+		{
+			name: "还没到定时发表的时间",
+			mock: func(t *testing.T) *sql.DB {
+				db, mock, err := sqlmock.New()
+				require.NoError(t, err)
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT \\* FROM `articles` .*").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "author_id", "status", "version", "publish_at"}).
+						AddRow(1, 123, articleStatusPendingReview, 3, 2000))
+				mock.ExpectExec("UPDATE `articles` SET `status`=\\?,`utime`=\\? WHERE .*").
+					WithArgs(articleStatusScheduled, now.UnixMilli(), 1, articleStatusPendingReview, 3).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+				return db
+			},
+		},
+		{
+			name: "作者已经改过了",
+			mock: func(t *testing.T) *sql.DB {
+				db, mock, err := sqlmock.New()
+				require.NoError(t, err)
+				mock.ExpectBegin()
+				mock.ExpectQuery("SELECT \\* FROM `articles` .*").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectRollback()
+				return db
+			},
+			wantErr: ErrArticleNotPending,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sqlDB := tc.mock(t)
+			db, err := gorm.Open(mysql.New(mysql.Config{
+				Conn:                      sqlDB,
+				SkipInitializeWithVersion: true,
+			}), &gorm.Config{
+				DisableAutomaticPing:   true,
+				SkipDefaultTransaction: true,
+			})
+			require.NoError(t, err)
+			dao := NewArticleGORMDAO(db)
+			err = dao.ApprovePending(context.Background(), 1, 3, now)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
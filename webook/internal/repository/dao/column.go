@@ -0,0 +1,258 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrArticleAlreadyInColumn 一篇文章只能在一个专栏里面
+	ErrArticleAlreadyInColumn = errors.New("文章已经在专栏里面了")
+	// ErrColumnArticlesMismatch 调整顺序的时候必须给出专栏里面全部的文章
+	ErrColumnArticlesMismatch = errors.New("文章和专栏里面的不一致")
+)
+
+//go:generate mockgen -source=./column.go -package=daomocks -destination=./mocks/column.mock.go ColumnDAO
+type ColumnDAO interface {
+	Insert(ctx context.Context, c Column) (int64, error)
+	// UpdateById 只能修改自己的专栏，否则返回 ErrDataNotFound
+	UpdateById(ctx context.Context, c Column) error
+	// Delete 删除专栏，文章本身不会被删除
+	Delete(ctx context.Context, uid int64, id int64) error
+	FindById(ctx context.Context, id int64) (Column, error)
+	FindByAuthor(ctx context.Context, uid int64) ([]Column, error)
+
+	// AddArticle 加到专栏的最后面
+	AddArticle(ctx context.Context, colId int64, artId int64) error
+	RemoveArticle(ctx context.Context, colId int64, artId int64) error
+	// Reorder artIds 是专栏里面全部文章的新顺序
+	Reorder(ctx context.Context, colId int64, artIds []int64) error
+	// FindByArticle 文章所在的专栏
+	FindByArticle(ctx context.Context, artId int64) (ColumnArticle, error)
+	// ListPubArticles 按照专栏里面的顺序返回已经发表的文章
+	ListPubArticles(ctx context.Context, colId int64, offset int, limit int) ([]PublishedArticle, error)
+	// FindPubNeighbors 在 ca 前后的已经发表的文章，没有就是零值
+	FindPubNeighbors(ctx context.Context, ca ColumnArticle) (PublishedArticle, PublishedArticle, error)
+}
+
+type GORMColumnDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMColumnDAO(db *gorm.DB) ColumnDAO {
+	return &GORMColumnDAO{
+		db: db,
+	}
+}
+
+func (d *GORMColumnDAO) Insert(ctx context.Context, c Column) (int64, error) {
+	now := time.Now().UnixMilli()
+	c.Ctime = now
+	c.Utime = now
+	err := d.db.WithContext(ctx).Create(&c).Error
+	return c.Id, err
+}
+
+func (d *GORMColumnDAO) UpdateById(ctx context.Context, c Column) error {
+	res := d.db.WithContext(ctx).Model(&Column{}).
+		Where("id = ? AND author_id = ?", c.Id, c.AuthorId).
+		Updates(map[string]any{
+			"title":       c.Title,
+			"description": c.Description,
+			"utime":       time.Now().UnixMilli(),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrDataNotFound
+	}
+	return nil
+}
+
+func (d *GORMColumnDAO) Delete(ctx context.Context, uid int64, id int64) error {
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Where("id = ? AND author_id = ?", id, uid).Delete(&Column{})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return ErrDataNotFound
+		}
+		return tx.Where("column_id = ?", id).Delete(&ColumnArticle{}).Error
+	})
+}
+
+func (d *GORMColumnDAO) FindById(ctx context.Context, id int64) (Column, error) {
+	var res Column
+	err := d.db.WithContext(ctx).Where("id = ?", id).First(&res).Error
+	return res, err
+}
+
+func (d *GORMColumnDAO) FindByAuthor(ctx context.Context, uid int64) ([]Column, error) {
+	var res []Column
+	err := d.db.WithContext(ctx).
+		Where("author_id = ?", uid).
+		Order("id DESC").
+		Find(&res).Error
+	return res, err
+}
+
+func (d *GORMColumnDAO) AddArticle(ctx context.Context, colId int64, artId int64) error {
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var pos int64
+		err := tx.Model(&ColumnArticle{}).
+			Select("COALESCE(MAX(position), 0)").
+			Where("column_id = ?", colId).
+			Scan(&pos).Error
+		if err != nil {
+			return err
+		}
+		now := time.Now().UnixMilli()
+		err = tx.Create(&ColumnArticle{
+			ColumnId:  colId,
+			ArticleId: artId,
+			Position:  pos + 1,
+			Ctime:     now,
+			Utime:     now,
+		}).Error
+		var me *mysql.MySQLError
+		if errors.As(err, &me) {
+			const uniqueIndexErrNo uint16 = 1062
+			if me.Number == uniqueIndexErrNo {
+				return ErrArticleAlreadyInColumn
+			}
+		}
+		return err
+	})
+}
+
+func (d *GORMColumnDAO) RemoveArticle(ctx context.Context, colId int64, artId int64) error {
+	res := d.db.WithContext(ctx).
+		Where("column_id = ? AND article_id = ?", colId, artId).
+		Delete(&ColumnArticle{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrDataNotFound
+	}
+	return nil
+}
+
+func (d *GORMColumnDAO) Reorder(ctx context.Context, colId int64, artIds []int64) error {
+	return d.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var cnt int64
+		err := tx.Model(&ColumnArticle{}).
+			Where("column_id = ? AND article_id IN ?", colId, artIds).
+			Count(&cnt).Error
+		if err != nil {
+			return err
+		}
+		var total int64
+		err = tx.Model(&ColumnArticle{}).
+			Where("column_id = ?", colId).
+			Count(&total).Error
+		if err != nil {
+			return err
+		}
+		// 有重复的，或者有不在专栏里面的，或者少了
+		if cnt != int64(len(artIds)) || total != cnt {
+			return ErrColumnArticlesMismatch
+		}
+		now := time.Now().UnixMilli()
+		for i, artId := range artIds {
+			err = tx.Model(&ColumnArticle{}).
+				Where("column_id = ? AND article_id = ?", colId, artId).
+				Updates(map[string]any{
+					"position": i + 1,
+					"utime":    now,
+				}).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (d *GORMColumnDAO) FindByArticle(ctx context.Context, artId int64) (ColumnArticle, error) {
+	var res ColumnArticle
+	err := d.db.WithContext(ctx).Where("article_id = ?", artId).First(&res).Error
+	return res, err
+}
+
+func (d *GORMColumnDAO) ListPubArticles(ctx context.Context, colId int64, offset int, limit int) ([]PublishedArticle, error) {
+	var res []PublishedArticle
+	err := d.pubArticles(ctx, colId).
+		Order("column_articles.position ASC, column_articles.id ASC").
+		Offset(offset).Limit(limit).
+		Find(&res).Error
+	return res, err
+}
+
+func (d *GORMColumnDAO) FindPubNeighbors(ctx context.Context, ca ColumnArticle) (PublishedArticle, PublishedArticle, error) {
+	// 位置可能重复，用 ID 兜底保证顺序是确定的
+	var prev []PublishedArticle
+	err := d.pubArticles(ctx, ca.ColumnId).
+		Where("column_articles.position < ? OR (column_articles.position = ? AND column_articles.id < ?)",
+			ca.Position, ca.Position, ca.Id).
+		Order("column_articles.position DESC, column_articles.id DESC").
+		Limit(1).
+		Find(&prev).Error
+	if err != nil {
+		return PublishedArticle{}, PublishedArticle{}, err
+	}
+	var next []PublishedArticle
+	err = d.pubArticles(ctx, ca.ColumnId).
+		Where("column_articles.position > ? OR (column_articles.position = ? AND column_articles.id > ?)",
+			ca.Position, ca.Position, ca.Id).
+		Order("column_articles.position ASC, column_articles.id ASC").
+		Limit(1).
+		Find(&next).Error
+	if err != nil {
+		return PublishedArticle{}, PublishedArticle{}, err
+	}
+	var p, n PublishedArticle
+	if len(prev) > 0 {
+		p = prev[0]
+	}
+	if len(next) > 0 {
+		n = next[0]
+	}
+	return p, n, nil
+}
+
+// pubArticles 专栏里面已经发表的文章
+func (d *GORMColumnDAO) pubArticles(ctx context.Context, colId int64) *gorm.DB {
+	return d.db.WithContext(ctx).Model(&PublishedArticle{}).
+		Select("published_articles.*").
+		Joins("JOIN column_articles ON column_articles.article_id = published_articles.id").
+		Where("column_articles.column_id = ? AND published_articles.status = ?",
+			colId, articleStatusPublished)
+}
+
+// Column 专栏
+type Column struct {
+	Id          int64  `gorm:"primaryKey,autoIncrement"`
+	AuthorId    int64  `gorm:"index"`
+	Title       string `gorm:"type:varchar(256)"`
+	Description string `gorm:"type:varchar(1024)"`
+	Ctime       int64
+	Utime       int64
+}
+
+// ColumnArticle 专栏和文章的关系，一篇文章只能在一个专栏里面
+type ColumnArticle struct {
+	Id        int64 `gorm:"primaryKey,autoIncrement"`
+	ColumnId  int64 `gorm:"index:column_position"`
+	ArticleId int64 `gorm:"unique"`
+	// Position 从 1 开始，越小越靠前
+	Position int64 `gorm:"index:column_position"`
+	Ctime    int64
+	Utime    int64
+}
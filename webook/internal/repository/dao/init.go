@@ -6,8 +6,16 @@ import "gorm.io/gorm"
 func InitTables(db *gorm.DB) error {
 	return db.AutoMigrate(
 		&User{},
+		&UserIdentity{},
 		&Article{},
 		&PublishedArticle{},
+		&ArticleRevision{},
 		&Job{},
+		&Media{},
+		&Column{},
+		&ColumnArticle{},
+		&ArticleCollaborator{},
+		&ArticleAuditLog{},
+		&ArticleReview{},
 	)
 }
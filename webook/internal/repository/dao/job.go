@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type JobDAO interface {
@@ -12,8 +13,13 @@ type JobDAO interface {
 	Release(ctx context.Context, jid int64) error
 	UpdateUtime(ctx context.Context, id int64) error
 	UpdateNextTime(ctx context.Context, id int64, t time.Time) error
+	// Insert 按照 Name 去重，已经存在就什么也不做
+	Insert(ctx context.Context, j Job) error
 }
 
+// jobLeaseTimeout 超过这个时间没有续约，就认为抢占的实例已经崩溃了
+const jobLeaseTimeout = time.Minute * 3
+
 type GORMJobDAO struct {
 	db *gorm.DB
 }
@@ -27,9 +33,10 @@ func (jd *GORMJobDAO) Preempt(ctx context.Context) (Job, error) {
 	for {
 		var j Job
 		now := time.Now().UnixMilli()
-		// 作业：这里是缺少找到续约失败的 JOB 出来执行
-		err := db.Where("status = ? AND next_time < ?",
-			jobStatusWaiting, now).
+		// 续约失败的 JOB 也可以抢过来执行
+		err := db.Where("(status = ? AND next_time < ?) OR (status = ? AND utime < ?)",
+			jobStatusWaiting, now,
+			jobStatusRunning, now-jobLeaseTimeout.Milliseconds()).
 			First(&j).Error
 		if err != nil {
 			return j, err
@@ -78,6 +85,16 @@ func (jd *GORMJobDAO) UpdateNextTime(ctx context.Context, jid int64, t time.Time
 	}).Error
 }
 
+func (jd *GORMJobDAO) Insert(ctx context.Context, j Job) error {
+	now := time.Now().UnixMilli()
+	j.Ctime = now
+	j.Utime = now
+	return jd.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "name"}},
+		DoNothing: true,
+	}).Create(&j).Error
+}
+
 type Job struct {
 	Id         int64  `gorm:"primaryKey,autoIncrement"`
 	Name       string `gorm:"type:varchar(128);unique"`
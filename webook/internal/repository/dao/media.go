@@ -0,0 +1,59 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -source=./media.go -package=daomocks -destination=./mocks/media.mock.go MediaDAO
+type MediaDAO interface {
+	Insert(ctx context.Context, m Media) (int64, error)
+	FindById(ctx context.Context, id int64) (Media, error)
+	FindByIds(ctx context.Context, ids []int64) ([]Media, error)
+}
+
+type GORMMediaDAO struct {
+	db *gorm.DB
+}
+
+func NewGORMMediaDAO(db *gorm.DB) MediaDAO {
+	return &GORMMediaDAO{
+		db: db,
+	}
+}
+
+func (d *GORMMediaDAO) Insert(ctx context.Context, m Media) (int64, error) {
+	m.Ctime = time.Now().UnixMilli()
+	err := d.db.WithContext(ctx).Create(&m).Error
+	return m.Id, err
+}
+
+func (d *GORMMediaDAO) FindById(ctx context.Context, id int64) (Media, error) {
+	var res Media
+	err := d.db.WithContext(ctx).Where("id = ?", id).First(&res).Error
+	return res, err
+}
+
+func (d *GORMMediaDAO) FindByIds(ctx context.Context, ids []int64) ([]Media, error) {
+	var res []Media
+	if len(ids) == 0 {
+		return res, nil
+	}
+	err := d.db.WithContext(ctx).Where("id IN ?", ids).Find(&res).Error
+	return res, err
+}
+
+// Media 上传的文件，文件本身在对象存储里面，这里只存元数据
+type Media struct {
+	Id          int64  `gorm:"primaryKey,autoIncrement"`
+	Uid         int64  `gorm:"index"`
+	Key         string `gorm:"type:varchar(256);unique"`
+	ThumbKey    string `gorm:"type:varchar(256)"`
+	ContentType string `gorm:"type:varchar(64)"`
+	Size        int64
+	Width       int
+	Height      int
+	Ctime       int64
+}
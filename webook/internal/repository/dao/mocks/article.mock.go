@@ -41,19 +41,48 @@ func (m *MockArticleDAO) EXPECT() *MockArticleDAOMockRecorder {
 	return m.recorder
 }
 
+// ApprovePending mocks base method.
+func (m *MockArticleDAO) ApprovePending(ctx context.Context, id, version int64, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApprovePending", ctx, id, version, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApprovePending indicates an expected call of ApprovePending.
+func (mr *MockArticleDAOMockRecorder) ApprovePending(ctx, id, version, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApprovePending", reflect.TypeOf((*MockArticleDAO)(nil).ApprovePending), ctx, id, version, now)
+}
+
+// FindDueScheduled mocks base method.
+func (m *MockArticleDAO) FindDueScheduled(ctx context.Context, now time.Time, limit int) ([]dao.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDueScheduled", ctx, now, limit)
+	ret0, _ := ret[0].([]dao.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDueScheduled indicates an expected call of FindDueScheduled.
+func (mr *MockArticleDAOMockRecorder) FindDueScheduled(ctx, now, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDueScheduled", reflect.TypeOf((*MockArticleDAO)(nil).FindDueScheduled), ctx, now, limit)
+}
+
 // GetByAuthor mocks base method.
-func (m *MockArticleDAO) GetByAuthor(ctx context.Context, uid int64, offset, limit int) ([]dao.Article, error) {
+func (m *MockArticleDAO) GetByAuthor(ctx context.Context, uid, colId int64, cursor dao.Cursor, limit int) ([]dao.Article, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetByAuthor", ctx, uid, offset, limit)
+	ret := m.ctrl.Call(m, "GetByAuthor", ctx, uid, colId, cursor, limit)
 	ret0, _ := ret[0].([]dao.Article)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetByAuthor indicates an expected call of GetByAuthor.
-func (mr *MockArticleDAOMockRecorder) GetByAuthor(ctx, uid, offset, limit any) *gomock.Call {
+func (mr *MockArticleDAOMockRecorder) GetByAuthor(ctx, uid, colId, cursor, limit any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAuthor", reflect.TypeOf((*MockArticleDAO)(nil).GetByAuthor), ctx, uid, offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAuthor", reflect.TypeOf((*MockArticleDAO)(nil).GetByAuthor), ctx, uid, colId, cursor, limit)
 }
 
 // GetById mocks base method.
@@ -86,6 +115,51 @@ func (mr *MockArticleDAOMockRecorder) GetPubById(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPubById", reflect.TypeOf((*MockArticleDAO)(nil).GetPubById), ctx, id)
 }
 
+// GetPubByIds mocks base method.
+func (m *MockArticleDAO) GetPubByIds(ctx context.Context, ids []int64) ([]dao.PublishedArticle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPubByIds", ctx, ids)
+	ret0, _ := ret[0].([]dao.PublishedArticle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPubByIds indicates an expected call of GetPubByIds.
+func (mr *MockArticleDAOMockRecorder) GetPubByIds(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPubByIds", reflect.TypeOf((*MockArticleDAO)(nil).GetPubByIds), ctx, ids)
+}
+
+// GetRevisionById mocks base method.
+func (m *MockArticleDAO) GetRevisionById(ctx context.Context, id int64) (dao.ArticleRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisionById", ctx, id)
+	ret0, _ := ret[0].(dao.ArticleRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisionById indicates an expected call of GetRevisionById.
+func (mr *MockArticleDAOMockRecorder) GetRevisionById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisionById", reflect.TypeOf((*MockArticleDAO)(nil).GetRevisionById), ctx, id)
+}
+
+// GetRevisions mocks base method.
+func (m *MockArticleDAO) GetRevisions(ctx context.Context, artId, authorId int64, offset, limit int) ([]dao.ArticleRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisions", ctx, artId, authorId, offset, limit)
+	ret0, _ := ret[0].([]dao.ArticleRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisions indicates an expected call of GetRevisions.
+func (mr *MockArticleDAOMockRecorder) GetRevisions(ctx, artId, authorId, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisions", reflect.TypeOf((*MockArticleDAO)(nil).GetRevisions), ctx, artId, authorId, offset, limit)
+}
+
 // Insert mocks base method.
 func (m *MockArticleDAO) Insert(ctx context.Context, art dao.Article) (int64, error) {
 	m.ctrl.T.Helper()
@@ -102,18 +176,61 @@ func (mr *MockArticleDAOMockRecorder) Insert(ctx, art any) *gomock.Call {
 }
 
 // ListPub mocks base method.
-func (m *MockArticleDAO) ListPub(ctx context.Context, start time.Time, offset, limit int) ([]dao.PublishedArticle, error) {
+func (m *MockArticleDAO) ListPub(ctx context.Context, cursor dao.Cursor, limit int) ([]dao.PublishedArticle, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListPub", ctx, start, offset, limit)
+	ret := m.ctrl.Call(m, "ListPub", ctx, cursor, limit)
 	ret0, _ := ret[0].([]dao.PublishedArticle)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListPub indicates an expected call of ListPub.
-func (mr *MockArticleDAOMockRecorder) ListPub(ctx, start, offset, limit any) *gomock.Call {
+func (mr *MockArticleDAOMockRecorder) ListPub(ctx, cursor, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPub", reflect.TypeOf((*MockArticleDAO)(nil).ListPub), ctx, cursor, limit)
+}
+
+// ListPubByAuthor mocks base method.
+func (m *MockArticleDAO) ListPubByAuthor(ctx context.Context, uid int64, cursor dao.Cursor, limit int) ([]dao.PublishedArticle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPubByAuthor", ctx, uid, cursor, limit)
+	ret0, _ := ret[0].([]dao.PublishedArticle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPubByAuthor indicates an expected call of ListPubByAuthor.
+func (mr *MockArticleDAOMockRecorder) ListPubByAuthor(ctx, uid, cursor, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPubByAuthor", reflect.TypeOf((*MockArticleDAO)(nil).ListPubByAuthor), ctx, uid, cursor, limit)
+}
+
+// PublishScheduled mocks base method.
+func (m *MockArticleDAO) PublishScheduled(ctx context.Context, id int64, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishScheduled", ctx, id, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishScheduled indicates an expected call of PublishScheduled.
+func (mr *MockArticleDAOMockRecorder) PublishScheduled(ctx, id, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishScheduled", reflect.TypeOf((*MockArticleDAO)(nil).PublishScheduled), ctx, id, now)
+}
+
+// RejectPending mocks base method.
+func (m *MockArticleDAO) RejectPending(ctx context.Context, id, version int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectPending", ctx, id, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RejectPending indicates an expected call of RejectPending.
+func (mr *MockArticleDAOMockRecorder) RejectPending(ctx, id, version any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPub", reflect.TypeOf((*MockArticleDAO)(nil).ListPub), ctx, start, offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectPending", reflect.TypeOf((*MockArticleDAO)(nil).RejectPending), ctx, id, version)
 }
 
 // Sync mocks base method.
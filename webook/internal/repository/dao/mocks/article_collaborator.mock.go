@@ -0,0 +1,128 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./article_collaborator.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./article_collaborator.go -package=daomocks -destination=./mocks/article_collaborator.mock.go ArticleCollaboratorDAO
+//
+
+// Package daomocks is a generated GoMock package.
+package daomocks
+
+import (
+	context "context"
+	reflect "reflect"
+	dao "webook/internal/repository/dao"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockArticleCollaboratorDAO is a mock of ArticleCollaboratorDAO interface.
+type MockArticleCollaboratorDAO struct {
+	ctrl     *gomock.Controller
+	recorder *MockArticleCollaboratorDAOMockRecorder
+}
+
+// MockArticleCollaboratorDAOMockRecorder is the mock recorder for MockArticleCollaboratorDAO.
+type MockArticleCollaboratorDAOMockRecorder struct {
+	mock *MockArticleCollaboratorDAO
+}
+
+// NewMockArticleCollaboratorDAO creates a new mock instance.
+func NewMockArticleCollaboratorDAO(ctrl *gomock.Controller) *MockArticleCollaboratorDAO {
+	mock := &MockArticleCollaboratorDAO{ctrl: ctrl}
+	mock.recorder = &MockArticleCollaboratorDAOMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockArticleCollaboratorDAO) EXPECT() *MockArticleCollaboratorDAOMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockArticleCollaboratorDAO) Delete(ctx context.Context, artId, uid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, artId, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockArticleCollaboratorDAOMockRecorder) Delete(ctx, artId, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockArticleCollaboratorDAO)(nil).Delete), ctx, artId, uid)
+}
+
+// Find mocks base method.
+func (m *MockArticleCollaboratorDAO) Find(ctx context.Context, artId, uid int64) (dao.ArticleCollaborator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, artId, uid)
+	ret0, _ := ret[0].(dao.ArticleCollaborator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockArticleCollaboratorDAOMockRecorder) Find(ctx, artId, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockArticleCollaboratorDAO)(nil).Find), ctx, artId, uid)
+}
+
+// FindAuditLogs mocks base method.
+func (m *MockArticleCollaboratorDAO) FindAuditLogs(ctx context.Context, artId int64, offset, limit int) ([]dao.ArticleAuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAuditLogs", ctx, artId, offset, limit)
+	ret0, _ := ret[0].([]dao.ArticleAuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAuditLogs indicates an expected call of FindAuditLogs.
+func (mr *MockArticleCollaboratorDAOMockRecorder) FindAuditLogs(ctx, artId, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAuditLogs", reflect.TypeOf((*MockArticleCollaboratorDAO)(nil).FindAuditLogs), ctx, artId, offset, limit)
+}
+
+// FindByArticle mocks base method.
+func (m *MockArticleCollaboratorDAO) FindByArticle(ctx context.Context, artId int64) ([]dao.ArticleCollaborator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByArticle", ctx, artId)
+	ret0, _ := ret[0].([]dao.ArticleCollaborator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByArticle indicates an expected call of FindByArticle.
+func (mr *MockArticleCollaboratorDAOMockRecorder) FindByArticle(ctx, artId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByArticle", reflect.TypeOf((*MockArticleCollaboratorDAO)(nil).FindByArticle), ctx, artId)
+}
+
+// InsertAuditLog mocks base method.
+func (m *MockArticleCollaboratorDAO) InsertAuditLog(ctx context.Context, l dao.ArticleAuditLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAuditLog", ctx, l)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertAuditLog indicates an expected call of InsertAuditLog.
+func (mr *MockArticleCollaboratorDAOMockRecorder) InsertAuditLog(ctx, l any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAuditLog", reflect.TypeOf((*MockArticleCollaboratorDAO)(nil).InsertAuditLog), ctx, l)
+}
+
+// Upsert mocks base method.
+func (m *MockArticleCollaboratorDAO) Upsert(ctx context.Context, c dao.ArticleCollaborator) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockArticleCollaboratorDAOMockRecorder) Upsert(ctx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockArticleCollaboratorDAO)(nil).Upsert), ctx, c)
+}
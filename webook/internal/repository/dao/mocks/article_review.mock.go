@@ -0,0 +1,114 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./article_review.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./article_review.go -package=daomocks -destination=./mocks/article_review.mock.go ArticleReviewDAO
+//
+
+// Package daomocks is a generated GoMock package.
+package daomocks
+
+import (
+	context "context"
+	reflect "reflect"
+	dao "webook/internal/repository/dao"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockArticleReviewDAO is a mock of ArticleReviewDAO interface.
+type MockArticleReviewDAO struct {
+	ctrl     *gomock.Controller
+	recorder *MockArticleReviewDAOMockRecorder
+}
+
+// MockArticleReviewDAOMockRecorder is the mock recorder for MockArticleReviewDAO.
+type MockArticleReviewDAOMockRecorder struct {
+	mock *MockArticleReviewDAO
+}
+
+// NewMockArticleReviewDAO creates a new mock instance.
+func NewMockArticleReviewDAO(ctrl *gomock.Controller) *MockArticleReviewDAO {
+	mock := &MockArticleReviewDAO{ctrl: ctrl}
+	mock.recorder = &MockArticleReviewDAOMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockArticleReviewDAO) EXPECT() *MockArticleReviewDAOMockRecorder {
+	return m.recorder
+}
+
+// FindById mocks base method.
+func (m *MockArticleReviewDAO) FindById(ctx context.Context, id int64) (dao.ArticleReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, id)
+	ret0, _ := ret[0].(dao.ArticleReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockArticleReviewDAOMockRecorder) FindById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockArticleReviewDAO)(nil).FindById), ctx, id)
+}
+
+// FindPending mocks base method.
+func (m *MockArticleReviewDAO) FindPending(ctx context.Context, offset, limit int) ([]dao.ArticleReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPending", ctx, offset, limit)
+	ret0, _ := ret[0].([]dao.ArticleReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPending indicates an expected call of FindPending.
+func (mr *MockArticleReviewDAOMockRecorder) FindPending(ctx, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPending", reflect.TypeOf((*MockArticleReviewDAO)(nil).FindPending), ctx, offset, limit)
+}
+
+// Insert mocks base method.
+func (m *MockArticleReviewDAO) Insert(ctx context.Context, r dao.ArticleReview) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, r)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockArticleReviewDAOMockRecorder) Insert(ctx, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockArticleReviewDAO)(nil).Insert), ctx, r)
+}
+
+// Resolve mocks base method.
+func (m *MockArticleReviewDAO) Resolve(ctx context.Context, id int64, status uint8, reviewer int64, comment string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resolve", ctx, id, status, reviewer, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Resolve indicates an expected call of Resolve.
+func (mr *MockArticleReviewDAOMockRecorder) Resolve(ctx, id, status, reviewer, comment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resolve", reflect.TypeOf((*MockArticleReviewDAO)(nil).Resolve), ctx, id, status, reviewer, comment)
+}
+
+// SetStatus mocks base method.
+func (m *MockArticleReviewDAO) SetStatus(ctx context.Context, id int64, status uint8) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStatus", ctx, id, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetStatus indicates an expected call of SetStatus.
+func (mr *MockArticleReviewDAOMockRecorder) SetStatus(ctx, id, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStatus", reflect.TypeOf((*MockArticleReviewDAO)(nil).SetStatus), ctx, id, status)
+}
@@ -0,0 +1,202 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./column.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./column.go -package=daomocks -destination=./mocks/column.mock.go ColumnDAO
+//
+
+// Package daomocks is a generated GoMock package.
+package daomocks
+
+import (
+	context "context"
+	reflect "reflect"
+	dao "webook/internal/repository/dao"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockColumnDAO is a mock of ColumnDAO interface.
+type MockColumnDAO struct {
+	ctrl     *gomock.Controller
+	recorder *MockColumnDAOMockRecorder
+}
+
+// MockColumnDAOMockRecorder is the mock recorder for MockColumnDAO.
+type MockColumnDAOMockRecorder struct {
+	mock *MockColumnDAO
+}
+
+// NewMockColumnDAO creates a new mock instance.
+func NewMockColumnDAO(ctrl *gomock.Controller) *MockColumnDAO {
+	mock := &MockColumnDAO{ctrl: ctrl}
+	mock.recorder = &MockColumnDAOMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockColumnDAO) EXPECT() *MockColumnDAOMockRecorder {
+	return m.recorder
+}
+
+// AddArticle mocks base method.
+func (m *MockColumnDAO) AddArticle(ctx context.Context, colId, artId int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddArticle", ctx, colId, artId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddArticle indicates an expected call of AddArticle.
+func (mr *MockColumnDAOMockRecorder) AddArticle(ctx, colId, artId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddArticle", reflect.TypeOf((*MockColumnDAO)(nil).AddArticle), ctx, colId, artId)
+}
+
+// Delete mocks base method.
+func (m *MockColumnDAO) Delete(ctx context.Context, uid, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, uid, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockColumnDAOMockRecorder) Delete(ctx, uid, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockColumnDAO)(nil).Delete), ctx, uid, id)
+}
+
+// FindByArticle mocks base method.
+func (m *MockColumnDAO) FindByArticle(ctx context.Context, artId int64) (dao.ColumnArticle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByArticle", ctx, artId)
+	ret0, _ := ret[0].(dao.ColumnArticle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByArticle indicates an expected call of FindByArticle.
+func (mr *MockColumnDAOMockRecorder) FindByArticle(ctx, artId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByArticle", reflect.TypeOf((*MockColumnDAO)(nil).FindByArticle), ctx, artId)
+}
+
+// FindByAuthor mocks base method.
+func (m *MockColumnDAO) FindByAuthor(ctx context.Context, uid int64) ([]dao.Column, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByAuthor", ctx, uid)
+	ret0, _ := ret[0].([]dao.Column)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByAuthor indicates an expected call of FindByAuthor.
+func (mr *MockColumnDAOMockRecorder) FindByAuthor(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByAuthor", reflect.TypeOf((*MockColumnDAO)(nil).FindByAuthor), ctx, uid)
+}
+
+// FindById mocks base method.
+func (m *MockColumnDAO) FindById(ctx context.Context, id int64) (dao.Column, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, id)
+	ret0, _ := ret[0].(dao.Column)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockColumnDAOMockRecorder) FindById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockColumnDAO)(nil).FindById), ctx, id)
+}
+
+// FindPubNeighbors mocks base method.
+func (m *MockColumnDAO) FindPubNeighbors(ctx context.Context, ca dao.ColumnArticle) (dao.PublishedArticle, dao.PublishedArticle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPubNeighbors", ctx, ca)
+	ret0, _ := ret[0].(dao.PublishedArticle)
+	ret1, _ := ret[1].(dao.PublishedArticle)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindPubNeighbors indicates an expected call of FindPubNeighbors.
+func (mr *MockColumnDAOMockRecorder) FindPubNeighbors(ctx, ca any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPubNeighbors", reflect.TypeOf((*MockColumnDAO)(nil).FindPubNeighbors), ctx, ca)
+}
+
+// Insert mocks base method.
+func (m *MockColumnDAO) Insert(ctx context.Context, c dao.Column) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, c)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockColumnDAOMockRecorder) Insert(ctx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockColumnDAO)(nil).Insert), ctx, c)
+}
+
+// ListPubArticles mocks base method.
+func (m *MockColumnDAO) ListPubArticles(ctx context.Context, colId int64, offset, limit int) ([]dao.PublishedArticle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPubArticles", ctx, colId, offset, limit)
+	ret0, _ := ret[0].([]dao.PublishedArticle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPubArticles indicates an expected call of ListPubArticles.
+func (mr *MockColumnDAOMockRecorder) ListPubArticles(ctx, colId, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPubArticles", reflect.TypeOf((*MockColumnDAO)(nil).ListPubArticles), ctx, colId, offset, limit)
+}
+
+// RemoveArticle mocks base method.
+func (m *MockColumnDAO) RemoveArticle(ctx context.Context, colId, artId int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveArticle", ctx, colId, artId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveArticle indicates an expected call of RemoveArticle.
+func (mr *MockColumnDAOMockRecorder) RemoveArticle(ctx, colId, artId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveArticle", reflect.TypeOf((*MockColumnDAO)(nil).RemoveArticle), ctx, colId, artId)
+}
+
+// Reorder mocks base method.
+func (m *MockColumnDAO) Reorder(ctx context.Context, colId int64, artIds []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reorder", ctx, colId, artIds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reorder indicates an expected call of Reorder.
+func (mr *MockColumnDAOMockRecorder) Reorder(ctx, colId, artIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reorder", reflect.TypeOf((*MockColumnDAO)(nil).Reorder), ctx, colId, artIds)
+}
+
+// UpdateById mocks base method.
+func (m *MockColumnDAO) UpdateById(ctx context.Context, c dao.Column) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateById", ctx, c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateById indicates an expected call of UpdateById.
+func (mr *MockColumnDAOMockRecorder) UpdateById(ctx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateById", reflect.TypeOf((*MockColumnDAO)(nil).UpdateById), ctx, c)
+}
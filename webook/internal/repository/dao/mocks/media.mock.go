@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./media.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./media.go -package=daomocks -destination=./mocks/media.mock.go MediaDAO
+//
+
+// Package daomocks is a generated GoMock package.
+package daomocks
+
+import (
+	context "context"
+	reflect "reflect"
+	dao "webook/internal/repository/dao"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMediaDAO is a mock of MediaDAO interface.
+type MockMediaDAO struct {
+	ctrl     *gomock.Controller
+	recorder *MockMediaDAOMockRecorder
+}
+
+// MockMediaDAOMockRecorder is the mock recorder for MockMediaDAO.
+type MockMediaDAOMockRecorder struct {
+	mock *MockMediaDAO
+}
+
+// NewMockMediaDAO creates a new mock instance.
+func NewMockMediaDAO(ctrl *gomock.Controller) *MockMediaDAO {
+	mock := &MockMediaDAO{ctrl: ctrl}
+	mock.recorder = &MockMediaDAOMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMediaDAO) EXPECT() *MockMediaDAOMockRecorder {
+	return m.recorder
+}
+
+// FindById mocks base method.
+func (m *MockMediaDAO) FindById(ctx context.Context, id int64) (dao.Media, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, id)
+	ret0, _ := ret[0].(dao.Media)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockMediaDAOMockRecorder) FindById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockMediaDAO)(nil).FindById), ctx, id)
+}
+
+// FindByIds mocks base method.
+func (m *MockMediaDAO) FindByIds(ctx context.Context, ids []int64) ([]dao.Media, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIds", ctx, ids)
+	ret0, _ := ret[0].([]dao.Media)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIds indicates an expected call of FindByIds.
+func (mr *MockMediaDAOMockRecorder) FindByIds(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIds", reflect.TypeOf((*MockMediaDAO)(nil).FindByIds), ctx, ids)
+}
+
+// Insert mocks base method.
+func (m_2 *MockMediaDAO) Insert(ctx context.Context, m dao.Media) (int64, error) {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "Insert", ctx, m)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockMediaDAOMockRecorder) Insert(ctx, m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockMediaDAO)(nil).Insert), ctx, m)
+}
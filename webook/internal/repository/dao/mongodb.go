@@ -15,32 +15,81 @@ type MongoDBArticleDAO struct {
 	node    *snowflake.Node
 	col     *mongo.Collection
 	liveCol *mongo.Collection
+	// revisionCol 历史版本，没有事务，所以是在更新成功之后再插入
+	revisionCol *mongo.Collection
 }
 
 func (m *MongoDBArticleDAO) Insert(ctx context.Context, art Article) (int64, error) {
+	id, err := m.insert(ctx, art)
+	if err != nil {
+		return 0, err
+	}
+	art.Id = id
+	return id, m.insertRevision(ctx, art, RevisionKindSave)
+}
+
+func (m *MongoDBArticleDAO) insert(ctx context.Context, art Article) (int64, error) {
 	now := time.Now().UnixMilli()
 	art.Ctime = now
 	art.Utime = now
+	art.Version = 1
 	art.Id = m.node.Generate().Int64()
 	_, err := m.col.InsertOne(ctx, &art)
 	return art.Id, err
 }
 
 func (m *MongoDBArticleDAO) UpdateById(ctx context.Context, art Article) error {
+	err := m.updateById(ctx, art)
+	if err != nil {
+		return err
+	}
+	return m.insertRevision(ctx, art, RevisionKindSave)
+}
+
+func (m *MongoDBArticleDAO) insertRevision(ctx context.Context, art Article, kind uint8) error {
+	_, err := m.revisionCol.InsertOne(ctx, ArticleRevision{
+		Id:        m.node.Generate().Int64(),
+		ArticleId: art.Id,
+		AuthorId:  art.AuthorId,
+		Title:     art.Title,
+		Content:   art.Content,
+		Status:    art.Status,
+		Kind:      kind,
+		Ctime:     time.Now().UnixMilli(),
+	})
+	return err
+}
+
+func (m *MongoDBArticleDAO) updateById(ctx context.Context, art Article) error {
 	now := time.Now().UnixMilli()
 	filter := bson.D{bson.E{Key: "id", Value: art.Id},
-		bson.E{Key: "author_id", Value: art.AuthorId}}
-	set := bson.D{bson.E{Key: "$set", Value: bson.M{
-		"title":   art.Title,
-		"content": art.Content,
-		"status":  art.Status,
-		"utime":   now,
-	}}}
+		bson.E{Key: "author_id", Value: art.AuthorId},
+		bson.E{Key: "version", Value: art.Version}}
+	set := bson.D{bson.E{Key: "$inc", Value: bson.M{"version": 1}},
+		bson.E{Key: "$set", Value: bson.M{
+			"title":      art.Title,
+			"content":    art.Content,
+			"status":     art.Status,
+			"publish_at": art.PublishAt,
+			"cover_id":   art.CoverId,
+			"html":       art.Html,
+			"toc":        art.Toc,
+			"abstract":   art.Abstract,
+			"utime":      now,
+		}}}
 	res, err := m.col.UpdateOne(ctx, filter, set)
 	if err != nil {
 		return err
 	}
 	if res.ModifiedCount == 0 {
+		cnt, err := m.col.CountDocuments(ctx, bson.D{bson.E{Key: "id", Value: art.Id},
+			bson.E{Key: "author_id", Value: art.AuthorId}})
+		if err != nil {
+			return err
+		}
+		if cnt > 0 {
+			return ErrArticleVersionConflict
+		}
 		// 创作者不对，说明有人在瞎搞
 		return errors.New("ID 不对或者创作者不对")
 	}
@@ -52,15 +101,21 @@ func (m *MongoDBArticleDAO) Sync(ctx context.Context, art Article) (int64, error
 		id  = art.Id
 		err error
 	)
+	// 发表只记录一个发表的版本
 	if id > 0 {
-		err = m.UpdateById(ctx, art)
+		err = m.updateById(ctx, art)
 	} else {
-		id, err = m.Insert(ctx, art)
+		id, err = m.insert(ctx, art)
 	}
 	if err != nil {
 		return 0, err
 	}
 	art.Id = id
+	return id, m.upsertLive(ctx, art)
+}
+
+// upsertLive 写入线上库并记录一个发表的版本
+func (m *MongoDBArticleDAO) upsertLive(ctx context.Context, art Article) error {
 	now := time.Now().UnixMilli()
 	art.Utime = now
 	// liveCol 是 INSERT or Update 语义
@@ -69,10 +124,102 @@ func (m *MongoDBArticleDAO) Sync(ctx context.Context, art Article) (int64, error
 	set := bson.D{bson.E{Key: "$set", Value: art},
 		bson.E{Key: "$setOnInsert",
 			Value: bson.D{bson.E{Key: "ctime", Value: now}}}}
-	_, err = m.liveCol.UpdateOne(ctx,
+	_, err := m.liveCol.UpdateOne(ctx,
 		filter, set,
 		options.Update().SetUpsert(true))
-	return id, err
+	if err != nil {
+		return err
+	}
+	return m.insertRevision(ctx, art, RevisionKindPublish)
+}
+
+func (m *MongoDBArticleDAO) FindDueScheduled(ctx context.Context, now time.Time, limit int) ([]Article, error) {
+	filter := bson.D{bson.E{Key: "status", Value: articleStatusScheduled},
+		bson.E{Key: "publish_at", Value: bson.D{bson.E{Key: "$lte", Value: now.UnixMilli()}}}}
+	opts := options.Find().
+		SetSort(bson.D{bson.E{Key: "publish_at", Value: 1}}).
+		SetLimit(int64(limit))
+	cursor, err := m.col.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	var res []Article
+	err = cursor.All(ctx, &res)
+	return res, err
+}
+
+func (m *MongoDBArticleDAO) PublishScheduled(ctx context.Context, id int64, now time.Time) error {
+	// 没有事务，FindOneAndUpdate 本身是原子的，可以用来做 CAS
+	filter := bson.D{bson.E{Key: "id", Value: id},
+		bson.E{Key: "status", Value: articleStatusScheduled},
+		bson.E{Key: "publish_at", Value: bson.D{bson.E{Key: "$lte", Value: now.UnixMilli()}}}}
+	set := bson.D{bson.E{Key: "$set", Value: bson.M{
+		"status":     articleStatusPublished,
+		"publish_at": 0,
+		"utime":      now.UnixMilli(),
+	}}}
+	var art Article
+	err := m.col.FindOneAndUpdate(ctx, filter, set,
+		options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&art)
+	if err == mongo.ErrNoDocuments {
+		return ErrScheduledArticleNotDue
+	}
+	if err != nil {
+		return err
+	}
+	return m.upsertLive(ctx, art)
+}
+
+func (m *MongoDBArticleDAO) ApprovePending(ctx context.Context, id int64, version int64, now time.Time) error {
+	filter := bson.D{bson.E{Key: "id", Value: id},
+		bson.E{Key: "status", Value: articleStatusPendingReview},
+		bson.E{Key: "version", Value: version}}
+	var art Article
+	err := m.col.FindOne(ctx, filter).Decode(&art)
+	if err == mongo.ErrNoDocuments {
+		return ErrArticleNotPending
+	}
+	if err != nil {
+		return err
+	}
+	sets := bson.M{
+		"status": articleStatusScheduled,
+		"utime":  now.UnixMilli(),
+	}
+	publish := art.PublishAt <= now.UnixMilli()
+	if publish {
+		sets["status"] = articleStatusPublished
+		sets["publish_at"] = 0
+	}
+	// 查出来之后可能又被改了，所以更新的时候还是要带上状态和版本号
+	err = m.col.FindOneAndUpdate(ctx, filter, bson.D{bson.E{Key: "$set", Value: sets}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&art)
+	if err == mongo.ErrNoDocuments {
+		return ErrArticleNotPending
+	}
+	if err != nil || !publish {
+		return err
+	}
+	return m.upsertLive(ctx, art)
+}
+
+func (m *MongoDBArticleDAO) RejectPending(ctx context.Context, id int64, version int64) error {
+	filter := bson.D{bson.E{Key: "id", Value: id},
+		bson.E{Key: "status", Value: articleStatusPendingReview},
+		bson.E{Key: "version", Value: version}}
+	sets := bson.D{bson.E{Key: "$set", Value: bson.M{
+		"status":     articleStatusUnpublished,
+		"publish_at": 0,
+		"utime":      time.Now().UnixMilli(),
+	}}}
+	res, err := m.col.UpdateOne(ctx, filter, sets)
+	if err != nil {
+		return err
+	}
+	if res.ModifiedCount == 0 {
+		return ErrArticleNotPending
+	}
+	return nil
 }
 
 func (m *MongoDBArticleDAO) SyncStatus(ctx context.Context, uid int64, id int64, status uint8) error {
@@ -91,14 +238,15 @@ func (m *MongoDBArticleDAO) SyncStatus(ctx context.Context, uid int64, id int64,
 	return err
 }
 
-func (m *MongoDBArticleDAO) GetByAuthor(ctx context.Context, uid int64, offset int, limit int) ([]Article, error) {
+func (m *MongoDBArticleDAO) GetByAuthor(ctx context.Context, uid int64, colId int64, cursor Cursor, limit int) ([]Article, error) {
 	//TODO implement me
 	panic("implement me")
 }
 
 func (m *MongoDBArticleDAO) GetById(ctx context.Context, id int64) (Article, error) {
-	//TODO implement me
-	panic("implement me")
+	var res Article
+	err := m.col.FindOne(ctx, bson.D{bson.E{Key: "id", Value: id}}).Decode(&res)
+	return res, err
 }
 
 func (m *MongoDBArticleDAO) GetPubById(ctx context.Context, id int64) (PublishedArticle, error) {
@@ -106,15 +254,84 @@ func (m *MongoDBArticleDAO) GetPubById(ctx context.Context, id int64) (Published
 	panic("implement me")
 }
 
-func (m *MongoDBArticleDAO) ListPub(ctx context.Context, start time.Time, offset int, limit int) ([]PublishedArticle, error) {
+func (m *MongoDBArticleDAO) GetPubByIds(ctx context.Context, ids []int64) ([]PublishedArticle, error) {
+	filter := bson.D{bson.E{Key: "id", Value: bson.M{"$in": ids}},
+		bson.E{Key: "status", Value: articleStatusPublished}}
+	opts := options.Find().
+		SetProjection(bson.D{bson.E{Key: "id", Value: 1}, bson.E{Key: "title", Value: 1}})
+	cursor, err := m.liveCol.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	var res []PublishedArticle
+	err = cursor.All(ctx, &res)
+	return res, err
+}
+
+func (m *MongoDBArticleDAO) ListPub(ctx context.Context, cursor Cursor, limit int) ([]PublishedArticle, error) {
 	//TODO implement me
 	panic("implement me")
 }
 
+func (m *MongoDBArticleDAO) ListPubByAuthor(ctx context.Context, uid int64, cursor Cursor, limit int) ([]PublishedArticle, error) {
+	filter := cursor.bsonFilter(bson.D{bson.E{Key: "author_id", Value: uid},
+		bson.E{Key: "status", Value: articleStatusPublished}})
+	// 和 GORM 的实现一样，按照 (utime, id) 倒序
+	opts := options.Find().
+		SetSort(bson.D{bson.E{Key: "utime", Value: -1}, bson.E{Key: "id", Value: -1}}).
+		SetLimit(int64(limit))
+	res, err := m.liveCol.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	var arts []PublishedArticle
+	err = res.All(ctx, &arts)
+	return arts, err
+}
+
+// bsonFilter 和 where 一样，取排在游标后面的，也就是 (utime, id) 比游标小的
+func (c Cursor) bsonFilter(filter bson.D) bson.D {
+	if c.Utime == 0 && c.Id == 0 {
+		return filter
+	}
+	return append(filter, bson.E{Key: "$or", Value: bson.A{
+		bson.D{bson.E{Key: "utime", Value: bson.M{"$lt": c.Utime}}},
+		bson.D{bson.E{Key: "utime", Value: c.Utime}, bson.E{Key: "id", Value: bson.M{"$lt": c.Id}}},
+	}})
+}
+
+func (m *MongoDBArticleDAO) GetRevisions(ctx context.Context, artId int64, authorId int64,
+	offset int, limit int) ([]ArticleRevision, error) {
+	filter := bson.D{bson.E{Key: "article_id", Value: artId},
+		bson.E{Key: "author_id", Value: authorId}}
+	// 雪花算法的 ID 是递增的，按照 ID 倒序就是按照时间倒序
+	opts := options.Find().
+		SetSort(bson.D{bson.E{Key: "id", Value: -1}}).
+		SetSkip(int64(offset)).
+		SetLimit(int64(limit))
+	cursor, err := m.revisionCol.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	var res []ArticleRevision
+	err = cursor.All(ctx, &res)
+	return res, err
+}
+
+func (m *MongoDBArticleDAO) GetRevisionById(ctx context.Context, id int64) (ArticleRevision, error) {
+	var res ArticleRevision
+	err := m.revisionCol.FindOne(ctx, bson.D{bson.E{Key: "id", Value: id}}).Decode(&res)
+	if err == mongo.ErrNoDocuments {
+		return res, ErrDataNotFound
+	}
+	return res, err
+}
+
 func NewMongoDBArticleDAO(mdb *mongo.Database, node *snowflake.Node) *MongoDBArticleDAO {
 	return &MongoDBArticleDAO{
-		node:    node,
-		liveCol: mdb.Collection("published_articles"),
-		col:     mdb.Collection("articles"),
+		node:        node,
+		liveCol:     mdb.Collection("published_articles"),
+		col:         mdb.Collection("articles"),
+		revisionCol: mdb.Collection("article_revisions"),
 	}
 }
@@ -13,6 +13,8 @@ import (
 var (
 	ErrDuplicateUser = errors.New("邮箱冲突")
 	ErrDataNotFound  = gorm.ErrRecordNotFound
+	// ErrTwoFactorCodeUsed 验证码或者恢复码已经被别的请求用掉了
+	ErrTwoFactorCodeUsed = errors.New("两步验证码已经用过了")
 )
 
 //go:generate mockgen -source=./user.go -package=daomocks -destination=./mocks/user.mock.go UserDAO
@@ -23,6 +25,41 @@ type UserDAO interface {
 	FindByPhone(ctx context.Context, phone string) (User, error)
 	UpdateById(ctx context.Context, id int64, nickname string, birthday string, aboutMe string) error
 	FindByWechat(ctx context.Context, openId string) (User, error)
+	// UpdatePassword 同时作废还没有生效的密码
+	UpdatePassword(ctx context.Context, id int64, password string) error
+	// UpdateEmailVerified 验证通过的时候，绑定邮箱时设置的密码才会生效
+	UpdateEmailVerified(ctx context.Context, id int64, verified bool) error
+	UpdateTwoFactor(ctx context.Context, id int64, secret string, enabled bool, recoveryCodes string) error
+	// UseTOTPStep 只有 step 比上一次用过的大才会更新，否则返回 ErrTwoFactorCodeUsed
+	UseTOTPStep(ctx context.Context, id int64, step int64) error
+	// UpdateRecoveryCodes 只有数据库里面的恢复码还是 old 才会更新，否则返回 ErrTwoFactorCodeUsed
+	UpdateRecoveryCodes(ctx context.Context, id int64, old string, recoveryCodes string) error
+	// UpdateRoles roles 是 JSON 格式的角色列表
+	UpdateRoles(ctx context.Context, id int64, roles string) error
+	// UpdatePhone phone 为空表示解绑
+	UpdatePhone(ctx context.Context, id int64, phone string) error
+	// UpdateEmail 换了邮箱之后需要重新验证，email 为空表示解绑。
+	// password 要等邮箱验证通过之后才会生效
+	UpdateEmail(ctx context.Context, id int64, email string, password string) error
+	// UpdateWechat openId 为空表示解绑
+	UpdateWechat(ctx context.Context, id int64, openId string, unionId string) error
+	// Merge 把 source 的登录方式和文章都转移到 target 上，然后删除 source
+	Merge(ctx context.Context, targetId int64, sourceId int64) error
+
+	// FindByIdentity 根据第三方账号查找用户
+	FindByIdentity(ctx context.Context, provider string, subject string) (User, error)
+	FindIdentities(ctx context.Context, uid int64) ([]UserIdentity, error)
+	// InsertWithIdentity 第三方账号第一次登录，同时创建用户和绑定关系，返回用户 ID
+	InsertWithIdentity(ctx context.Context, identity UserIdentity) (int64, error)
+	InsertIdentity(ctx context.Context, identity UserIdentity) error
+	DeleteIdentity(ctx context.Context, uid int64, provider string) error
+
+	// UpdateDeleteAt 预约注销的时间，毫秒数，0 表示取消注销
+	UpdateDeleteAt(ctx context.Context, id int64, deleteAt int64) error
+	// FindDueDeletions 找到注销时间已经到了的用户
+	FindDueDeletions(ctx context.Context, now int64, limit int) ([]User, error)
+	// Anonymize 清空用户的个人信息和登录方式，保留 ID 让别的数据还能关联上
+	Anonymize(ctx context.Context, id int64, nickname string) error
 }
 
 type GORMUserDAO struct {
@@ -81,21 +118,361 @@ func (ud *GORMUserDAO) FindByWechat(ctx context.Context, openId string) (User, e
 	return u, err
 }
 
+func (ud *GORMUserDAO) UpdatePassword(ctx context.Context, id int64, password string) error {
+	return ud.db.WithContext(ctx).Model(&User{}).Where("id = ?", id).
+		Updates(map[string]any{
+			"password":         password,
+			"pending_password": "",
+			"utime":            time.Now().UnixMilli(),
+		}).Error
+}
+
+func (ud *GORMUserDAO) UpdateEmailVerified(ctx context.Context, id int64, verified bool) error {
+	return ud.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var u User
+		err := tx.Where("id = ?", id).First(&u).Error
+		if err != nil {
+			return err
+		}
+		updates := map[string]any{
+			"email_verified": verified,
+			"utime":          time.Now().UnixMilli(),
+		}
+		if verified && u.PendingPassword != "" {
+			updates["password"] = u.PendingPassword
+			updates["pending_password"] = ""
+		}
+		return tx.Model(&User{}).Where("id = ?", id).Updates(updates).Error
+	})
+}
+
+func (ud *GORMUserDAO) UpdateTwoFactor(ctx context.Context, id int64,
+	secret string, enabled bool, recoveryCodes string) error {
+	return ud.db.WithContext(ctx).Model(&User{}).Where("id = ?", id).
+		Updates(map[string]any{
+			"totp_secret":    secret,
+			"totp_enabled":   enabled,
+			"recovery_codes": recoveryCodes,
+			"utime":          time.Now().UnixMilli(),
+		}).Error
+}
+
+func (ud *GORMUserDAO) UseTOTPStep(ctx context.Context, id int64, step int64) error {
+	res := ud.db.WithContext(ctx).Model(&User{}).
+		Where("id = ? AND totp_last_step < ?", id, step).
+		Updates(map[string]any{
+			"totp_last_step": step,
+			"utime":          time.Now().UnixMilli(),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrTwoFactorCodeUsed
+	}
+	return nil
+}
+
+func (ud *GORMUserDAO) UpdateRecoveryCodes(ctx context.Context, id int64,
+	old string, recoveryCodes string) error {
+	res := ud.db.WithContext(ctx).Model(&User{}).
+		Where("id = ? AND recovery_codes = ?", id, old).
+		Updates(map[string]any{
+			"recovery_codes": recoveryCodes,
+			"utime":          time.Now().UnixMilli(),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrTwoFactorCodeUsed
+	}
+	return nil
+}
+
+func (ud *GORMUserDAO) UpdateRoles(ctx context.Context, id int64, roles string) error {
+	return ud.db.WithContext(ctx).Model(&User{}).Where("id = ?", id).
+		Updates(map[string]any{
+			"roles": roles,
+			"utime": time.Now().UnixMilli(),
+		}).Error
+}
+
+func (ud *GORMUserDAO) UpdatePhone(ctx context.Context, id int64, phone string) error {
+	return ud.updateIdentity(ctx, id, map[string]any{
+		"phone": toNullString(phone),
+	})
+}
+
+func (ud *GORMUserDAO) UpdateEmail(ctx context.Context, id int64, email string, password string) error {
+	return ud.updateIdentity(ctx, id, map[string]any{
+		"email":            toNullString(email),
+		"email_verified":   false,
+		"pending_password": password,
+	})
+}
+
+func (ud *GORMUserDAO) UpdateWechat(ctx context.Context, id int64, openId string, unionId string) error {
+	return ud.updateIdentity(ctx, id, map[string]any{
+		"wechat_open_id":  toNullString(openId),
+		"wechat_union_id": toNullString(unionId),
+	})
+}
+
+func (ud *GORMUserDAO) updateIdentity(ctx context.Context, id int64, updates map[string]any) error {
+	updates["utime"] = time.Now().UnixMilli()
+	err := ud.db.WithContext(ctx).Model(&User{}).Where("id = ?", id).
+		Updates(updates).Error
+	return ud.convertDuplicateErr(err)
+}
+
+func (ud *GORMUserDAO) Merge(ctx context.Context, targetId int64, sourceId int64) error {
+	return ud.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var source User
+		err := tx.Where("id = ?", sourceId).First(&source).Error
+		if err != nil {
+			return err
+		}
+		now := time.Now().UnixMilli()
+		// 只转移 source 上有的登录方式，target 上已经有的在上层已经检查过冲突
+		updates := map[string]any{"utime": now}
+		if source.Phone.Valid {
+			updates["phone"] = source.Phone
+		}
+		if source.Email.Valid {
+			updates["email"] = source.Email
+			updates["email_verified"] = source.EmailVerified
+			updates["password"] = source.Password
+			updates["pending_password"] = source.PendingPassword
+		}
+		if source.WechatOpenId.Valid {
+			updates["wechat_open_id"] = source.WechatOpenId
+			updates["wechat_union_id"] = source.WechatUnionId
+		}
+		// 先删掉 source，不然唯一索引会冲突
+		err = tx.Where("id = ?", sourceId).Delete(&User{}).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Model(&User{}).Where("id = ?", targetId).Updates(updates).Error
+		if err != nil {
+			return ud.convertDuplicateErr(err)
+		}
+		err = tx.Model(&UserIdentity{}).Where("uid = ?", sourceId).
+			Updates(map[string]any{"uid": targetId, "utime": now}).Error
+		if err != nil {
+			return ud.convertDuplicateErr(err)
+		}
+		// 文章也归到 target 名下。列表、订阅源和 sitemap 都按照 utime 排序，
+		// 所以只改作者，不动 utime，不然老文章会全部跑到最前面
+		err = tx.Model(&Article{}).Where("author_id = ?", sourceId).
+			Update("author_id", targetId).Error
+		if err != nil {
+			return err
+		}
+		// 历史版本按照作者查询，要跟着文章一起转过去
+		err = tx.Model(&ArticleRevision{}).Where("author_id = ?", sourceId).
+			Update("author_id", targetId).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Model(&PublishedArticle{}).Where("author_id = ?", sourceId).
+			Update("author_id", targetId).Error
+		if err != nil {
+			return err
+		}
+		// 专栏里面的文章已经转过去了，专栏本身也要跟着转
+		err = tx.Model(&Column{}).Where("author_id = ?", sourceId).
+			Update("author_id", targetId).Error
+		if err != nil {
+			return err
+		}
+		// 协作的文章也转过去。target 已经是协作者的文章保留 target 原来的权限，
+		// source 的那条直接删掉，不然 (article_id, uid) 唯一索引会冲突
+		var dupArtIds []int64
+		err = tx.Model(&ArticleCollaborator{}).Where("uid = ?", targetId).
+			Pluck("article_id", &dupArtIds).Error
+		if err != nil {
+			return err
+		}
+		if len(dupArtIds) > 0 {
+			err = tx.Where("uid = ? AND article_id IN ?", sourceId, dupArtIds).
+				Delete(&ArticleCollaborator{}).Error
+			if err != nil {
+				return err
+			}
+		}
+		err = tx.Model(&ArticleCollaborator{}).Where("uid = ?", sourceId).
+			Updates(map[string]any{"uid": targetId, "utime": now}).Error
+		if err != nil {
+			return err
+		}
+		// 文章只能引用作者自己上传的文件，所以上传的文件也要归到 target 名下
+		return tx.Model(&Media{}).Where("uid = ?", sourceId).
+			Update("uid", targetId).Error
+	})
+}
+
+func (ud *GORMUserDAO) FindByIdentity(ctx context.Context, provider string, subject string) (User, error) {
+	var u User
+	err := ud.db.WithContext(ctx).
+		Where("id = (?)", ud.db.Model(&UserIdentity{}).Select("uid").
+			Where("provider = ? AND subject = ?", provider, subject)).
+		First(&u).Error
+	return u, err
+}
+
+func (ud *GORMUserDAO) FindIdentities(ctx context.Context, uid int64) ([]UserIdentity, error) {
+	var res []UserIdentity
+	err := ud.db.WithContext(ctx).Where("uid = ?", uid).Find(&res).Error
+	return res, err
+}
+
+func (ud *GORMUserDAO) InsertWithIdentity(ctx context.Context, identity UserIdentity) (int64, error) {
+	var uid int64
+	err := ud.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now().UnixMilli()
+		u := User{Ctime: now, Utime: now}
+		err := tx.Create(&u).Error
+		if err != nil {
+			return err
+		}
+		uid = u.Id
+		identity.Uid = u.Id
+		identity.Ctime = now
+		identity.Utime = now
+		return tx.Create(&identity).Error
+	})
+	return uid, ud.convertDuplicateErr(err)
+}
+
+func (ud *GORMUserDAO) InsertIdentity(ctx context.Context, identity UserIdentity) error {
+	now := time.Now().UnixMilli()
+	identity.Ctime = now
+	identity.Utime = now
+	err := ud.db.WithContext(ctx).Create(&identity).Error
+	return ud.convertDuplicateErr(err)
+}
+
+func (ud *GORMUserDAO) DeleteIdentity(ctx context.Context, uid int64, provider string) error {
+	return ud.db.WithContext(ctx).
+		Where("uid = ? AND provider = ?", uid, provider).
+		Delete(&UserIdentity{}).Error
+}
+
+func (ud *GORMUserDAO) UpdateDeleteAt(ctx context.Context, id int64, deleteAt int64) error {
+	return ud.db.WithContext(ctx).Model(&User{}).Where("id = ?", id).
+		Updates(map[string]any{
+			"delete_at": deleteAt,
+			"utime":     time.Now().UnixMilli(),
+		}).Error
+}
+
+func (ud *GORMUserDAO) FindDueDeletions(ctx context.Context, now int64, limit int) ([]User, error) {
+	var res []User
+	err := ud.db.WithContext(ctx).
+		Where("delete_at > 0 AND delete_at <= ?", now).
+		Order("delete_at ASC").
+		Limit(limit).Find(&res).Error
+	return res, err
+}
+
+func (ud *GORMUserDAO) Anonymize(ctx context.Context, id int64, nickname string) error {
+	return ud.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now().UnixMilli()
+		err := tx.Model(&User{}).Where("id = ?", id).
+			Updates(map[string]any{
+				"email":           sql.NullString{},
+				"email_verified":  false,
+				"phone":           sql.NullString{},
+				"password":        "",
+				"nickname":        nickname,
+				"birthday":        "",
+				"about_me":        "",
+				"wechat_open_id":  sql.NullString{},
+				"wechat_union_id": sql.NullString{},
+				"totp_secret":     "",
+				"totp_enabled":    false,
+				"recovery_codes":  "",
+				"roles":           "",
+				"delete_at":       0,
+				"deleted":         true,
+				"utime":           now,
+			}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Where("uid = ?", id).Delete(&UserIdentity{}).Error
+	})
+}
+
+func (ud *GORMUserDAO) convertDuplicateErr(err error) error {
+	if me, ok := err.(*mysql.MySQLError); ok {
+		const uniqueIndexErrNo uint16 = 1062
+		if me.Number == uniqueIndexErrNo {
+			return ErrDuplicateUser
+		}
+	}
+	return err
+}
+
+func toNullString(val string) sql.NullString {
+	return sql.NullString{
+		String: val,
+		Valid:  val != "",
+	}
+}
+
 type User struct {
 	Id int64 `gorm:"primaryKey,autoIncrement"`
 	// 创建唯一索引
-	Email    sql.NullString `gorm:"unique"`
-	Phone    sql.NullString `gorm:"unique"`
-	Password string
-	Nickname string `gorm:"type=varchar(16)"`
-	Birthday string
-	AboutMe  string `gorm:"type=varchar(1024)"`
+	Email sql.NullString `gorm:"unique"`
+	// 邮箱是否已经验证过
+	EmailVerified bool
+	Phone         sql.NullString `gorm:"unique"`
+	Password      string
+	// PendingPassword 绑定邮箱时设置的密码，邮箱验证通过之后才会变成 Password
+	PendingPassword string
+	Nickname        string `gorm:"type=varchar(16)"`
+	Birthday        string
+	AboutMe         string `gorm:"type=varchar(1024)"`
 
 	WechatOpenId  sql.NullString `gorm:"unique"`
 	WechatUnionId sql.NullString
 
+	// 两步验证
+	TOTPSecret  string `gorm:"column:totp_secret"`
+	TOTPEnabled bool   `gorm:"column:totp_enabled"`
+	// TOTPLastStep 最近一次用过的验证码所在的周期，用来防止重放
+	TOTPLastStep int64 `gorm:"column:totp_last_step"`
+	// RecoveryCodes JSON 格式的恢复码哈希
+	RecoveryCodes string `gorm:"type=varchar(1024)"`
+
+	// Roles JSON 格式的角色列表
+	Roles string `gorm:"type=varchar(256)"`
+
+	// DeleteAt 预约注销的时间，过了冷静期之后才会真的匿名化，0 表示没有申请注销
+	DeleteAt int64 `gorm:"index"`
+	// Deleted 已经注销，只剩下一个匿名的壳
+	Deleted bool
+
 	// 创建时间
 	Ctime int64
 	// 更新时间
 	Utime int64
 }
+
+// UserIdentity 用户和第三方账号的绑定关系
+type UserIdentity struct {
+	Id  int64 `gorm:"primaryKey,autoIncrement"`
+	Uid int64 `gorm:"uniqueIndex:uid_provider"`
+	// 同一个第三方账号只能绑定一个用户，一个用户在同一个第三方只能绑定一个账号
+	Provider string `gorm:"type:varchar(64);uniqueIndex:provider_subject;uniqueIndex:uid_provider"`
+	Subject  string `gorm:"type:varchar(255);uniqueIndex:provider_subject"`
+	UnionId  string
+	Email    string
+	Nickname string
+
+	Ctime int64
+	Utime int64
+}
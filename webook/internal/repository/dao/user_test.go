@@ -4,11 +4,13 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"regexp"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	mysqlDriver "github.com/go-sql-driver/mysql"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
@@ -89,3 +91,60 @@ func TestGORMUserDAO_Insert(t *testing.T) {
 		})
 	}
 }
+
+func TestGORMUserDAO_Merge(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `users` WHERE id = ?")).
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "phone"}).AddRow(2, "13800000000"))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `users` WHERE id = ?")).
+		WithArgs(2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `users` SET `phone`=?,`utime`=? WHERE id = ?")).
+		WithArgs("13800000000", sqlmock.AnyArg(), 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `user_identities` SET `uid`=?,`utime`=? WHERE uid = ?")).
+		WithArgs(1, sqlmock.AnyArg(), 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	// 只改作者，不动 utime
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `articles` SET `author_id`=? WHERE author_id = ?")).
+		WithArgs(1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `article_revisions` SET `author_id`=? WHERE author_id = ?")).
+		WithArgs(1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 5))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `published_articles` SET `author_id`=? WHERE author_id = ?")).
+		WithArgs(1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `columns` SET `author_id`=? WHERE author_id = ?")).
+		WithArgs(1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	// target 已经协作了文章 10，source 在文章 10 上的记录删掉
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `article_id` FROM `article_collaborators` WHERE uid = ?")).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"article_id"}).AddRow(10))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `article_collaborators` WHERE uid = ? AND article_id IN (?)")).
+		WithArgs(2, 10).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `article_collaborators` SET `uid`=?,`utime`=? WHERE uid = ?")).
+		WithArgs(1, sqlmock.AnyArg(), 2).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `media` SET `uid`=? WHERE uid = ?")).
+		WithArgs(1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 4))
+	mock.ExpectCommit()
+
+	db, err := gorm.Open(mysql.New(mysql.Config{
+		Conn:                      sqlDB,
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+	})
+	require.NoError(t, err)
+	err = NewUserDAO(db).Merge(context.Background(), 1, 2)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
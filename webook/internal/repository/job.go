@@ -12,6 +12,7 @@ type CronJobRepository interface {
 	Release(ctx context.Context, jid int64) error
 	UpdateUtime(ctx context.Context, id int64) error
 	UpdateNextTime(ctx context.Context, id int64, time time.Time) error
+	AddJob(ctx context.Context, j domain.Job) error
 }
 
 type PreemptJobRepository struct {
@@ -43,3 +44,13 @@ func (jr *PreemptJobRepository) UpdateUtime(ctx context.Context, id int64) error
 func (jr *PreemptJobRepository) UpdateNextTime(ctx context.Context, id int64, time time.Time) error {
 	return jr.jd.UpdateNextTime(ctx, id, time)
 }
+
+func (jr *PreemptJobRepository) AddJob(ctx context.Context, j domain.Job) error {
+	return jr.jd.Insert(ctx, dao.Job{
+		Name:       j.Name,
+		Executor:   j.Executor,
+		Expression: j.Expression,
+		Cfg:        j.Cfg,
+		NextTime:   j.NextTime().UnixMilli(),
+	})
+}
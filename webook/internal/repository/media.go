@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"webook/internal/domain"
+	"webook/internal/repository/dao"
+
+	"github.com/ecodeclub/ekit/slice"
+)
+
+var ErrMediaNotFound = dao.ErrDataNotFound
+
+//go:generate mockgen -source=./media.go -package=repomocks -destination=./mocks/media.mock.go MediaRepository
+type MediaRepository interface {
+	Create(ctx context.Context, m domain.Media) (int64, error)
+	FindById(ctx context.Context, id int64) (domain.Media, error)
+	// FindByIds 不存在的 ID 会被忽略
+	FindByIds(ctx context.Context, ids []int64) ([]domain.Media, error)
+}
+
+type GORMMediaRepository struct {
+	dao dao.MediaDAO
+}
+
+func NewMediaRepository(dao dao.MediaDAO) MediaRepository {
+	return &GORMMediaRepository{
+		dao: dao,
+	}
+}
+
+func (r *GORMMediaRepository) Create(ctx context.Context, m domain.Media) (int64, error) {
+	return r.dao.Insert(ctx, r.toEntity(m))
+}
+
+func (r *GORMMediaRepository) FindById(ctx context.Context, id int64) (domain.Media, error) {
+	m, err := r.dao.FindById(ctx, id)
+	if err != nil {
+		return domain.Media{}, err
+	}
+	return r.toDomain(m), nil
+}
+
+func (r *GORMMediaRepository) FindByIds(ctx context.Context, ids []int64) ([]domain.Media, error) {
+	ms, err := r.dao.FindByIds(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map[dao.Media, domain.Media](ms, func(idx int, src dao.Media) domain.Media {
+		return r.toDomain(src)
+	}), nil
+}
+
+func (r *GORMMediaRepository) toEntity(m domain.Media) dao.Media {
+	return dao.Media{
+		Id:          m.Id,
+		Uid:         m.Uid,
+		Key:         m.Key,
+		ThumbKey:    m.ThumbKey,
+		ContentType: m.ContentType,
+		Size:        m.Size,
+		Width:       m.Width,
+		Height:      m.Height,
+	}
+}
+
+func (r *GORMMediaRepository) toDomain(m dao.Media) domain.Media {
+	return domain.Media{
+		Id:          m.Id,
+		Uid:         m.Uid,
+		Key:         m.Key,
+		ThumbKey:    m.ThumbKey,
+		ContentType: m.ContentType,
+		Size:        m.Size,
+		Width:       m.Width,
+		Height:      m.Height,
+		Ctime:       time.UnixMilli(m.Ctime),
+	}
+}
@@ -0,0 +1,128 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./article_collaborator.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./article_collaborator.go -package=repomocks -destination=./mocks/article_collaborator.mock.go ArticleCollaboratorRepository
+//
+
+// Package repomocks is a generated GoMock package.
+package repomocks
+
+import (
+	context "context"
+	reflect "reflect"
+	domain "webook/internal/domain"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockArticleCollaboratorRepository is a mock of ArticleCollaboratorRepository interface.
+type MockArticleCollaboratorRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockArticleCollaboratorRepositoryMockRecorder
+}
+
+// MockArticleCollaboratorRepositoryMockRecorder is the mock recorder for MockArticleCollaboratorRepository.
+type MockArticleCollaboratorRepositoryMockRecorder struct {
+	mock *MockArticleCollaboratorRepository
+}
+
+// NewMockArticleCollaboratorRepository creates a new mock instance.
+func NewMockArticleCollaboratorRepository(ctrl *gomock.Controller) *MockArticleCollaboratorRepository {
+	mock := &MockArticleCollaboratorRepository{ctrl: ctrl}
+	mock.recorder = &MockArticleCollaboratorRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockArticleCollaboratorRepository) EXPECT() *MockArticleCollaboratorRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddAuditLog mocks base method.
+func (m *MockArticleCollaboratorRepository) AddAuditLog(ctx context.Context, l domain.ArticleAuditLog) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAuditLog", ctx, l)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddAuditLog indicates an expected call of AddAuditLog.
+func (mr *MockArticleCollaboratorRepositoryMockRecorder) AddAuditLog(ctx, l any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAuditLog", reflect.TypeOf((*MockArticleCollaboratorRepository)(nil).AddAuditLog), ctx, l)
+}
+
+// Delete mocks base method.
+func (m *MockArticleCollaboratorRepository) Delete(ctx context.Context, artId, uid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, artId, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockArticleCollaboratorRepositoryMockRecorder) Delete(ctx, artId, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockArticleCollaboratorRepository)(nil).Delete), ctx, artId, uid)
+}
+
+// Find mocks base method.
+func (m *MockArticleCollaboratorRepository) Find(ctx context.Context, artId, uid int64) (domain.ArticleCollaborator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Find", ctx, artId, uid)
+	ret0, _ := ret[0].(domain.ArticleCollaborator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Find indicates an expected call of Find.
+func (mr *MockArticleCollaboratorRepositoryMockRecorder) Find(ctx, artId, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockArticleCollaboratorRepository)(nil).Find), ctx, artId, uid)
+}
+
+// FindByArticle mocks base method.
+func (m *MockArticleCollaboratorRepository) FindByArticle(ctx context.Context, artId int64) ([]domain.ArticleCollaborator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByArticle", ctx, artId)
+	ret0, _ := ret[0].([]domain.ArticleCollaborator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByArticle indicates an expected call of FindByArticle.
+func (mr *MockArticleCollaboratorRepositoryMockRecorder) FindByArticle(ctx, artId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByArticle", reflect.TypeOf((*MockArticleCollaboratorRepository)(nil).FindByArticle), ctx, artId)
+}
+
+// ListAuditLogs mocks base method.
+func (m *MockArticleCollaboratorRepository) ListAuditLogs(ctx context.Context, artId int64, offset, limit int) ([]domain.ArticleAuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditLogs", ctx, artId, offset, limit)
+	ret0, _ := ret[0].([]domain.ArticleAuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditLogs indicates an expected call of ListAuditLogs.
+func (mr *MockArticleCollaboratorRepositoryMockRecorder) ListAuditLogs(ctx, artId, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditLogs", reflect.TypeOf((*MockArticleCollaboratorRepository)(nil).ListAuditLogs), ctx, artId, offset, limit)
+}
+
+// Save mocks base method.
+func (m *MockArticleCollaboratorRepository) Save(ctx context.Context, c domain.ArticleCollaborator) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockArticleCollaboratorRepositoryMockRecorder) Save(ctx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockArticleCollaboratorRepository)(nil).Save), ctx, c)
+}
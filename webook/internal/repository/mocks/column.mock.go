@@ -0,0 +1,186 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./column.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./column.go -package=repomocks -destination=./mocks/column.mock.go ColumnRepository
+//
+
+// Package repomocks is a generated GoMock package.
+package repomocks
+
+import (
+	context "context"
+	reflect "reflect"
+	domain "webook/internal/domain"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockColumnRepository is a mock of ColumnRepository interface.
+type MockColumnRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockColumnRepositoryMockRecorder
+}
+
+// MockColumnRepositoryMockRecorder is the mock recorder for MockColumnRepository.
+type MockColumnRepositoryMockRecorder struct {
+	mock *MockColumnRepository
+}
+
+// NewMockColumnRepository creates a new mock instance.
+func NewMockColumnRepository(ctrl *gomock.Controller) *MockColumnRepository {
+	mock := &MockColumnRepository{ctrl: ctrl}
+	mock.recorder = &MockColumnRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockColumnRepository) EXPECT() *MockColumnRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddArticle mocks base method.
+func (m *MockColumnRepository) AddArticle(ctx context.Context, colId, artId int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddArticle", ctx, colId, artId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddArticle indicates an expected call of AddArticle.
+func (mr *MockColumnRepositoryMockRecorder) AddArticle(ctx, colId, artId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddArticle", reflect.TypeOf((*MockColumnRepository)(nil).AddArticle), ctx, colId, artId)
+}
+
+// Create mocks base method.
+func (m *MockColumnRepository) Create(ctx context.Context, c domain.Column) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, c)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockColumnRepositoryMockRecorder) Create(ctx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockColumnRepository)(nil).Create), ctx, c)
+}
+
+// Delete mocks base method.
+func (m *MockColumnRepository) Delete(ctx context.Context, uid, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, uid, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockColumnRepositoryMockRecorder) Delete(ctx, uid, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockColumnRepository)(nil).Delete), ctx, uid, id)
+}
+
+// FindByAuthor mocks base method.
+func (m *MockColumnRepository) FindByAuthor(ctx context.Context, uid int64) ([]domain.Column, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByAuthor", ctx, uid)
+	ret0, _ := ret[0].([]domain.Column)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByAuthor indicates an expected call of FindByAuthor.
+func (mr *MockColumnRepositoryMockRecorder) FindByAuthor(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByAuthor", reflect.TypeOf((*MockColumnRepository)(nil).FindByAuthor), ctx, uid)
+}
+
+// FindById mocks base method.
+func (m *MockColumnRepository) FindById(ctx context.Context, id int64) (domain.Column, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", ctx, id)
+	ret0, _ := ret[0].(domain.Column)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockColumnRepositoryMockRecorder) FindById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockColumnRepository)(nil).FindById), ctx, id)
+}
+
+// FindNav mocks base method.
+func (m *MockColumnRepository) FindNav(ctx context.Context, artId int64) (domain.ColumnNav, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindNav", ctx, artId)
+	ret0, _ := ret[0].(domain.ColumnNav)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindNav indicates an expected call of FindNav.
+func (mr *MockColumnRepositoryMockRecorder) FindNav(ctx, artId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNav", reflect.TypeOf((*MockColumnRepository)(nil).FindNav), ctx, artId)
+}
+
+// ListPubArticles mocks base method.
+func (m *MockColumnRepository) ListPubArticles(ctx context.Context, colId int64, offset, limit int) ([]domain.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPubArticles", ctx, colId, offset, limit)
+	ret0, _ := ret[0].([]domain.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPubArticles indicates an expected call of ListPubArticles.
+func (mr *MockColumnRepositoryMockRecorder) ListPubArticles(ctx, colId, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPubArticles", reflect.TypeOf((*MockColumnRepository)(nil).ListPubArticles), ctx, colId, offset, limit)
+}
+
+// RemoveArticle mocks base method.
+func (m *MockColumnRepository) RemoveArticle(ctx context.Context, colId, artId int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveArticle", ctx, colId, artId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveArticle indicates an expected call of RemoveArticle.
+func (mr *MockColumnRepositoryMockRecorder) RemoveArticle(ctx, colId, artId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveArticle", reflect.TypeOf((*MockColumnRepository)(nil).RemoveArticle), ctx, colId, artId)
+}
+
+// Reorder mocks base method.
+func (m *MockColumnRepository) Reorder(ctx context.Context, colId int64, artIds []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reorder", ctx, colId, artIds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reorder indicates an expected call of Reorder.
+func (mr *MockColumnRepositoryMockRecorder) Reorder(ctx, colId, artIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reorder", reflect.TypeOf((*MockColumnRepository)(nil).Reorder), ctx, colId, artIds)
+}
+
+// Update mocks base method.
+func (m *MockColumnRepository) Update(ctx context.Context, c domain.Column) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockColumnRepositoryMockRecorder) Update(ctx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockColumnRepository)(nil).Update), ctx, c)
+}
@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./user_export.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./user_export.go -package=repomocks -destination=./mocks/user_export.mock.go UserExportRepository
+//
+
+// Package repomocks is a generated GoMock package.
+package repomocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+	domain "webook/internal/domain"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserExportRepository is a mock of UserExportRepository interface.
+type MockUserExportRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserExportRepositoryMockRecorder
+}
+
+// MockUserExportRepositoryMockRecorder is the mock recorder for MockUserExportRepository.
+type MockUserExportRepositoryMockRecorder struct {
+	mock *MockUserExportRepository
+}
+
+// NewMockUserExportRepository creates a new mock instance.
+func NewMockUserExportRepository(ctrl *gomock.Controller) *MockUserExportRepository {
+	mock := &MockUserExportRepository{ctrl: ctrl}
+	mock.recorder = &MockUserExportRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserExportRepository) EXPECT() *MockUserExportRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockUserExportRepository) Get(ctx context.Context, uid int64) (domain.UserExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, uid)
+	ret0, _ := ret[0].(domain.UserExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockUserExportRepositoryMockRecorder) Get(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockUserExportRepository)(nil).Get), ctx, uid)
+}
+
+// Set mocks base method.
+func (m *MockUserExportRepository) Set(ctx context.Context, export domain.UserExport, expiration time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", ctx, export, expiration)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockUserExportRepositoryMockRecorder) Set(ctx, export, expiration any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockUserExportRepository)(nil).Set), ctx, export, expiration)
+}
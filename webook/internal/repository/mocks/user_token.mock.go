@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./user_token.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./user_token.go -package=repomocks -destination=./mocks/user_token.mock.go UserTokenRepository
+//
+
+// Package repomocks is a generated GoMock package.
+package repomocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserTokenRepository is a mock of UserTokenRepository interface.
+type MockUserTokenRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserTokenRepositoryMockRecorder
+}
+
+// MockUserTokenRepositoryMockRecorder is the mock recorder for MockUserTokenRepository.
+type MockUserTokenRepositoryMockRecorder struct {
+	mock *MockUserTokenRepository
+}
+
+// NewMockUserTokenRepository creates a new mock instance.
+func NewMockUserTokenRepository(ctrl *gomock.Controller) *MockUserTokenRepository {
+	mock := &MockUserTokenRepository{ctrl: ctrl}
+	mock.recorder = &MockUserTokenRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserTokenRepository) EXPECT() *MockUserTokenRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Set mocks base method.
+func (m *MockUserTokenRepository) Set(ctx context.Context, biz string, uid int64, nonce string, expiration time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", ctx, biz, uid, nonce, expiration)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockUserTokenRepositoryMockRecorder) Set(ctx, biz, uid, nonce, expiration any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockUserTokenRepository)(nil).Set), ctx, biz, uid, nonce, expiration)
+}
+
+// Throttle mocks base method.
+func (m *MockUserTokenRepository) Throttle(ctx context.Context, biz, target string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Throttle", ctx, biz, target)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Throttle indicates an expected call of Throttle.
+func (mr *MockUserTokenRepositoryMockRecorder) Throttle(ctx, biz, target any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Throttle", reflect.TypeOf((*MockUserTokenRepository)(nil).Throttle), ctx, biz, target)
+}
+
+// Verify mocks base method.
+func (m *MockUserTokenRepository) Verify(ctx context.Context, biz string, uid int64, nonce string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", ctx, biz, uid, nonce)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockUserTokenRepositoryMockRecorder) Verify(ctx, biz, uid, nonce any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockUserTokenRepository)(nil).Verify), ctx, biz, uid, nonce)
+}
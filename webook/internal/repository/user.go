@@ -3,6 +3,9 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
+	"slices"
 	"time"
 	"webook/internal/domain"
 	"webook/internal/repository/cache"
@@ -12,6 +15,14 @@ import (
 var (
 	ErrDuplicateUser = dao.ErrDuplicateUser
 	ErrUserNotFound  = dao.ErrDataNotFound
+	// ErrTwoFactorCodeUsed 验证码或者恢复码已经用过了
+	ErrTwoFactorCodeUsed = dao.ErrTwoFactorCodeUsed
+	// ErrIdentityConflict 手机号、邮箱或者微信已经绑定在别的账号上
+	ErrIdentityConflict = errors.New("已经绑定了别的账号")
+	// ErrIdentityAlreadyBound 同一种登录方式只能绑定一个，换绑之前要先解绑
+	ErrIdentityAlreadyBound = errors.New("已经绑定过了")
+	// ErrLastIdentity 不能解绑最后一种登录方式，不然就再也登录不了了
+	ErrLastIdentity = errors.New("至少要保留一种登录方式")
 )
 
 //go:generate mockgen -source=./user.go -package=repomocks -destination=./mocks/user.mock.go UserRepository
@@ -22,6 +33,33 @@ type UserRepository interface {
 	FindByPhone(ctx context.Context, phone string) (domain.User, error)
 	UpdateUserById(ctx context.Context, userId int64, nickname string, birthday string, aboutMe string) error
 	FindByWechat(ctx context.Context, openId string) (domain.User, error)
+	UpdatePassword(ctx context.Context, userId int64, password string) error
+	MarkEmailVerified(ctx context.Context, userId int64) error
+	UpdateTwoFactor(ctx context.Context, userId int64, tf domain.TwoFactor) error
+	// UseTOTPStep 记下用过的验证码周期，step 不比上一次大的时候返回 ErrTwoFactorCodeUsed
+	UseTOTPStep(ctx context.Context, userId int64, step int64) error
+	// UseRecoveryCode 把恢复码从 old 换成 codes，old 已经变了的时候返回 ErrTwoFactorCodeUsed
+	UseRecoveryCode(ctx context.Context, userId int64, old []string, codes []string) error
+	UpdateRoles(ctx context.Context, userId int64, roles []string) error
+
+	BindPhone(ctx context.Context, userId int64, phone string) error
+	// BindEmail password 是加密之后的密码，邮箱验证通过之后才会生效
+	BindEmail(ctx context.Context, userId int64, email string, password string) error
+	BindWechat(ctx context.Context, userId int64, wechatInfo domain.WechatInfo) error
+	Unbind(ctx context.Context, userId int64, typ domain.IdentityType) error
+	// Merge 把 source 合并到 target 上，两边不能有同一种登录方式
+	Merge(ctx context.Context, targetId int64, sourceId int64) error
+
+	// FindByIdentity 根据第三方账号查找用户
+	FindByIdentity(ctx context.Context, provider string, subject string) (domain.User, error)
+	// CreateWithIdentity 第三方账号第一次登录的时候创建用户
+	CreateWithIdentity(ctx context.Context, identity domain.ExternalIdentity) (domain.User, error)
+	BindIdentity(ctx context.Context, userId int64, identity domain.ExternalIdentity) error
+
+	// UpdateDeleteAt 零值表示取消注销
+	UpdateDeleteAt(ctx context.Context, userId int64, deleteAt time.Time) error
+	FindDueDeletions(ctx context.Context, now time.Time, limit int) ([]domain.User, error)
+	Anonymize(ctx context.Context, userId int64, nickname string) error
 }
 
 type CachedUserRepository struct {
@@ -63,11 +101,10 @@ func (ur *CachedUserRepository) FindById(ctx context.Context, id int64) (domain.
 	if err == nil {
 		return du, nil
 	}
-	u, err := ur.ud.FindById(ctx, id)
+	du, err = ur.findWithIdentities(ctx, id)
 	if err != nil {
 		return domain.User{}, err
 	}
-	du = ur.toDomain(u)
 	_ = ur.uc.Set(ctx, du)
 	return du, nil
 }
@@ -92,20 +129,352 @@ func (ur *CachedUserRepository) FindByWechat(ctx context.Context, openId string)
 	return ur.toDomain(u), nil
 }
 
+func (ur *CachedUserRepository) UpdatePassword(ctx context.Context, userId int64, password string) error {
+	err := ur.ud.UpdatePassword(ctx, userId, password)
+	if err != nil {
+		return err
+	}
+	return ur.uc.Del(ctx, userId)
+}
+
+func (ur *CachedUserRepository) MarkEmailVerified(ctx context.Context, userId int64) error {
+	err := ur.ud.UpdateEmailVerified(ctx, userId, true)
+	if err != nil {
+		return err
+	}
+	return ur.uc.Del(ctx, userId)
+}
+
+func (ur *CachedUserRepository) UpdateTwoFactor(ctx context.Context, userId int64, tf domain.TwoFactor) error {
+	codes, err := json.Marshal(tf.RecoveryCodes)
+	if err != nil {
+		return err
+	}
+	err = ur.ud.UpdateTwoFactor(ctx, userId, tf.Secret, tf.Enabled, string(codes))
+	if err != nil {
+		return err
+	}
+	return ur.uc.Del(ctx, userId)
+}
+
+func (ur *CachedUserRepository) UseTOTPStep(ctx context.Context, userId int64, step int64) error {
+	err := ur.ud.UseTOTPStep(ctx, userId, step)
+	if err != nil {
+		return err
+	}
+	return ur.uc.Del(ctx, userId)
+}
+
+func (ur *CachedUserRepository) UseRecoveryCode(ctx context.Context, userId int64, old []string, codes []string) error {
+	oldVal, err := json.Marshal(old)
+	if err != nil {
+		return err
+	}
+	val, err := json.Marshal(codes)
+	if err != nil {
+		return err
+	}
+	err = ur.ud.UpdateRecoveryCodes(ctx, userId, string(oldVal), string(val))
+	if err != nil {
+		return err
+	}
+	return ur.uc.Del(ctx, userId)
+}
+
+func (ur *CachedUserRepository) UpdateRoles(ctx context.Context, userId int64, roles []string) error {
+	val, err := json.Marshal(roles)
+	if err != nil {
+		return err
+	}
+	err = ur.ud.UpdateRoles(ctx, userId, string(val))
+	if err != nil {
+		return err
+	}
+	return ur.uc.Del(ctx, userId)
+}
+
+func (ur *CachedUserRepository) UpdateDeleteAt(ctx context.Context, userId int64, deleteAt time.Time) error {
+	var val int64
+	if !deleteAt.IsZero() {
+		val = deleteAt.UnixMilli()
+	}
+	return ur.afterUpdate(ctx, userId, ur.ud.UpdateDeleteAt(ctx, userId, val))
+}
+
+func (ur *CachedUserRepository) FindDueDeletions(ctx context.Context, now time.Time, limit int) ([]domain.User, error) {
+	users, err := ur.ud.FindDueDeletions(ctx, now.UnixMilli(), limit)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]domain.User, 0, len(users))
+	for _, u := range users {
+		res = append(res, ur.toDomain(u))
+	}
+	return res, nil
+}
+
+func (ur *CachedUserRepository) Anonymize(ctx context.Context, userId int64, nickname string) error {
+	return ur.afterUpdate(ctx, userId, ur.ud.Anonymize(ctx, userId, nickname))
+}
+
+func (ur *CachedUserRepository) BindPhone(ctx context.Context, userId int64, phone string) error {
+	err := ur.checkBind(ctx, userId, func(u dao.User) string {
+		return u.Phone.String
+	}, phone, ur.ud.FindByPhone)
+	if err != nil {
+		return err
+	}
+	return ur.afterUpdate(ctx, userId, ur.ud.UpdatePhone(ctx, userId, phone))
+}
+
+func (ur *CachedUserRepository) BindEmail(ctx context.Context, userId int64, email string, password string) error {
+	err := ur.checkBind(ctx, userId, func(u dao.User) string {
+		return u.Email.String
+	}, email, ur.ud.FindByEmail)
+	if err != nil {
+		return err
+	}
+	return ur.afterUpdate(ctx, userId, ur.ud.UpdateEmail(ctx, userId, email, password))
+}
+
+func (ur *CachedUserRepository) BindWechat(ctx context.Context, userId int64, wechatInfo domain.WechatInfo) error {
+	err := ur.checkBind(ctx, userId, func(u dao.User) string {
+		return u.WechatOpenId.String
+	}, wechatInfo.OpenId, ur.ud.FindByWechat)
+	if err != nil {
+		return err
+	}
+	return ur.afterUpdate(ctx, userId,
+		ur.ud.UpdateWechat(ctx, userId, wechatInfo.OpenId, wechatInfo.UnionId))
+}
+
+// checkBind 绑定之前检查：自己没有绑定过这种登录方式，别人也没有绑定这个值。
+// 重复绑定同一个值也算已经绑定过，不然可以借着重新绑定邮箱绕过旧密码改掉密码
+func (ur *CachedUserRepository) checkBind(ctx context.Context, userId int64,
+	current func(u dao.User) string, val string,
+	find func(ctx context.Context, val string) (dao.User, error)) error {
+	u, err := ur.ud.FindById(ctx, userId)
+	if err != nil {
+		return err
+	}
+	if current(u) != "" {
+		return ErrIdentityAlreadyBound
+	}
+	_, err = find(ctx, val)
+	switch err {
+	case nil:
+		return ErrIdentityConflict
+	case ErrUserNotFound:
+		return nil
+	default:
+		return err
+	}
+}
+
+func (ur *CachedUserRepository) Unbind(ctx context.Context, userId int64, typ domain.IdentityType) error {
+	u, err := ur.findWithIdentities(ctx, userId)
+	if err != nil {
+		return err
+	}
+	identities := u.Identities()
+	if !slices.Contains(identities, typ) {
+		return nil
+	}
+	if len(identities) <= 1 {
+		return ErrLastIdentity
+	}
+	switch typ {
+	case domain.IdentityPhone:
+		err = ur.ud.UpdatePhone(ctx, userId, "")
+	case domain.IdentityEmail:
+		err = ur.ud.UpdateEmail(ctx, userId, "", "")
+	case domain.IdentityWechat:
+		err = ur.ud.UpdateWechat(ctx, userId, "", "")
+	default:
+		// 其余的都是通用的第三方账号
+		err = ur.ud.DeleteIdentity(ctx, userId, string(typ))
+	}
+	return ur.afterUpdate(ctx, userId, err)
+}
+
+func (ur *CachedUserRepository) Merge(ctx context.Context, targetId int64, sourceId int64) error {
+	target, err := ur.findWithIdentities(ctx, targetId)
+	if err != nil {
+		return err
+	}
+	source, err := ur.findWithIdentities(ctx, sourceId)
+	if err != nil {
+		return err
+	}
+	targetIdentities := target.Identities()
+	for _, typ := range source.Identities() {
+		if slices.Contains(targetIdentities, typ) {
+			return ErrIdentityConflict
+		}
+	}
+	// 没有密码的邮箱不算登录方式，但是邮箱本身也不能冲突
+	if target.Email != "" && source.Email != "" {
+		return ErrIdentityConflict
+	}
+	err = ur.ud.Merge(ctx, targetId, sourceId)
+	if err == ErrDuplicateUser {
+		return ErrIdentityConflict
+	}
+	if err != nil {
+		return err
+	}
+	err = ur.uc.Del(ctx, sourceId)
+	if err != nil {
+		return err
+	}
+	return ur.uc.Del(ctx, targetId)
+}
+
+func (ur *CachedUserRepository) FindByIdentity(ctx context.Context,
+	provider string, subject string) (domain.User, error) {
+	u, err := ur.ud.FindByIdentity(ctx, provider, subject)
+	if err != nil {
+		return domain.User{}, err
+	}
+	return ur.findWithIdentities(ctx, u.Id)
+}
+
+func (ur *CachedUserRepository) CreateWithIdentity(ctx context.Context,
+	identity domain.ExternalIdentity) (domain.User, error) {
+	uid, err := ur.ud.InsertWithIdentity(ctx, ur.identityToEntity(0, identity))
+	if err != nil {
+		return domain.User{}, err
+	}
+	return ur.findWithIdentities(ctx, uid)
+}
+
+func (ur *CachedUserRepository) BindIdentity(ctx context.Context, userId int64,
+	identity domain.ExternalIdentity) error {
+	u, err := ur.findWithIdentities(ctx, userId)
+	if err != nil {
+		return err
+	}
+	for _, ei := range u.ExternalIdentities {
+		if ei.Provider != identity.Provider {
+			continue
+		}
+		if ei.Subject == identity.Subject {
+			return nil
+		}
+		return ErrIdentityAlreadyBound
+	}
+	_, err = ur.ud.FindByIdentity(ctx, identity.Provider, identity.Subject)
+	switch err {
+	case nil:
+		return ErrIdentityConflict
+	case ErrUserNotFound:
+	default:
+		return err
+	}
+	return ur.afterUpdate(ctx, userId,
+		ur.ud.InsertIdentity(ctx, ur.identityToEntity(userId, identity)))
+}
+
+// findWithIdentities 直接查数据库，带上所有第三方账号
+func (ur *CachedUserRepository) findWithIdentities(ctx context.Context, id int64) (domain.User, error) {
+	u, err := ur.ud.FindById(ctx, id)
+	if err != nil {
+		return domain.User{}, err
+	}
+	identities, err := ur.ud.FindIdentities(ctx, id)
+	if err != nil {
+		return domain.User{}, err
+	}
+	du := ur.toDomain(u)
+	for _, ei := range identities {
+		du.ExternalIdentities = append(du.ExternalIdentities, domain.ExternalIdentity{
+			Provider: ei.Provider,
+			Subject:  ei.Subject,
+			UnionId:  ei.UnionId,
+			Email:    ei.Email,
+			Nickname: ei.Nickname,
+		})
+	}
+	return du, nil
+}
+
+func (ur *CachedUserRepository) identityToEntity(uid int64, ei domain.ExternalIdentity) dao.UserIdentity {
+	return dao.UserIdentity{
+		Uid:      uid,
+		Provider: ei.Provider,
+		Subject:  ei.Subject,
+		UnionId:  ei.UnionId,
+		Email:    ei.Email,
+		Nickname: ei.Nickname,
+	}
+}
+
+// afterUpdate 唯一索引冲突说明并发绑定了同一个值，成功了就删掉缓存
+func (ur *CachedUserRepository) afterUpdate(ctx context.Context, userId int64, err error) error {
+	if err == ErrDuplicateUser {
+		return ErrIdentityConflict
+	}
+	if err != nil {
+		return err
+	}
+	return ur.uc.Del(ctx, userId)
+}
+
 func (ur *CachedUserRepository) toDomain(user dao.User) domain.User {
+	var codes []string
+	if user.RecoveryCodes != "" {
+		// 格式不对就当作没有恢复码，不影响其他字段
+		_ = json.Unmarshal([]byte(user.RecoveryCodes), &codes)
+	}
+	var roles []string
+	if user.Roles != "" {
+		_ = json.Unmarshal([]byte(user.Roles), &roles)
+	}
 	return domain.User{
-		Id:       user.Id,
-		Email:    user.Email.String,
-		Phone:    user.Phone.String,
-		Password: user.Password,
-		Nickname: user.Nickname,
-		Birthday: user.Birthday,
-		AboutMe:  user.AboutMe,
-		Ctime:    time.UnixMilli(user.Ctime),
+		Id:            user.Id,
+		Email:         user.Email.String,
+		EmailVerified: user.EmailVerified,
+		Phone:         user.Phone.String,
+		Password:      user.Password,
+		Nickname:      user.Nickname,
+		Birthday:      user.Birthday,
+		AboutMe:       user.AboutMe,
+		Ctime:         time.UnixMilli(user.Ctime),
 		WechatInfo: domain.WechatInfo{
 			OpenId:  user.WechatOpenId.String,
 			UnionId: user.WechatOpenId.String,
 		},
+		ExternalIdentities: ur.wechatIdentities(user),
+		TwoFactor: domain.TwoFactor{
+			Secret:        user.TOTPSecret,
+			Enabled:       user.TOTPEnabled,
+			RecoveryCodes: codes,
+			LastStep:      user.TOTPLastStep,
+		},
+		Roles:    roles,
+		DeleteAt: ur.toTime(user.DeleteAt),
+		Deleted:  user.Deleted,
+	}
+}
+
+func (ur *CachedUserRepository) toTime(millis int64) time.Time {
+	if millis == 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(millis)
+}
+
+// wechatIdentities 微信的信息存在用户表上，也当作一个第三方账号
+func (ur *CachedUserRepository) wechatIdentities(user dao.User) []domain.ExternalIdentity {
+	if !user.WechatOpenId.Valid {
+		return nil
+	}
+	return []domain.ExternalIdentity{
+		{
+			Provider: string(domain.IdentityWechat),
+			Subject:  user.WechatOpenId.String,
+			UnionId:  user.WechatUnionId.String,
+		},
 	}
 }
 
@@ -120,10 +489,11 @@ func (ur *CachedUserRepository) toEntity(u domain.User) dao.User {
 			String: u.Email,
 			Valid:  u.Email != "",
 		},
-		Password: u.Password,
-		Nickname: u.Nickname,
-		Birthday: u.Birthday,
-		AboutMe:  u.AboutMe,
+		EmailVerified: u.EmailVerified,
+		Password:      u.Password,
+		Nickname:      u.Nickname,
+		Birthday:      u.Birthday,
+		AboutMe:       u.AboutMe,
 
 		WechatOpenId: sql.NullString{
 			String: u.WechatInfo.OpenId,
@@ -133,5 +503,7 @@ func (ur *CachedUserRepository) toEntity(u domain.User) dao.User {
 			String: u.WechatInfo.UnionId,
 			Valid:  u.WechatInfo.UnionId != "",
 		},
+		TOTPSecret:  u.TwoFactor.Secret,
+		TOTPEnabled: u.TwoFactor.Enabled,
 	}
 }
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"webook/internal/domain"
+	"webook/internal/repository/cache"
+)
+
+//go:generate mockgen -source=./user_export.go -package=repomocks -destination=./mocks/user_export.mock.go UserExportRepository
+type UserExportRepository interface {
+	// Get 没有导出任务的时候返回 Status 为 UserExportStatusUnknown 的结果
+	Get(ctx context.Context, uid int64) (domain.UserExport, error)
+	// Set expiration 之后任务就会被忘掉，用户需要重新导出
+	Set(ctx context.Context, export domain.UserExport, expiration time.Duration) error
+}
+
+type CacheUserExportRepository struct {
+	cache cache.UserExportCache
+}
+
+func NewUserExportRepository(cache cache.UserExportCache) UserExportRepository {
+	return &CacheUserExportRepository{
+		cache: cache,
+	}
+}
+
+func (r *CacheUserExportRepository) Get(ctx context.Context, uid int64) (domain.UserExport, error) {
+	res, err := r.cache.Get(ctx, uid)
+	if err == cache.ErrKeyNotExist {
+		return domain.UserExport{Uid: uid}, nil
+	}
+	return res, err
+}
+
+func (r *CacheUserExportRepository) Set(ctx context.Context, export domain.UserExport, expiration time.Duration) error {
+	return r.cache.Set(ctx, export, expiration)
+}
@@ -61,6 +61,7 @@ func TestCachedUserRepository_FindById(t *testing.T) {
 					},
 					Ctime: 101,
 				}, nil)
+				ud.EXPECT().FindIdentities(gomock.Any(), uid).Return(nil, nil)
 
 				return uc, ud
 			},
@@ -156,6 +157,7 @@ func TestCachedUserRepository_FindById(t *testing.T) {
 					},
 					Ctime: 101,
 				}, nil)
+				ud.EXPECT().FindIdentities(gomock.Any(), uid).Return(nil, nil)
 				return uc, ud
 			},
 			uid: 123,
@@ -186,3 +188,197 @@ func TestCachedUserRepository_FindById(t *testing.T) {
 		})
 	}
 }
+
+func TestCachedUserRepository_BindPhone(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		mock func(ctrl *gomock.Controller) (cache.UserCache, dao.UserDAO)
+
+		wantErr error
+	}{
+		{
+			name: "绑定成功",
+			mock: func(ctrl *gomock.Controller) (cache.UserCache, dao.UserDAO) {
+				uc := cachemocks.NewMockUserCache(ctrl)
+				ud := daomocks.NewMockUserDAO(ctrl)
+				ud.EXPECT().FindById(gomock.Any(), int64(123)).Return(dao.User{Id: 123}, nil)
+				ud.EXPECT().FindByPhone(gomock.Any(), "15212345678").
+					Return(dao.User{}, dao.ErrDataNotFound)
+				ud.EXPECT().UpdatePhone(gomock.Any(), int64(123), "15212345678").Return(nil)
+				uc.EXPECT().Del(gomock.Any(), int64(123)).Return(nil)
+				return uc, ud
+			},
+		},
+		{
+			name: "手机号已经绑定了别的账号",
+			mock: func(ctrl *gomock.Controller) (cache.UserCache, dao.UserDAO) {
+				uc := cachemocks.NewMockUserCache(ctrl)
+				ud := daomocks.NewMockUserDAO(ctrl)
+				ud.EXPECT().FindById(gomock.Any(), int64(123)).Return(dao.User{Id: 123}, nil)
+				ud.EXPECT().FindByPhone(gomock.Any(), "15212345678").
+					Return(dao.User{Id: 456}, nil)
+				return uc, ud
+			},
+			wantErr: ErrIdentityConflict,
+		},
+		{
+			name: "自己已经绑定了别的手机号",
+			mock: func(ctrl *gomock.Controller) (cache.UserCache, dao.UserDAO) {
+				uc := cachemocks.NewMockUserCache(ctrl)
+				ud := daomocks.NewMockUserDAO(ctrl)
+				ud.EXPECT().FindById(gomock.Any(), int64(123)).Return(dao.User{
+					Id:    123,
+					Phone: sql.NullString{String: "15200000000", Valid: true},
+				}, nil)
+				return uc, ud
+			},
+			wantErr: ErrIdentityAlreadyBound,
+		},
+		{
+			name: "并发绑定，唯一索引冲突",
+			mock: func(ctrl *gomock.Controller) (cache.UserCache, dao.UserDAO) {
+				uc := cachemocks.NewMockUserCache(ctrl)
+				ud := daomocks.NewMockUserDAO(ctrl)
+				ud.EXPECT().FindById(gomock.Any(), int64(123)).Return(dao.User{Id: 123}, nil)
+				ud.EXPECT().FindByPhone(gomock.Any(), "15212345678").
+					Return(dao.User{}, dao.ErrDataNotFound)
+				ud.EXPECT().UpdatePhone(gomock.Any(), int64(123), "15212345678").
+					Return(dao.ErrDuplicateUser)
+				return uc, ud
+			},
+			wantErr: ErrIdentityConflict,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			uc, ud := tc.mock(ctrl)
+			repo := NewUserRepository(ud, uc)
+			err := repo.BindPhone(context.Background(), 123, "15212345678")
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+func TestCachedUserRepository_BindEmail(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		mock func(ctrl *gomock.Controller) (cache.UserCache, dao.UserDAO)
+
+		wantErr error
+	}{
+		{
+			name: "绑定成功，密码等验证之后再生效",
+			mock: func(ctrl *gomock.Controller) (cache.UserCache, dao.UserDAO) {
+				uc := cachemocks.NewMockUserCache(ctrl)
+				ud := daomocks.NewMockUserDAO(ctrl)
+				ud.EXPECT().FindById(gomock.Any(), int64(123)).Return(dao.User{Id: 123}, nil)
+				ud.EXPECT().FindByEmail(gomock.Any(), "a@qq.com").
+					Return(dao.User{}, dao.ErrDataNotFound)
+				ud.EXPECT().UpdateEmail(gomock.Any(), int64(123), "a@qq.com", "hash").Return(nil)
+				uc.EXPECT().Del(gomock.Any(), int64(123)).Return(nil)
+				return uc, ud
+			},
+		},
+		{
+			name: "重复绑定同一个邮箱",
+			mock: func(ctrl *gomock.Controller) (cache.UserCache, dao.UserDAO) {
+				uc := cachemocks.NewMockUserCache(ctrl)
+				ud := daomocks.NewMockUserDAO(ctrl)
+				ud.EXPECT().FindById(gomock.Any(), int64(123)).Return(dao.User{
+					Id:    123,
+					Email: sql.NullString{String: "a@qq.com", Valid: true},
+				}, nil)
+				return uc, ud
+			},
+			wantErr: ErrIdentityAlreadyBound,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			uc, ud := tc.mock(ctrl)
+			repo := NewUserRepository(ud, uc)
+			err := repo.BindEmail(context.Background(), 123, "a@qq.com", "hash")
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+func TestCachedUserRepository_Unbind(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		mock func(ctrl *gomock.Controller) (cache.UserCache, dao.UserDAO)
+		typ  domain.IdentityType
+
+		wantErr error
+	}{
+		{
+			name: "还有别的登录方式",
+			mock: func(ctrl *gomock.Controller) (cache.UserCache, dao.UserDAO) {
+				uc := cachemocks.NewMockUserCache(ctrl)
+				ud := daomocks.NewMockUserDAO(ctrl)
+				ud.EXPECT().FindById(gomock.Any(), int64(123)).Return(dao.User{
+					Id:           123,
+					Phone:        sql.NullString{String: "15212345678", Valid: true},
+					WechatOpenId: sql.NullString{String: "open_id", Valid: true},
+				}, nil)
+				ud.EXPECT().FindIdentities(gomock.Any(), int64(123)).Return(nil, nil)
+				ud.EXPECT().UpdatePhone(gomock.Any(), int64(123), "").Return(nil)
+				uc.EXPECT().Del(gomock.Any(), int64(123)).Return(nil)
+				return uc, ud
+			},
+			typ: domain.IdentityPhone,
+		},
+		{
+			name: "最后一种登录方式",
+			mock: func(ctrl *gomock.Controller) (cache.UserCache, dao.UserDAO) {
+				uc := cachemocks.NewMockUserCache(ctrl)
+				ud := daomocks.NewMockUserDAO(ctrl)
+				// 没有密码的邮箱不算登录方式
+				ud.EXPECT().FindById(gomock.Any(), int64(123)).Return(dao.User{
+					Id:    123,
+					Email: sql.NullString{String: "123@qq.com", Valid: true},
+					Phone: sql.NullString{String: "15212345678", Valid: true},
+				}, nil)
+				ud.EXPECT().FindIdentities(gomock.Any(), int64(123)).Return(nil, nil)
+				return uc, ud
+			},
+			typ:     domain.IdentityPhone,
+			wantErr: ErrLastIdentity,
+		},
+		{
+			name: "解绑通用的第三方账号",
+			mock: func(ctrl *gomock.Controller) (cache.UserCache, dao.UserDAO) {
+				uc := cachemocks.NewMockUserCache(ctrl)
+				ud := daomocks.NewMockUserDAO(ctrl)
+				ud.EXPECT().FindById(gomock.Any(), int64(123)).Return(dao.User{
+					Id:    123,
+					Phone: sql.NullString{String: "15212345678", Valid: true},
+				}, nil)
+				ud.EXPECT().FindIdentities(gomock.Any(), int64(123)).Return([]dao.UserIdentity{
+					{Uid: 123, Provider: "github", Subject: "42"},
+				}, nil)
+				ud.EXPECT().DeleteIdentity(gomock.Any(), int64(123), "github").Return(nil)
+				uc.EXPECT().Del(gomock.Any(), int64(123)).Return(nil)
+				return uc, ud
+			},
+			typ: domain.IdentityType("github"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			uc, ud := tc.mock(ctrl)
+			repo := NewUserRepository(ud, uc)
+			err := repo.Unbind(context.Background(), 123, tc.typ)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
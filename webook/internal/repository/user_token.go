@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+	"time"
+	"webook/internal/repository/cache"
+)
+
+var (
+	ErrUserTokenSendTooMany = cache.ErrUserTokenSendTooMany
+	ErrUserTokenInvalid     = cache.ErrUserTokenInvalid
+)
+
+//go:generate mockgen -source=./user_token.go -package=repomocks -destination=./mocks/user_token.mock.go UserTokenRepository
+type UserTokenRepository interface {
+	Set(ctx context.Context, biz string, uid int64, nonce string, expiration time.Duration) error
+	Verify(ctx context.Context, biz string, uid int64, nonce string) error
+	// Throttle 按照 target 限制发送频率，太频繁返回 ErrUserTokenSendTooMany
+	Throttle(ctx context.Context, biz string, target string) error
+}
+
+type CacheUserTokenRepository struct {
+	cache cache.UserTokenCache
+}
+
+func NewUserTokenRepository(cache cache.UserTokenCache) UserTokenRepository {
+	return &CacheUserTokenRepository{
+		cache: cache,
+	}
+}
+
+func (r *CacheUserTokenRepository) Set(ctx context.Context, biz string, uid int64,
+	nonce string, expiration time.Duration) error {
+	return r.cache.Set(ctx, biz, uid, nonce, expiration)
+}
+
+func (r *CacheUserTokenRepository) Verify(ctx context.Context, biz string, uid int64, nonce string) error {
+	return r.cache.Verify(ctx, biz, uid, nonce)
+}
+
+func (r *CacheUserTokenRepository) Throttle(ctx context.Context, biz string, target string) error {
+	return r.cache.Throttle(ctx, biz, target)
+}
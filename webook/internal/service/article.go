@@ -2,73 +2,515 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 	"time"
 	"webook/internal/domain"
 	"webook/internal/events/article"
 	"webook/internal/repository"
+	"webook/internal/service/moderation"
+	"webook/pkg/diffx"
 	"webook/pkg/logger"
+	"webook/pkg/mdx"
 )
 
+var (
+	// ErrArticleRevisionNotFound 别人的历史版本也当作不存在
+	ErrArticleRevisionNotFound = repository.ErrArticleRevisionNotFound
+	ErrArticleRevisionMismatch = errors.New("只能比较同一篇文章的历史版本")
+	ErrInvalidPublishAt        = errors.New("定时发表的时间必须晚于当前时间")
+	// ErrArticleCoverNotFound 封面不存在，或者不是作者自己上传的
+	ErrArticleCoverNotFound = errors.New("封面不存在")
+	// ErrArticleVersionConflict 别的协作者先保存了，要刷新之后再改
+	ErrArticleVersionConflict = repository.ErrArticleVersionConflict
+	ErrArticleForbidden       = errors.New("没有权限操作这篇文章")
+	ErrInvalidCollaborator    = errors.New("协作者或者权限不对")
+	ErrCollaboratorNotFound   = repository.ErrCollaboratorNotFound
+	// ErrArticlePendingReview 没有通过自动审核，要等人工审核，不算失败
+	ErrArticlePendingReview = errors.New("文章需要人工审核，审核通过之后会自动发表")
+)
+
+// scheduledBatchSize 定时发表每次从数据库里面捞出来的文章数量
+const scheduledBatchSize = 100
+
+// maxListLimit 分页查询一次最多返回的条数
+const maxListLimit = 100
+
+// clampPage 不信任调用方传进来的分页参数，避免一次查出整张表
+func clampPage(offset, limit int) (int, int) {
+	if offset < 0 {
+		offset = 0
+	}
+	if limit <= 0 || limit > maxListLimit {
+		limit = maxListLimit
+	}
+	return offset, limit
+}
+
 //go:generate mockgen -source=./article.go -package=svcmocks -destination=./mocks/article.mock.go ArticleService
 type ArticleService interface {
 	Save(ctx context.Context, art domain.Article) (int64, error)
+	// Publish 没有通过自动审核的时候文章会进入人工审核，
+	// 这个时候返回文章 ID 和 ErrArticlePendingReview
 	Publish(ctx context.Context, art domain.Article) (int64, error)
+	// Schedule 保存文章并且在 art.PublishAt 自动发表，
+	// 在这之前再次保存或者直接发表都会取消定时发表。
+	// 和 Publish 一样要先经过审核
+	Schedule(ctx context.Context, art domain.Article) (int64, error)
+	// PublishDue 发表所有到时间的定时发表的文章，返回发表成功的数量
+	PublishDue(ctx context.Context, now time.Time) (int, error)
 	Withdraw(ctx context.Context, uid int64, id int64) error
-	GetByAuthor(ctx context.Context, uid int64, offset int, limit int) ([]domain.Article, error)
+	// GetByAuthor 游标是上一页的最后一条，零值就是第一页。
+	// colId 不为 0 的时候只返回这个专栏里面的文章
+	GetByAuthor(ctx context.Context, uid int64, colId int64, cursor domain.ArticleCursor, limit int) ([]domain.Article, error)
 	GetById(ctx context.Context, id int64) (domain.Article, error)
 	GetPubById(ctx context.Context, id int64, uid int64) (domain.Article, error)
-	ListPub(ctx context.Context, start time.Time, offset, limit int) ([]domain.Article, error)
+	// GetPubByIds 批量查询已经发表的文章，只有 ID 和标题，不会记录阅读
+	GetPubByIds(ctx context.Context, ids []int64) ([]domain.Article, error)
+	ListPub(ctx context.Context, cursor domain.ArticleCursor, limit int) ([]domain.Article, error)
+	// ListPubByAuthor 作者主页和作者的订阅源用
+	ListPubByAuthor(ctx context.Context, uid int64, cursor domain.ArticleCursor, limit int) ([]domain.Article, error)
+
+	// ListRevisions 按照时间倒序列出历史版本，每次保存和发表都会产生一个
+	ListRevisions(ctx context.Context, uid int64, artId int64, offset, limit int) ([]domain.ArticleRevision, error)
+	GetRevision(ctx context.Context, uid int64, id int64) (domain.ArticleRevision, error)
+	// DiffRevisions 比较同一篇文章的两个历史版本
+	DiffRevisions(ctx context.Context, uid int64, fromId int64, toId int64) (domain.ArticleDiff, error)
+	// RestoreRevision 把历史版本恢复成当前的草稿，已经发表的内容不受影响
+	RestoreRevision(ctx context.Context, uid int64, id int64) (int64, error)
+
+	// Role uid 对草稿的权限，作者本人是 ArticleRoleOwner
+	Role(ctx context.Context, uid int64, art domain.Article) (domain.ArticleRole, error)
+	// Invite 只有作者能邀请协作者，已经是协作者了就修改权限
+	Invite(ctx context.Context, uid int64, c domain.ArticleCollaborator) error
+	RemoveCollaborator(ctx context.Context, uid int64, artId int64, collaborator int64) error
+	// ListCollaborators 协作者也能看
+	ListCollaborators(ctx context.Context, uid int64, artId int64) ([]domain.ArticleCollaborator, error)
+	// ListAuditLogs 按照时间倒序，协作者也能看
+	ListAuditLogs(ctx context.Context, uid int64, artId int64, offset, limit int) ([]domain.ArticleAuditLog, error)
 }
 
 type articleService struct {
-	ar       repository.ArticleRepository
-	producer article.Producer
-	l        logger.LoggerV1
+	ar        repository.ArticleRepository
+	collab    repository.ArticleCollaboratorRepository
+	media     MediaService
+	review    repository.ArticleReviewRepository
+	moderator moderation.Moderator
+	producer  article.Producer
+	l         logger.LoggerV1
 }
 
 func NewArticleService(ar repository.ArticleRepository,
+	collab repository.ArticleCollaboratorRepository,
+	media MediaService,
+	review repository.ArticleReviewRepository,
+	moderator moderation.Moderator,
 	producer article.Producer,
 	l logger.LoggerV1) ArticleService {
 	return &articleService{
-		ar:       ar,
-		producer: producer,
-		l:        l,
+		ar:        ar,
+		collab:    collab,
+		media:     media,
+		review:    review,
+		moderator: moderator,
+		producer:  producer,
+		l:         l,
 	}
 }
 
+// Save art.Author 是保存的人，可能是作者，也可能是有编辑权限的协作者
 func (as *articleService) Save(ctx context.Context, art domain.Article) (int64, error) {
 	art.Status = domain.ArticleStatusUnpublished
+	editor := art.Author.Id
+	var cur domain.Article
 	if art.Id > 0 {
+		var err error
+		cur, err = as.ar.GetById(ctx, art.Id)
+		if err != nil {
+			return 0, err
+		}
+		role, err := as.Role(ctx, editor, cur)
+		if err != nil {
+			return 0, err
+		}
+		if !role.CanEdit() {
+			as.l.Warn("非法修改文章",
+				logger.Int64("uid", editor),
+				logger.Int64("aid", art.Id))
+			return 0, ErrArticleForbidden
+		}
+		// 协作者改的也还是作者的文章
+		art.Author = cur.Author
+	}
+	// 草稿只检查封面，正文里面的引用等发表的时候再处理
+	if art.Cover.Id > 0 {
+		media, err := as.media.GetByIds(ctx, art.Author.Id, []int64{art.Cover.Id})
+		if err != nil {
+			return 0, err
+		}
+		if _, ok := media[art.Cover.Id]; !ok {
+			return 0, ErrArticleCoverNotFound
+		}
+	}
+	id := art.Id
+	if id > 0 {
 		err := as.ar.Update(ctx, art)
-		return art.Id, err
+		if err != nil {
+			return id, err
+		}
+	} else {
+		var err error
+		id, err = as.ar.Create(ctx, art)
+		if err != nil {
+			return 0, err
+		}
 	}
-	return as.ar.Create(ctx, art)
+	as.audit(ctx, domain.ArticleAuditLog{
+		ArticleId: id,
+		Uid:       editor,
+		Action:    domain.ArticleAuditActionSave,
+		Version:   art.Version + 1,
+		Detail:    strings.Join(changedFields(cur, art), ","),
+	})
+	return id, nil
+}
+
+// changedFields 保存的时候改了哪些字段，新建的时候 old 是零值
+func changedFields(old domain.Article, art domain.Article) []string {
+	var res []string
+	if old.Title != art.Title {
+		res = append(res, "title")
+	}
+	if old.Content != art.Content {
+		res = append(res, "content")
+	}
+	if old.Cover.Id != art.Cover.Id {
+		res = append(res, "cover")
+	}
+	return res
+}
+
+// audit 操作记录失败了不影响正常的业务
+func (as *articleService) audit(ctx context.Context, l domain.ArticleAuditLog) {
+	err := as.collab.AddAuditLog(ctx, l)
+	if err != nil {
+		as.l.Error("记录文章操作失败",
+			logger.Int64("aid", l.ArticleId),
+			logger.Int64("uid", l.Uid),
+			logger.String("action", l.Action.String()),
+			logger.Error(err))
+	}
+}
+
+func (as *articleService) Role(ctx context.Context, uid int64, art domain.Article) (domain.ArticleRole, error) {
+	if art.Author.Id == uid {
+		return domain.ArticleRoleOwner, nil
+	}
+	c, err := as.collab.Find(ctx, art.Id, uid)
+	switch err {
+	case nil:
+		return c.Role, nil
+	case repository.ErrCollaboratorNotFound:
+		return domain.ArticleRoleNone, nil
+	default:
+		return domain.ArticleRoleNone, err
+	}
+}
+
+func (as *articleService) Invite(ctx context.Context, uid int64, c domain.ArticleCollaborator) error {
+	if c.Uid <= 0 || c.Uid == uid || !c.Role.Valid() {
+		return ErrInvalidCollaborator
+	}
+	err := as.checkOwner(ctx, uid, c.ArticleId)
+	if err != nil {
+		return err
+	}
+	c.Inviter = uid
+	err = as.collab.Save(ctx, c)
+	if err != nil {
+		return err
+	}
+	as.audit(ctx, domain.ArticleAuditLog{
+		ArticleId: c.ArticleId,
+		Uid:       uid,
+		Action:    domain.ArticleAuditActionInvite,
+		Detail:    fmt.Sprintf("uid=%d,role=%d", c.Uid, c.Role),
+	})
+	return nil
+}
+
+func (as *articleService) RemoveCollaborator(ctx context.Context, uid int64, artId int64, collaborator int64) error {
+	err := as.checkOwner(ctx, uid, artId)
+	if err != nil {
+		return err
+	}
+	err = as.collab.Delete(ctx, artId, collaborator)
+	if err != nil {
+		return err
+	}
+	as.audit(ctx, domain.ArticleAuditLog{
+		ArticleId: artId,
+		Uid:       uid,
+		Action:    domain.ArticleAuditActionRemove,
+		Detail:    fmt.Sprintf("uid=%d", collaborator),
+	})
+	return nil
+}
+
+func (as *articleService) ListCollaborators(ctx context.Context, uid int64, artId int64) ([]domain.ArticleCollaborator, error) {
+	err := as.checkViewer(ctx, uid, artId)
+	if err != nil {
+		return nil, err
+	}
+	return as.collab.FindByArticle(ctx, artId)
+}
+
+func (as *articleService) ListAuditLogs(ctx context.Context, uid int64, artId int64,
+	offset, limit int) ([]domain.ArticleAuditLog, error) {
+	err := as.checkViewer(ctx, uid, artId)
+	if err != nil {
+		return nil, err
+	}
+	offset, limit = clampPage(offset, limit)
+	return as.collab.ListAuditLogs(ctx, artId, offset, limit)
+}
+
+func (as *articleService) checkOwner(ctx context.Context, uid int64, artId int64) error {
+	art, err := as.ar.GetById(ctx, artId)
+	if err != nil {
+		return err
+	}
+	if art.Author.Id != uid {
+		return ErrArticleForbidden
+	}
+	return nil
+}
+
+func (as *articleService) checkViewer(ctx context.Context, uid int64, artId int64) error {
+	art, err := as.ar.GetById(ctx, artId)
+	if err != nil {
+		return err
+	}
+	role, err := as.Role(ctx, uid, art)
+	if err != nil {
+		return err
+	}
+	if !role.CanView() {
+		return ErrArticleForbidden
+	}
+	return nil
 }
 
 func (as *articleService) Publish(ctx context.Context, art domain.Article) (int64, error) {
 	art.Status = domain.ArticleStatusPublished
-	return as.ar.Sync(ctx, art)
+	// 只在发表的时候渲染一次，读者看到的都是渲染好的
+	art, err := as.render(ctx, art)
+	if err != nil {
+		return 0, err
+	}
+	res, err := as.moderator.Check(ctx, art)
+	if err != nil {
+		return 0, err
+	}
+	if res.Flagged() {
+		return as.submitReview(ctx, art, res.Reasons)
+	}
+	id, err := as.ar.Sync(ctx, art)
+	if err != nil {
+		return id, err
+	}
+	art.Id = id
+	as.syncSearch(art)
+	return id, nil
+}
+
+// syncSearch 通知搜索服务更新索引，失败了只记录日志，不影响发表
+func (as *articleService) syncSearch(art domain.Article) {
+	err := as.producer.ProduceSyncEvent(newSyncEvent(art))
+	if err != nil {
+		as.l.Error("发送 SyncEvent 失败",
+			logger.Int64("aid", art.Id),
+			logger.Error(err))
+	}
+}
+
+// newSyncEvent 搜索服务只索引纯文本，发出去之前先把 markdown 去掉
+func newSyncEvent(art domain.Article) article.SyncEvent {
+	return article.SyncEvent{
+		Id:      art.Id,
+		Title:   art.Title,
+		Status:  int32(art.Status),
+		Content: mdx.PlainText(art.Content),
+	}
+}
+
+// submitReview 只保存草稿，审核通过之后再从草稿复制到线上库，
+// 所以已经发表过的文章在审核期间读者看到的还是原来的内容
+func (as *articleService) submitReview(ctx context.Context, art domain.Article, reasons []string) (int64, error) {
+	art.Status = domain.ArticleStatusPendingReview
+	id := art.Id
+	// 审核的是保存之后的版本，新建的文章版本号从 1 开始
+	version := art.Version + 1
+	if id > 0 {
+		err := as.ar.Update(ctx, art)
+		if err != nil {
+			return id, err
+		}
+	} else {
+		var err error
+		id, err = as.ar.Create(ctx, art)
+		if err != nil {
+			return 0, err
+		}
+		version = 1
+	}
+	_, err := as.review.Create(ctx, domain.ArticleReview{
+		ArticleId: id,
+		Author:    art.Author,
+		Version:   version,
+		Title:     art.Title,
+		Reasons:   reasons,
+	})
+	if err != nil {
+		// 文章卡在待审核状态，作者再发表一次就可以了
+		return id, err
+	}
+	return id, ErrArticlePendingReview
+}
+
+// render 检查引用的文件，然后渲染正文
+func (as *articleService) render(ctx context.Context, art domain.Article) (domain.Article, error) {
+	var media map[int64]domain.Media
+	if ids := art.MediaIds(); len(ids) > 0 {
+		var err error
+		media, err = as.media.GetByIds(ctx, art.Author.Id, ids)
+		if err != nil {
+			return domain.Article{}, err
+		}
+	}
+	if art.Cover.Id > 0 {
+		cover, ok := media[art.Cover.Id]
+		if !ok {
+			return domain.Article{}, ErrArticleCoverNotFound
+		}
+		art.Cover = cover
+	}
+	rendered, err := domain.RenderArticle(art.Content, media)
+	if err != nil {
+		return domain.Article{}, err
+	}
+	art.Rendered = rendered
+	return art, nil
+}
+
+func (as *articleService) Schedule(ctx context.Context, art domain.Article) (int64, error) {
+	if !art.PublishAt.After(time.Now()) {
+		return 0, ErrInvalidPublishAt
+	}
+	art.Status = domain.ArticleStatusScheduled
+	// 定时发表之前改内容会取消定时发表，所以现在就可以渲染，到时间直接复制到线上库
+	art, err := as.render(ctx, art)
+	if err != nil {
+		return 0, err
+	}
+	// 审核也是现在做，审核通过的时候还没到时间就继续定时发表
+	res, err := as.moderator.Check(ctx, art)
+	if err != nil {
+		return 0, err
+	}
+	if res.Flagged() {
+		return as.submitReview(ctx, art, res.Reasons)
+	}
+	if art.Id > 0 {
+		err := as.ar.Update(ctx, art)
+		return art.Id, err
+	}
+	return as.ar.Create(ctx, art)
+}
+
+func (as *articleService) PublishDue(ctx context.Context, now time.Time) (int, error) {
+	cnt := 0
+	for {
+		arts, err := as.ar.ListDueScheduled(ctx, now, scheduledBatchSize)
+		if err != nil {
+			return cnt, err
+		}
+		before := cnt
+		for _, art := range arts {
+			err = as.ar.PublishScheduled(ctx, art, now)
+			switch err {
+			case nil:
+				cnt++
+				art.Status = domain.ArticleStatusPublished
+				as.syncSearch(art)
+			case repository.ErrScheduledArticleNotDue:
+				// 别的实例已经发表了，或者作者改了主意
+			default:
+				// 一篇失败了不影响别的，没发表的下一轮还会被找出来
+				as.l.Error("定时发表文章失败",
+					logger.Int64("aid", art.Id),
+					logger.Error(err))
+			}
+		}
+		// 一篇都没有发表成功，再查一次还是同一批，等下次调度
+		if len(arts) < scheduledBatchSize || cnt == before {
+			return cnt, nil
+		}
+	}
 }
 
 func (as *articleService) Withdraw(ctx context.Context, uid int64, id int64) error {
-	return as.ar.SyncStatus(ctx, uid, id, domain.ArticleStatusPrivate)
+	err := as.ar.SyncStatus(ctx, uid, id, domain.ArticleStatusPrivate)
+	if err == nil {
+		// 搜索服务只看状态，不需要内容
+		as.syncSearch(domain.Article{
+			Id:     id,
+			Status: domain.ArticleStatusPrivate,
+		})
+	}
+	return err
 }
 
-func (as *articleService) GetByAuthor(ctx context.Context, uid int64, offset int, limit int) ([]domain.Article, error) {
-	return as.ar.GetByAuthor(ctx, uid, offset, limit)
+func (as *articleService) GetByAuthor(ctx context.Context, uid int64, colId int64,
+	cursor domain.ArticleCursor, limit int) ([]domain.Article, error) {
+	return as.ar.GetByAuthor(ctx, uid, colId, cursor, limit)
 }
 
 func (as *articleService) GetById(ctx context.Context, id int64) (domain.Article, error) {
-	return as.ar.GetById(ctx, id)
+	res, err := as.ar.GetById(ctx, id)
+	if err == nil {
+		res.Cover = as.cover(ctx, res.Cover)
+	}
+	return res, err
+}
+
+// cover 补上封面的地址，失败了就当作没有封面
+func (as *articleService) cover(ctx context.Context, cover domain.Media) domain.Media {
+	if cover.Id <= 0 {
+		return cover
+	}
+	res, err := as.media.GetById(ctx, cover.Id)
+	if err != nil {
+		as.l.Warn("查询文章封面失败",
+			logger.Int64("mid", cover.Id),
+			logger.Error(err))
+		return cover
+	}
+	return res
 }
 
 func (as *articleService) GetPubById(ctx context.Context, id int64, uid int64) (domain.Article, error) {
 	res, err := as.ar.GetPubById(ctx, id)
+	if err == nil {
+		res.Cover = as.cover(ctx, res.Cover)
+	}
 	go func() {
 		if err == nil {
 			er := as.producer.ProduceReadEvent(article.ReadEvent{
-				Aid: id,
-				Uid: uid,
+				Aid:   id,
+				Uid:   uid,
+				Ctime: time.Now().UnixMilli(),
 			})
 			if er != nil {
 				as.l.Error("发送 ReadEvent 失败",
@@ -82,6 +524,83 @@ func (as *articleService) GetPubById(ctx context.Context, id int64, uid int64) (
 }
 
 func (as *articleService) ListPub(ctx context.Context,
-	start time.Time, offset, limit int) ([]domain.Article, error) {
-	return as.ar.ListPub(ctx, start, offset, limit)
+	cursor domain.ArticleCursor, limit int) ([]domain.Article, error) {
+	return as.ar.ListPub(ctx, cursor, limit)
+}
+
+func (as *articleService) GetPubByIds(ctx context.Context, ids []int64) ([]domain.Article, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return as.ar.GetPubByIds(ctx, ids)
+}
+
+func (as *articleService) ListPubByAuthor(ctx context.Context, uid int64,
+	cursor domain.ArticleCursor, limit int) ([]domain.Article, error) {
+	return as.ar.ListPubByAuthor(ctx, uid, cursor, limit)
+}
+
+func (as *articleService) ListRevisions(ctx context.Context, uid int64, artId int64,
+	offset, limit int) ([]domain.ArticleRevision, error) {
+	offset, limit = clampPage(offset, limit)
+	return as.ar.ListRevisions(ctx, uid, artId, offset, limit)
+}
+
+func (as *articleService) GetRevision(ctx context.Context, uid int64, id int64) (domain.ArticleRevision, error) {
+	rev, err := as.ar.GetRevision(ctx, id)
+	if err != nil {
+		return domain.ArticleRevision{}, err
+	}
+	if rev.Author.Id != uid {
+		as.l.Warn("非法查询文章历史版本",
+			logger.Int64("uid", uid),
+			logger.Int64("rid", id))
+		return domain.ArticleRevision{}, ErrArticleRevisionNotFound
+	}
+	return rev, nil
+}
+
+func (as *articleService) DiffRevisions(ctx context.Context, uid int64,
+	fromId int64, toId int64) (domain.ArticleDiff, error) {
+	from, err := as.GetRevision(ctx, uid, fromId)
+	if err != nil {
+		return domain.ArticleDiff{}, err
+	}
+	to, err := as.GetRevision(ctx, uid, toId)
+	if err != nil {
+		return domain.ArticleDiff{}, err
+	}
+	if from.ArticleId != to.ArticleId {
+		return domain.ArticleDiff{}, ErrArticleRevisionMismatch
+	}
+	return domain.ArticleDiff{
+		From:    from,
+		To:      to,
+		Title:   diffx.Lines(from.Title, to.Title),
+		Content: diffx.Lines(from.Content, to.Content),
+	}, nil
+}
+
+func (as *articleService) RestoreRevision(ctx context.Context, uid int64, id int64) (int64, error) {
+	rev, err := as.GetRevision(ctx, uid, id)
+	if err != nil {
+		return 0, err
+	}
+	cur, err := as.ar.GetById(ctx, rev.ArticleId)
+	if err != nil {
+		return 0, err
+	}
+	// 恢复就是用历史版本的内容保存一次，所以也会产生一个新的历史版本。
+	// 恢复是作者明确要覆盖，所以直接用最新的版本号。
+	// 历史版本里面没有封面，保存又会整个覆盖，所以封面沿用当前的
+	return as.Save(ctx, domain.Article{
+		Id:      rev.ArticleId,
+		Title:   rev.Title,
+		Content: rev.Content,
+		Cover:   cur.Cover,
+		Version: cur.Version,
+		Author: domain.Author{
+			Id: uid,
+		},
+	})
 }
@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"webook/internal/domain"
+	"webook/internal/events/article"
+	"webook/internal/repository"
+	"webook/internal/service/email"
+	"webook/pkg/logger"
+)
+
+var (
+	ErrArticleReviewNotFound = repository.ErrArticleReviewNotFound
+	// ErrArticleReviewResolved 别的审核员已经处理过了
+	ErrArticleReviewResolved = repository.ErrArticleReviewResolved
+	// ErrArticleReviewOutdated 作者在审核期间改了文章或者撤回了，这条记录作废
+	ErrArticleReviewOutdated = errors.New("作者已经修改了文章，这条审核记录已经作废")
+)
+
+// ArticleReviewService 人工审核，给审核员用的，文章进入审核队列是在 ArticleService 里面
+//
+//go:generate mockgen -source=./article_review.go -package=svcmocks -destination=./mocks/article_review.mock.go ArticleReviewService
+type ArticleReviewService interface {
+	// ListPending 先提交的排在前面
+	ListPending(ctx context.Context, offset, limit int) ([]domain.ArticleReview, error)
+	// Detail 返回审核记录和文章当前的草稿，
+	// 草稿的版本号和审核记录的不一样说明作者已经改过了
+	Detail(ctx context.Context, id int64) (domain.ArticleReview, domain.Article, error)
+	// Approve 审核通过之后发表文章，并且通知作者
+	Approve(ctx context.Context, reviewer int64, id int64, comment string) error
+	// Reject 文章回到未发表状态，comment 会告诉作者
+	Reject(ctx context.Context, reviewer int64, id int64, comment string) error
+}
+
+type articleReviewService struct {
+	repo     repository.ArticleReviewRepository
+	ar       repository.ArticleRepository
+	ur       repository.UserRepository
+	emailSvc email.Service
+	producer article.Producer
+	l        logger.LoggerV1
+}
+
+func NewArticleReviewService(repo repository.ArticleReviewRepository,
+	ar repository.ArticleRepository,
+	ur repository.UserRepository,
+	emailSvc email.Service,
+	producer article.Producer,
+	l logger.LoggerV1) ArticleReviewService {
+	return &articleReviewService{
+		repo:     repo,
+		ar:       ar,
+		ur:       ur,
+		emailSvc: emailSvc,
+		producer: producer,
+		l:        l,
+	}
+}
+
+func (svc *articleReviewService) ListPending(ctx context.Context, offset, limit int) ([]domain.ArticleReview, error) {
+	return svc.repo.ListPending(ctx, offset, limit)
+}
+
+func (svc *articleReviewService) Detail(ctx context.Context, id int64) (domain.ArticleReview, domain.Article, error) {
+	r, err := svc.repo.FindById(ctx, id)
+	if err != nil {
+		return domain.ArticleReview{}, domain.Article{}, err
+	}
+	art, err := svc.ar.GetById(ctx, r.ArticleId)
+	return r, art, err
+}
+
+func (svc *articleReviewService) Approve(ctx context.Context, reviewer int64, id int64, comment string) error {
+	return svc.resolve(ctx, domain.ArticleReview{
+		Id:       id,
+		Status:   domain.ArticleReviewStatusApproved,
+		Reviewer: reviewer,
+		Comment:  comment,
+	}, func(art domain.Article) error {
+		err := svc.ar.ApprovePending(ctx, art, time.Now())
+		if err == nil {
+			svc.syncSearch(ctx, art.Id)
+		}
+		return err
+	})
+}
+
+// syncSearch 直接发表的文章要通知搜索服务，变成定时发表的等发表的时候再通知
+func (svc *articleReviewService) syncSearch(ctx context.Context, id int64) {
+	art, err := svc.ar.GetById(ctx, id)
+	if err != nil {
+		svc.l.Error("查询审核通过的文章失败，没有通知搜索服务",
+			logger.Int64("aid", id),
+			logger.Error(err))
+		return
+	}
+	if art.Status != domain.ArticleStatusPublished {
+		return
+	}
+	err = svc.producer.ProduceSyncEvent(newSyncEvent(art))
+	if err != nil {
+		svc.l.Error("发送 SyncEvent 失败",
+			logger.Int64("aid", id),
+			logger.Error(err))
+	}
+}
+
+func (svc *articleReviewService) Reject(ctx context.Context, reviewer int64, id int64, comment string) error {
+	return svc.resolve(ctx, domain.ArticleReview{
+		Id:       id,
+		Status:   domain.ArticleReviewStatusRejected,
+		Reviewer: reviewer,
+		Comment:  comment,
+	}, func(art domain.Article) error {
+		return svc.ar.RejectPending(ctx, art)
+	})
+}
+
+// resolve 先抢到审核记录，多个审核员同时处理只有一个能成功，
+// 然后再修改文章的状态，失败了要把审核记录改回去
+func (svc *articleReviewService) resolve(ctx context.Context, res domain.ArticleReview,
+	apply func(art domain.Article) error) error {
+	r, err := svc.repo.FindById(ctx, res.Id)
+	if err != nil {
+		return err
+	}
+	if r.Status != domain.ArticleReviewStatusPending {
+		return ErrArticleReviewResolved
+	}
+	err = svc.repo.Resolve(ctx, res)
+	if err != nil {
+		return err
+	}
+	err = apply(domain.Article{
+		Id:      r.ArticleId,
+		Author:  r.Author,
+		Version: r.Version,
+	})
+	switch err {
+	case nil:
+	case repository.ErrArticleNotPending:
+		svc.setStatus(ctx, r.Id, domain.ArticleReviewStatusCanceled)
+		return ErrArticleReviewOutdated
+	default:
+		// 放回队列，审核员可以重试
+		svc.setStatus(ctx, r.Id, domain.ArticleReviewStatusPending)
+		return err
+	}
+	r.Status = res.Status
+	r.Comment = res.Comment
+	svc.notify(ctx, r)
+	return nil
+}
+
+func (svc *articleReviewService) setStatus(ctx context.Context, id int64, status domain.ArticleReviewStatus) {
+	err := svc.repo.SetStatus(ctx, id, status)
+	if err != nil {
+		svc.l.Error("修改审核记录的状态失败",
+			logger.Int64("id", id),
+			logger.Int("status", int(status)),
+			logger.Error(err))
+	}
+}
+
+// notify 邮件通知作者审核结果，文章已经处理好了，通知失败只记录日志
+func (svc *articleReviewService) notify(ctx context.Context, r domain.ArticleReview) {
+	u, err := svc.ur.FindById(ctx, r.Author.Id)
+	if err != nil {
+		svc.l.Error("查询作者失败，没有通知审核结果",
+			logger.Int64("uid", r.Author.Id),
+			logger.Error(err))
+		return
+	}
+	// 没有验证过的邮箱不一定是作者本人的
+	if u.Email == "" || !u.EmailVerified {
+		return
+	}
+	subject := "你的文章通过了审核"
+	content := fmt.Sprintf("《%s》通过了审核，定时发表的文章会在设定的时间发表", r.Title)
+	if r.Status == domain.ArticleReviewStatusRejected {
+		subject = "你的文章没有通过审核"
+		content = fmt.Sprintf("《%s》没有通过审核：%s。修改之后可以重新发表", r.Title, r.Comment)
+	}
+	err = svc.emailSvc.Send(ctx, subject, content, u.Email)
+	if err != nil {
+		svc.l.Error("通知作者审核结果失败",
+			logger.Int64("uid", r.Author.Id),
+			logger.Int64("review", r.Id),
+			logger.Error(err))
+	}
+}
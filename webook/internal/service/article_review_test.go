@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"webook/internal/domain"
+	"webook/internal/events/article"
+	evtmocks "webook/internal/events/article/mocks"
+	"webook/internal/repository"
+	repomocks "webook/internal/repository/mocks"
+	"webook/internal/service/email"
+	emailmocks "webook/internal/service/email/mocks"
+	"webook/pkg/logger"
+	"webook/pkg/mdx"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestArticleReviewService_Approve(t *testing.T) {
+	pending := domain.ArticleReview{
+		Id:        1,
+		ArticleId: 2,
+		Author:    domain.Author{Id: 3},
+		Version:   4,
+		Title:     "我的标题",
+		Status:    domain.ArticleReviewStatusPending,
+	}
+	resolved := domain.ArticleReview{
+		Id:       1,
+		Status:   domain.ArticleReviewStatusApproved,
+		Reviewer: 9,
+		Comment:  "OK",
+	}
+	art := domain.Article{Id: 2, Author: domain.Author{Id: 3}, Version: 4}
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (repository.ArticleReviewRepository,
+			repository.ArticleRepository, repository.UserRepository, email.Service, article.Producer)
+
+		wantErr error
+	}{
+		{
+			name: "审核通过，通知作者",
+			mock: func(ctrl *gomock.Controller) (repository.ArticleReviewRepository,
+				repository.ArticleRepository, repository.UserRepository, email.Service, article.Producer) {
+				repo := repomocks.NewMockArticleReviewRepository(ctrl)
+				ar := repomocks.NewMockArticleRepository(ctrl)
+				ur := repomocks.NewMockUserRepository(ctrl)
+				emailSvc := emailmocks.NewMockService(ctrl)
+				repo.EXPECT().FindById(gomock.Any(), int64(1)).Return(pending, nil)
+				repo.EXPECT().Resolve(gomock.Any(), resolved).Return(nil)
+				ar.EXPECT().ApprovePending(gomock.Any(), art, gomock.Any()).Return(nil)
+				// 变成定时发表的不用通知搜索服务
+				ar.EXPECT().GetById(gomock.Any(), int64(2)).
+					Return(domain.Article{Id: 2, Status: domain.ArticleStatusScheduled}, nil)
+				ur.EXPECT().FindById(gomock.Any(), int64(3)).
+					Return(domain.User{Id: 3, Email: "a@qq.com", EmailVerified: true}, nil)
+				emailSvc.EXPECT().Send(gomock.Any(), "你的文章通过了审核",
+					gomock.Any(), "a@qq.com").Return(nil)
+				return repo, ar, ur, emailSvc, nil
+			},
+		},
+		{
+			name: "直接发表，通知搜索服务的是纯文本",
+			mock: func(ctrl *gomock.Controller) (repository.ArticleReviewRepository,
+				repository.ArticleRepository, repository.UserRepository, email.Service, article.Producer) {
+				repo := repomocks.NewMockArticleReviewRepository(ctrl)
+				ar := repomocks.NewMockArticleRepository(ctrl)
+				ur := repomocks.NewMockUserRepository(ctrl)
+				emailSvc := emailmocks.NewMockService(ctrl)
+				producer := evtmocks.NewMockProducer(ctrl)
+				repo.EXPECT().FindById(gomock.Any(), int64(1)).Return(pending, nil)
+				repo.EXPECT().Resolve(gomock.Any(), resolved).Return(nil)
+				ar.EXPECT().ApprovePending(gomock.Any(), art, gomock.Any()).Return(nil)
+				ar.EXPECT().GetById(gomock.Any(), int64(2)).
+					Return(domain.Article{Id: 2, Title: "我的标题", Content: "# 标题\n**正文**",
+						Status: domain.ArticleStatusPublished}, nil)
+				producer.EXPECT().ProduceSyncEvent(article.SyncEvent{
+					Id:      2,
+					Title:   "我的标题",
+					Status:  int32(domain.ArticleStatusPublished),
+					Content: mdx.PlainText("# 标题\n**正文**"),
+				}).Return(nil)
+				ur.EXPECT().FindById(gomock.Any(), int64(3)).
+					Return(domain.User{Id: 3, Email: "a@qq.com", EmailVerified: true}, nil)
+				emailSvc.EXPECT().Send(gomock.Any(), "你的文章通过了审核",
+					gomock.Any(), "a@qq.com").Return(nil)
+				return repo, ar, ur, emailSvc, producer
+			},
+		},
+		{
+			name: "别人已经处理过了",
+			mock: func(ctrl *gomock.Controller) (repository.ArticleReviewRepository,
+				repository.ArticleRepository, repository.UserRepository, email.Service, article.Producer) {
+				repo := repomocks.NewMockArticleReviewRepository(ctrl)
+				repo.EXPECT().FindById(gomock.Any(), int64(1)).Return(pending, nil)
+				repo.EXPECT().Resolve(gomock.Any(), resolved).
+					Return(repository.ErrArticleReviewResolved)
+				return repo, nil, nil, nil, nil
+			},
+			wantErr: ErrArticleReviewResolved,
+		},
+		{
+			name: "作者改过了文章，审核记录作废",
+			mock: func(ctrl *gomock.Controller) (repository.ArticleReviewRepository,
+				repository.ArticleRepository, repository.UserRepository, email.Service, article.Producer) {
+				repo := repomocks.NewMockArticleReviewRepository(ctrl)
+				ar := repomocks.NewMockArticleRepository(ctrl)
+				repo.EXPECT().FindById(gomock.Any(), int64(1)).Return(pending, nil)
+				repo.EXPECT().Resolve(gomock.Any(), resolved).Return(nil)
+				ar.EXPECT().ApprovePending(gomock.Any(), art, gomock.Any()).
+					Return(repository.ErrArticleNotPending)
+				repo.EXPECT().SetStatus(gomock.Any(), int64(1), domain.ArticleReviewStatusCanceled).
+					Return(nil)
+				return repo, ar, nil, nil, nil
+			},
+			wantErr: ErrArticleReviewOutdated,
+		},
+		{
+			name: "发表失败，放回队列",
+			mock: func(ctrl *gomock.Controller) (repository.ArticleReviewRepository,
+				repository.ArticleRepository, repository.UserRepository, email.Service, article.Producer) {
+				repo := repomocks.NewMockArticleReviewRepository(ctrl)
+				ar := repomocks.NewMockArticleRepository(ctrl)
+				repo.EXPECT().FindById(gomock.Any(), int64(1)).Return(pending, nil)
+				repo.EXPECT().Resolve(gomock.Any(), resolved).Return(nil)
+				ar.EXPECT().ApprovePending(gomock.Any(), art, gomock.Any()).
+					Return(errors.New("db 错误"))
+				repo.EXPECT().SetStatus(gomock.Any(), int64(1), domain.ArticleReviewStatusPending).
+					Return(nil)
+				return repo, ar, nil, nil, nil
+			},
+			wantErr: errors.New("db 错误"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo, ar, ur, emailSvc, producer := tc.mock(ctrl)
+			svc := NewArticleReviewService(repo, ar, ur, emailSvc, producer, &logger.NopLogger{})
+			err := svc.Approve(context.Background(), 9, 1, "OK")
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
@@ -2,20 +2,29 @@ package service
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 	"webook/internal/domain"
+	"webook/internal/events/article"
+	evtmocks "webook/internal/events/article/mocks"
 	"webook/internal/repository"
 	repomocks "webook/internal/repository/mocks"
+	svcmocks "webook/internal/service/mocks"
+	"webook/internal/service/moderation"
+	moderationmocks "webook/internal/service/moderation/mocks"
 	"webook/pkg/logger"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
 
 func TestArticleService_Publish(t *testing.T) {
 	testCases := []struct {
 		name string
-		mock func(ctrl *gomock.Controller) repository.ArticleRepository
+		mock func(ctrl *gomock.Controller) (repository.ArticleRepository,
+			repository.ArticleReviewRepository, moderation.Moderator, article.Producer)
 
 		art domain.Article
 
@@ -24,25 +33,89 @@ func TestArticleService_Publish(t *testing.T) {
 	}{
 		{
 			name: "发表成功",
-			mock: func(ctrl *gomock.Controller) repository.ArticleRepository {
+			mock: func(ctrl *gomock.Controller) (repository.ArticleRepository,
+				repository.ArticleReviewRepository, moderation.Moderator, article.Producer) {
 				repo := repomocks.NewMockArticleRepository(ctrl)
-				repo.EXPECT().Sync(gomock.Any(), domain.Article{
+				moderator := moderationmocks.NewMockModerator(ctrl)
+				art := domain.Article{
 					Title:   "我的标题",
+					Content: "**我的**内容",
+					Author: domain.Author{
+						Id: 123,
+					},
+					Status: domain.ArticleStatusPublished,
+					Rendered: domain.ArticleRendered{
+						Html:     "<p><strong>我的</strong>内容</p>\n",
+						Abstract: "我的内容",
+					},
+				}
+				moderator.EXPECT().Check(gomock.Any(), art).Return(moderation.Result{}, nil)
+				repo.EXPECT().Sync(gomock.Any(), art).Return(int64(1), nil)
+				producer := evtmocks.NewMockProducer(ctrl)
+				// 发给搜索的是纯文本
+				producer.EXPECT().ProduceSyncEvent(article.SyncEvent{
+					Id:      1,
+					Title:   "我的标题",
+					Status:  int32(domain.ArticleStatusPublished),
 					Content: "我的内容",
+				}).Return(nil)
+				return repo, nil, moderator, producer
+			},
+			art: domain.Article{
+				Title:   "我的标题",
+				Content: "**我的**内容",
+				Author: domain.Author{
+					Id: 123,
+				},
+			},
+			wantId: int64(1),
+		},
+		{
+			name: "没有通过自动审核",
+			mock: func(ctrl *gomock.Controller) (repository.ArticleRepository,
+				repository.ArticleReviewRepository, moderation.Moderator, article.Producer) {
+				repo := repomocks.NewMockArticleRepository(ctrl)
+				reviewRepo := repomocks.NewMockArticleReviewRepository(ctrl)
+				moderator := moderationmocks.NewMockModerator(ctrl)
+				moderator.EXPECT().Check(gomock.Any(), gomock.Any()).
+					Return(moderation.Result{Reasons: []string{"敏感词：赌博"}}, nil)
+				// 只保存草稿，不会同步到线上库
+				repo.EXPECT().Update(gomock.Any(), domain.Article{
+					Id:      2,
+					Title:   "我的标题",
+					Content: "赌博",
+					Version: 3,
 					Author: domain.Author{
 						Id: 123,
 					},
+					Status: domain.ArticleStatusPendingReview,
+					Rendered: domain.ArticleRendered{
+						Html:     "<p>赌博</p>\n",
+						Abstract: "赌博",
+					},
+				}).Return(nil)
+				reviewRepo.EXPECT().Create(gomock.Any(), domain.ArticleReview{
+					ArticleId: 2,
+					Author: domain.Author{
+						Id: 123,
+					},
+					Version: 4,
+					Title:   "我的标题",
+					Reasons: []string{"敏感词：赌博"},
 				}).Return(int64(1), nil)
-				return repo
+				return repo, reviewRepo, moderator, nil
 			},
 			art: domain.Article{
+				Id:      2,
 				Title:   "我的标题",
-				Content: "我的内容",
+				Content: "赌博",
+				Version: 3,
 				Author: domain.Author{
 					Id: 123,
 				},
 			},
-			wantId: int64(1),
+			wantId:  2,
+			wantErr: ErrArticlePendingReview,
 		},
 	}
 
@@ -50,10 +123,304 @@ func TestArticleService_Publish(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
-			svc := NewArticleService(tc.mock(ctrl), nil, &logger.NopLogger{})
+			repo, reviewRepo, moderator, producer := tc.mock(ctrl)
+			svc := NewArticleService(repo, nil, nil, reviewRepo, moderator, producer, &logger.NopLogger{})
 			id, err := svc.Publish(context.Background(), tc.art)
 			assert.Equal(t, tc.wantErr, err)
 			assert.Equal(t, tc.wantId, id)
 		})
 	}
 }
+
+func TestArticleService_PublishDue(t *testing.T) {
+	now := time.Now()
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (repository.ArticleRepository, article.Producer)
+
+		wantCnt int
+		wantErr error
+	}{
+		{
+			name: "发表成功，别人已经发表的不算",
+			mock: func(ctrl *gomock.Controller) (repository.ArticleRepository, article.Producer) {
+				repo := repomocks.NewMockArticleRepository(ctrl)
+				arts := []domain.Article{{Id: 1}, {Id: 2}, {Id: 3}}
+				repo.EXPECT().ListDueScheduled(gomock.Any(), now, scheduledBatchSize).
+					Return(arts, nil)
+				repo.EXPECT().PublishScheduled(gomock.Any(), arts[0], now).Return(nil)
+				// 只有发表成功的才通知搜索服务
+				producer := evtmocks.NewMockProducer(ctrl)
+				producer.EXPECT().ProduceSyncEvent(article.SyncEvent{
+					Id:     1,
+					Status: int32(domain.ArticleStatusPublished),
+				}).Return(nil)
+				repo.EXPECT().PublishScheduled(gomock.Any(), arts[1], now).
+					Return(repository.ErrScheduledArticleNotDue)
+				repo.EXPECT().PublishScheduled(gomock.Any(), arts[2], now).
+					Return(errors.New("db 错误"))
+				return repo, producer
+			},
+			wantCnt: 1,
+		},
+		{
+			name: "一整批都失败，不会一直重试",
+			mock: func(ctrl *gomock.Controller) (repository.ArticleRepository, article.Producer) {
+				repo := repomocks.NewMockArticleRepository(ctrl)
+				arts := make([]domain.Article, scheduledBatchSize)
+				repo.EXPECT().ListDueScheduled(gomock.Any(), now, scheduledBatchSize).
+					Return(arts, nil)
+				repo.EXPECT().PublishScheduled(gomock.Any(), gomock.Any(), now).
+					Return(errors.New("db 错误")).Times(scheduledBatchSize)
+				return repo, nil
+			},
+		},
+		{
+			name: "查询失败",
+			mock: func(ctrl *gomock.Controller) (repository.ArticleRepository, article.Producer) {
+				repo := repomocks.NewMockArticleRepository(ctrl)
+				repo.EXPECT().ListDueScheduled(gomock.Any(), now, scheduledBatchSize).
+					Return(nil, errors.New("db 错误"))
+				return repo, nil
+			},
+			wantErr: errors.New("db 错误"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo, producer := tc.mock(ctrl)
+			svc := NewArticleService(repo, nil, nil, nil, nil, producer, &logger.NopLogger{})
+			cnt, err := svc.PublishDue(context.Background(), now)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantCnt, cnt)
+		})
+	}
+}
+
+func TestArticleService_Schedule(t *testing.T) {
+	svc := NewArticleService(nil, nil, nil, nil, nil, nil, &logger.NopLogger{})
+	_, err := svc.Schedule(context.Background(), domain.Article{
+		PublishAt: time.Now().Add(-time.Minute),
+	})
+	assert.Equal(t, ErrInvalidPublishAt, err)
+}
+
+func TestArticleService_Save(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (repository.ArticleRepository, repository.ArticleCollaboratorRepository)
+
+		art domain.Article
+
+		wantId  int64
+		wantErr error
+	}{
+		{
+			name: "协作者保存成功",
+			mock: func(ctrl *gomock.Controller) (repository.ArticleRepository, repository.ArticleCollaboratorRepository) {
+				repo := repomocks.NewMockArticleRepository(ctrl)
+				collab := repomocks.NewMockArticleCollaboratorRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), int64(1)).Return(domain.Article{
+					Id:      1,
+					Title:   "老的标题",
+					Content: "内容",
+					Author:  domain.Author{Id: 123},
+					Version: 3,
+				}, nil)
+				collab.EXPECT().Find(gomock.Any(), int64(1), int64(456)).
+					Return(domain.ArticleCollaborator{Role: domain.ArticleRoleEditor}, nil)
+				// 文章还是作者的
+				repo.EXPECT().Update(gomock.Any(), domain.Article{
+					Id:      1,
+					Title:   "新的标题",
+					Content: "内容",
+					Author:  domain.Author{Id: 123},
+					Status:  domain.ArticleStatusUnpublished,
+					Version: 3,
+				}).Return(nil)
+				collab.EXPECT().AddAuditLog(gomock.Any(), domain.ArticleAuditLog{
+					ArticleId: 1,
+					Uid:       456,
+					Action:    domain.ArticleAuditActionSave,
+					Version:   4,
+					Detail:    "title",
+				}).Return(nil)
+				return repo, collab
+			},
+			art: domain.Article{
+				Id:      1,
+				Title:   "新的标题",
+				Content: "内容",
+				Author:  domain.Author{Id: 456},
+				Version: 3,
+			},
+			wantId: 1,
+		},
+		{
+			name: "只读的协作者不能保存",
+			mock: func(ctrl *gomock.Controller) (repository.ArticleRepository, repository.ArticleCollaboratorRepository) {
+				repo := repomocks.NewMockArticleRepository(ctrl)
+				collab := repomocks.NewMockArticleCollaboratorRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), int64(1)).Return(domain.Article{
+					Id:     1,
+					Author: domain.Author{Id: 123},
+				}, nil)
+				collab.EXPECT().Find(gomock.Any(), int64(1), int64(456)).
+					Return(domain.ArticleCollaborator{Role: domain.ArticleRoleViewer}, nil)
+				return repo, collab
+			},
+			art: domain.Article{
+				Id:     1,
+				Author: domain.Author{Id: 456},
+			},
+			wantErr: ErrArticleForbidden,
+		},
+		{
+			name: "版本号冲突",
+			mock: func(ctrl *gomock.Controller) (repository.ArticleRepository, repository.ArticleCollaboratorRepository) {
+				repo := repomocks.NewMockArticleRepository(ctrl)
+				collab := repomocks.NewMockArticleCollaboratorRepository(ctrl)
+				repo.EXPECT().GetById(gomock.Any(), int64(1)).Return(domain.Article{
+					Id:      1,
+					Author:  domain.Author{Id: 123},
+					Version: 5,
+				}, nil)
+				repo.EXPECT().Update(gomock.Any(), gomock.Any()).
+					Return(repository.ErrArticleVersionConflict)
+				return repo, collab
+			},
+			art: domain.Article{
+				Id:      1,
+				Title:   "新的标题",
+				Author:  domain.Author{Id: 123},
+				Version: 4,
+			},
+			wantId:  1,
+			wantErr: ErrArticleVersionConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo, collab := tc.mock(ctrl)
+			svc := NewArticleService(repo, collab, nil, nil, nil, nil, &logger.NopLogger{})
+			id, err := svc.Save(context.Background(), tc.art)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantId, id)
+		})
+	}
+}
+
+func TestArticleService_RestoreRevision(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockArticleRepository(ctrl)
+	collab := repomocks.NewMockArticleCollaboratorRepository(ctrl)
+	media := svcmocks.NewMockMediaService(ctrl)
+	repo.EXPECT().GetRevision(gomock.Any(), int64(9)).Return(domain.ArticleRevision{
+		Id:        9,
+		ArticleId: 1,
+		Author:    domain.Author{Id: 123},
+		Title:     "老的标题",
+		Content:   "老的内容",
+	}, nil)
+	repo.EXPECT().GetById(gomock.Any(), int64(1)).Return(domain.Article{
+		Id:      1,
+		Title:   "新的标题",
+		Content: "新的内容",
+		Cover:   domain.Media{Id: 7},
+		Author:  domain.Author{Id: 123},
+		Version: 3,
+	}, nil).Times(2)
+	media.EXPECT().GetByIds(gomock.Any(), int64(123), []int64{7}).
+		Return(map[int64]domain.Media{7: {Id: 7}}, nil)
+	// 只恢复标题和内容，封面还是现在的
+	repo.EXPECT().Update(gomock.Any(), domain.Article{
+		Id:      1,
+		Title:   "老的标题",
+		Content: "老的内容",
+		Cover:   domain.Media{Id: 7},
+		Author:  domain.Author{Id: 123},
+		Status:  domain.ArticleStatusUnpublished,
+		Version: 3,
+	}).Return(nil)
+	collab.EXPECT().AddAuditLog(gomock.Any(), domain.ArticleAuditLog{
+		ArticleId: 1,
+		Uid:       123,
+		Action:    domain.ArticleAuditActionSave,
+		Version:   4,
+		Detail:    "title,content",
+	}).Return(nil)
+
+	svc := NewArticleService(repo, collab, media, nil, nil, nil, &logger.NopLogger{})
+	id, err := svc.RestoreRevision(context.Background(), 123, 9)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), id)
+}
+
+func TestArticleService_ListRevisions(t *testing.T) {
+	testCases := []struct {
+		name   string
+		offset int
+		limit  int
+
+		wantOffset int
+		wantLimit  int
+	}{
+		{
+			name:       "正常分页",
+			offset:     10,
+			limit:      20,
+			wantOffset: 10,
+			wantLimit:  20,
+		},
+		{
+			name:       "limit 太大",
+			limit:      100000,
+			wantLimit:  maxListLimit,
+			wantOffset: 0,
+		},
+		{
+			name:       "参数是负数",
+			offset:     -1,
+			limit:      -1,
+			wantOffset: 0,
+			wantLimit:  maxListLimit,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo := repomocks.NewMockArticleRepository(ctrl)
+			repo.EXPECT().ListRevisions(gomock.Any(), int64(123), int64(1), tc.wantOffset, tc.wantLimit).
+				Return([]domain.ArticleRevision{{Id: 1}}, nil)
+			svc := NewArticleService(repo, nil, nil, nil, nil, nil, &logger.NopLogger{})
+			revs, err := svc.ListRevisions(context.Background(), 123, 1, tc.offset, tc.limit)
+			require.NoError(t, err)
+			assert.Equal(t, []domain.ArticleRevision{{Id: 1}}, revs)
+		})
+	}
+}
+
+func TestArticleService_ListAuditLogs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	repo := repomocks.NewMockArticleRepository(ctrl)
+	repo.EXPECT().GetById(gomock.Any(), int64(1)).
+		Return(domain.Article{Id: 1, Author: domain.Author{Id: 123}}, nil)
+	collab := repomocks.NewMockArticleCollaboratorRepository(ctrl)
+	// limit 太大的时候只查一页
+	collab.EXPECT().ListAuditLogs(gomock.Any(), int64(1), 0, maxListLimit).
+		Return([]domain.ArticleAuditLog{{Id: 1}}, nil)
+	svc := NewArticleService(repo, collab, nil, nil, nil, nil, &logger.NopLogger{})
+	logs, err := svc.ListAuditLogs(context.Background(), 123, 1, -1, 100000)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.ArticleAuditLog{{Id: 1}}, logs)
+}
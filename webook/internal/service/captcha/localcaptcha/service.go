@@ -0,0 +1,20 @@
+package localcaptcha
+
+import (
+	"context"
+	"log"
+)
+
+// Service 本地开发用的替身，只要带了票据就认为通过
+// 上线的时候替换成腾讯云、极验之类的验证码服务
+type Service struct {
+}
+
+func NewService() *Service {
+	return &Service{}
+}
+
+func (s *Service) Verify(ctx context.Context, ticket string, ip string) (bool, error) {
+	log.Println("验证码票据：", ticket, ip)
+	return ticket != "", nil
+}
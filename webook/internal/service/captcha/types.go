@@ -0,0 +1,9 @@
+package captcha
+
+import "context"
+
+//go:generate mockgen -source=./types.go -package=captchamocks -destination=./mocks/captcha.mock.go Service
+type Service interface {
+	// Verify 校验前端拿到的验证码票据
+	Verify(ctx context.Context, ticket string, ip string) (bool, error)
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"webook/internal/domain"
+	"webook/internal/repository"
+	"webook/pkg/logger"
+)
+
+var (
+	// ErrColumnNotFound 别人的专栏，在修改的时候也当作不存在
+	ErrColumnNotFound         = repository.ErrColumnNotFound
+	ErrArticleAlreadyInColumn = repository.ErrArticleAlreadyInColumn
+	ErrColumnArticlesMismatch = repository.ErrColumnArticlesMismatch
+	// ErrColumnArticleNotFound 文章不存在，或者不是专栏作者的文章
+	ErrColumnArticleNotFound = errors.New("文章不存在")
+)
+
+//go:generate mockgen -source=./column.go -package=svcmocks -destination=./mocks/column.mock.go ColumnService
+type ColumnService interface {
+	// Save Id 为 0 就是新建
+	Save(ctx context.Context, c domain.Column) (int64, error)
+	Delete(ctx context.Context, uid int64, id int64) error
+	GetById(ctx context.Context, id int64) (domain.Column, error)
+	ListByAuthor(ctx context.Context, uid int64) ([]domain.Column, error)
+
+	// AddArticle 只能把自己的文章加到自己的专栏里面，加在最后面
+	AddArticle(ctx context.Context, uid int64, colId int64, artId int64) error
+	RemoveArticle(ctx context.Context, uid int64, colId int64, artId int64) error
+	// Reorder artIds 是专栏里面全部文章的新顺序
+	Reorder(ctx context.Context, uid int64, colId int64, artIds []int64) error
+
+	// ListPubArticles 按照专栏里面的顺序，只返回已经发表的文章
+	ListPubArticles(ctx context.Context, colId int64, offset int, limit int) ([]domain.Article, error)
+	// Nav 文章在专栏里面的上一篇和下一篇，不在专栏里面就返回零值
+	Nav(ctx context.Context, artId int64) (domain.ColumnNav, error)
+}
+
+type columnService struct {
+	repo    repository.ColumnRepository
+	artRepo repository.ArticleRepository
+	l       logger.LoggerV1
+}
+
+func NewColumnService(repo repository.ColumnRepository,
+	artRepo repository.ArticleRepository, l logger.LoggerV1) ColumnService {
+	return &columnService{
+		repo:    repo,
+		artRepo: artRepo,
+		l:       l,
+	}
+}
+
+func (s *columnService) Save(ctx context.Context, c domain.Column) (int64, error) {
+	if c.Id > 0 {
+		return c.Id, s.repo.Update(ctx, c)
+	}
+	return s.repo.Create(ctx, c)
+}
+
+func (s *columnService) Delete(ctx context.Context, uid int64, id int64) error {
+	return s.repo.Delete(ctx, uid, id)
+}
+
+func (s *columnService) GetById(ctx context.Context, id int64) (domain.Column, error) {
+	return s.repo.FindById(ctx, id)
+}
+
+func (s *columnService) ListByAuthor(ctx context.Context, uid int64) ([]domain.Column, error) {
+	return s.repo.FindByAuthor(ctx, uid)
+}
+
+func (s *columnService) AddArticle(ctx context.Context, uid int64, colId int64, artId int64) error {
+	_, err := s.ownColumn(ctx, uid, colId)
+	if err != nil {
+		return err
+	}
+	art, err := s.artRepo.GetById(ctx, artId)
+	if err == repository.ErrArticleNotFound {
+		return ErrColumnArticleNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if art.Author.Id != uid {
+		s.l.Warn("非法添加别人的文章到专栏",
+			logger.Int64("uid", uid),
+			logger.Int64("cid", colId),
+			logger.Int64("aid", artId))
+		return ErrColumnArticleNotFound
+	}
+	return s.repo.AddArticle(ctx, colId, artId)
+}
+
+func (s *columnService) RemoveArticle(ctx context.Context, uid int64, colId int64, artId int64) error {
+	_, err := s.ownColumn(ctx, uid, colId)
+	if err != nil {
+		return err
+	}
+	err = s.repo.RemoveArticle(ctx, colId, artId)
+	if err == repository.ErrColumnNotFound {
+		// 专栏是有的，是文章不在专栏里面
+		return ErrColumnArticleNotFound
+	}
+	return err
+}
+
+func (s *columnService) Reorder(ctx context.Context, uid int64, colId int64, artIds []int64) error {
+	_, err := s.ownColumn(ctx, uid, colId)
+	if err != nil {
+		return err
+	}
+	return s.repo.Reorder(ctx, colId, artIds)
+}
+
+func (s *columnService) ListPubArticles(ctx context.Context, colId int64,
+	offset int, limit int) ([]domain.Article, error) {
+	return s.repo.ListPubArticles(ctx, colId, offset, limit)
+}
+
+func (s *columnService) Nav(ctx context.Context, artId int64) (domain.ColumnNav, error) {
+	nav, err := s.repo.FindNav(ctx, artId)
+	if err == repository.ErrColumnNotFound {
+		return domain.ColumnNav{}, nil
+	}
+	return nav, err
+}
+
+// ownColumn 别人的专栏也返回 ErrColumnNotFound
+func (s *columnService) ownColumn(ctx context.Context, uid int64, colId int64) (domain.Column, error) {
+	c, err := s.repo.FindById(ctx, colId)
+	if err != nil {
+		return domain.Column{}, err
+	}
+	if c.Author.Id != uid {
+		s.l.Warn("非法操作别人的专栏",
+			logger.Int64("uid", uid),
+			logger.Int64("cid", colId))
+		return domain.Column{}, ErrColumnNotFound
+	}
+	return c, nil
+}
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"webook/internal/domain"
+	"webook/internal/repository"
+	repomocks "webook/internal/repository/mocks"
+	"webook/pkg/logger"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestColumnService_AddArticle(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (repository.ColumnRepository, repository.ArticleRepository)
+
+		uid   int64
+		colId int64
+		artId int64
+
+		wantErr error
+	}{
+		{
+			name: "添加成功",
+			mock: func(ctrl *gomock.Controller) (repository.ColumnRepository, repository.ArticleRepository) {
+				repo := repomocks.NewMockColumnRepository(ctrl)
+				artRepo := repomocks.NewMockArticleRepository(ctrl)
+				repo.EXPECT().FindById(gomock.Any(), int64(2)).
+					Return(domain.Column{Id: 2, Author: domain.Author{Id: 1}}, nil)
+				artRepo.EXPECT().GetById(gomock.Any(), int64(3)).
+					Return(domain.Article{Id: 3, Author: domain.Author{Id: 1}}, nil)
+				repo.EXPECT().AddArticle(gomock.Any(), int64(2), int64(3)).Return(nil)
+				return repo, artRepo
+			},
+			uid:   1,
+			colId: 2,
+			artId: 3,
+		},
+		{
+			name: "别人的专栏",
+			mock: func(ctrl *gomock.Controller) (repository.ColumnRepository, repository.ArticleRepository) {
+				repo := repomocks.NewMockColumnRepository(ctrl)
+				artRepo := repomocks.NewMockArticleRepository(ctrl)
+				repo.EXPECT().FindById(gomock.Any(), int64(2)).
+					Return(domain.Column{Id: 2, Author: domain.Author{Id: 9}}, nil)
+				return repo, artRepo
+			},
+			uid:     1,
+			colId:   2,
+			artId:   3,
+			wantErr: ErrColumnNotFound,
+		},
+		{
+			name: "别人的文章",
+			mock: func(ctrl *gomock.Controller) (repository.ColumnRepository, repository.ArticleRepository) {
+				repo := repomocks.NewMockColumnRepository(ctrl)
+				artRepo := repomocks.NewMockArticleRepository(ctrl)
+				repo.EXPECT().FindById(gomock.Any(), int64(2)).
+					Return(domain.Column{Id: 2, Author: domain.Author{Id: 1}}, nil)
+				artRepo.EXPECT().GetById(gomock.Any(), int64(3)).
+					Return(domain.Article{Id: 3, Author: domain.Author{Id: 9}}, nil)
+				return repo, artRepo
+			},
+			uid:     1,
+			colId:   2,
+			artId:   3,
+			wantErr: ErrColumnArticleNotFound,
+		},
+		{
+			name: "文章已经在专栏里面",
+			mock: func(ctrl *gomock.Controller) (repository.ColumnRepository, repository.ArticleRepository) {
+				repo := repomocks.NewMockColumnRepository(ctrl)
+				artRepo := repomocks.NewMockArticleRepository(ctrl)
+				repo.EXPECT().FindById(gomock.Any(), int64(2)).
+					Return(domain.Column{Id: 2, Author: domain.Author{Id: 1}}, nil)
+				artRepo.EXPECT().GetById(gomock.Any(), int64(3)).
+					Return(domain.Article{Id: 3, Author: domain.Author{Id: 1}}, nil)
+				repo.EXPECT().AddArticle(gomock.Any(), int64(2), int64(3)).
+					Return(repository.ErrArticleAlreadyInColumn)
+				return repo, artRepo
+			},
+			uid:     1,
+			colId:   2,
+			artId:   3,
+			wantErr: ErrArticleAlreadyInColumn,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo, artRepo := tc.mock(ctrl)
+			svc := NewColumnService(repo, artRepo, &logger.NopLogger{})
+			err := svc.AddArticle(context.Background(), tc.uid, tc.colId, tc.artId)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+func TestColumnService_Nav(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	repo := repomocks.NewMockColumnRepository(ctrl)
+	// 不在专栏里面的文章没有导航，也不是错误
+	repo.EXPECT().FindNav(gomock.Any(), int64(3)).
+		Return(domain.ColumnNav{}, repository.ErrColumnNotFound)
+	svc := NewColumnService(repo, nil, &logger.NopLogger{})
+	nav, err := svc.Nav(context.Background(), 3)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.ColumnNav{}, nav)
+}
@@ -0,0 +1,19 @@
+package localemail
+
+import (
+	"context"
+	"log"
+)
+
+// Service 本地开发用的替身，只把邮件内容打印出来
+type Service struct {
+}
+
+func NewService() *Service {
+	return &Service{}
+}
+
+func (s *Service) Send(ctx context.Context, subject string, content string, to ...string) error {
+	log.Println("发送邮件：", to, subject, content)
+	return nil
+}
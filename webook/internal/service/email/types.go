@@ -0,0 +1,8 @@
+package email
+
+import "context"
+
+//go:generate mockgen -source=./types.go -package=emailmocks -destination=./mocks/email.mock.go Service
+type Service interface {
+	Send(ctx context.Context, subject string, content string, to ...string) error
+}
@@ -13,6 +13,8 @@ type CronJobService interface {
 	ResetNextTime(ctx context.Context, j domain.Job) error
 	//Release(ctx context.Context, job domain.Job) error
 	// 暴露 job 的增删改查方法
+	// AddJob 同名的任务已经存在的时候不会覆盖，所以每个实例启动的时候都可以调用
+	AddJob(ctx context.Context, j domain.Job) error
 }
 
 type cronJobService struct {
@@ -58,6 +60,10 @@ func (cjs *cronJobService) ResetNextTime(ctx context.Context, j domain.Job) erro
 	return cjs.cjr.UpdateNextTime(ctx, j.Id, nextTime)
 }
 
+func (cjs *cronJobService) AddJob(ctx context.Context, j domain.Job) error {
+	return cjs.cjr.AddJob(ctx, j)
+}
+
 func (cjs *cronJobService) refresh(id int64) {
 	// 本质上就是更新一下更新时间
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
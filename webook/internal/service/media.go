@@ -0,0 +1,218 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+	"webook/internal/domain"
+	"webook/internal/repository"
+	"webook/pkg/logger"
+	"webook/pkg/objstore"
+
+	"github.com/google/uuid"
+	"golang.org/x/image/draw"
+
+	// 注册图片的解码器
+	_ "image/gif"
+	_ "image/png"
+
+	_ "golang.org/x/image/webp"
+)
+
+var (
+	ErrMediaNotFound       = repository.ErrMediaNotFound
+	ErrMediaTooLarge       = errors.New("文件太大")
+	ErrMediaTypeNotAllowed = errors.New("不支持的文件类型")
+	ErrMediaInvalidImage   = errors.New("图片无法解析")
+)
+
+// allowedMediaTypes 允许上传的类型和保存的扩展名，类型是根据文件内容判断的，不相信客户端
+var allowedMediaTypes = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+	"video/mp4":  ".mp4",
+}
+
+// maxImagePixels 防止解压炸弹，一张很小的图片解码之后可能要几个 G 的内存
+const maxImagePixels = 40_000_000
+
+type MediaConfig struct {
+	// MaxSize 单个文件的最大字节数
+	MaxSize int64
+	// ThumbWidth 缩略图的宽度，比这个窄的图片不会放大
+	ThumbWidth int
+}
+
+//go:generate mockgen -source=./media.go -package=svcmocks -destination=./mocks/media.mock.go MediaService
+type MediaService interface {
+	// Upload 检查类型和大小，图片会生成缩略图
+	Upload(ctx context.Context, uid int64, r io.Reader) (domain.Media, error)
+	GetById(ctx context.Context, id int64) (domain.Media, error)
+	// GetByIds 只返回属于 uid 的，文章只能引用作者自己上传的文件
+	GetByIds(ctx context.Context, uid int64, ids []int64) (map[int64]domain.Media, error)
+	// Open 读取对象存储里面的文件
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+}
+
+type mediaService struct {
+	repo  repository.MediaRepository
+	store objstore.Store
+	cfg   MediaConfig
+	l     logger.LoggerV1
+}
+
+func NewMediaService(repo repository.MediaRepository, store objstore.Store,
+	cfg MediaConfig, l logger.LoggerV1) MediaService {
+	return &mediaService{
+		repo:  repo,
+		store: store,
+		cfg:   cfg,
+		l:     l,
+	}
+}
+
+func (s *mediaService) Upload(ctx context.Context, uid int64, r io.Reader) (domain.Media, error) {
+	// 多读一个字节，读满了说明超过限制
+	data, err := io.ReadAll(io.LimitReader(r, s.cfg.MaxSize+1))
+	if err != nil {
+		return domain.Media{}, err
+	}
+	if int64(len(data)) > s.cfg.MaxSize {
+		return domain.Media{}, ErrMediaTooLarge
+	}
+	ct := http.DetectContentType(data)
+	ext, ok := allowedMediaTypes[ct]
+	if !ok {
+		return domain.Media{}, ErrMediaTypeNotAllowed
+	}
+	name := uuid.NewString()
+	m := domain.Media{
+		Uid:         uid,
+		Key:         fmt.Sprintf("media/%d/%s%s", uid, name, ext),
+		ContentType: ct,
+		Size:        int64(len(data)),
+		Ctime:       time.Now(),
+	}
+	var thumb []byte
+	if m.IsImage() {
+		thumb, m.Width, m.Height, err = s.thumbnail(data)
+		if err != nil {
+			return domain.Media{}, err
+		}
+		m.ThumbKey = fmt.Sprintf("media/%d/%s_thumb.jpg", uid, name)
+	}
+
+	err = s.store.Put(ctx, m.Key, bytes.NewReader(data), m.Size, ct)
+	if err != nil {
+		return domain.Media{}, err
+	}
+	if m.ThumbKey != "" {
+		err = s.store.Put(ctx, m.ThumbKey, bytes.NewReader(thumb), int64(len(thumb)), "image/jpeg")
+		if err != nil {
+			s.cleanup(m)
+			return domain.Media{}, err
+		}
+	}
+	m.Id, err = s.repo.Create(ctx, m)
+	if err != nil {
+		s.cleanup(m)
+		return domain.Media{}, err
+	}
+	return s.withURL(m), nil
+}
+
+// thumbnail 返回 JPEG 格式的缩略图和原图的尺寸
+func (s *mediaService) thumbnail(data []byte) ([]byte, int, int, error) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, 0, 0, ErrMediaInvalidImage
+	}
+	if cfg.Width*cfg.Height > maxImagePixels {
+		return nil, 0, 0, ErrMediaInvalidImage
+	}
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, 0, 0, ErrMediaInvalidImage
+	}
+	w, h := cfg.Width, cfg.Height
+	if w > s.cfg.ThumbWidth {
+		h = h * s.cfg.ThumbWidth / w
+		w = s.cfg.ThumbWidth
+	}
+	if h < 1 {
+		h = 1
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, src.Bounds(), draw.Over, nil)
+	var buf bytes.Buffer
+	err = jpeg.Encode(&buf, dst, &jpeg.Options{Quality: 80})
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	return buf.Bytes(), cfg.Width, cfg.Height, nil
+}
+
+// cleanup 上传失败的时候尽量删掉已经写进去的文件，删不掉也只是浪费点空间
+func (s *mediaService) cleanup(m domain.Media) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	for _, key := range []string{m.Key, m.ThumbKey} {
+		if key == "" {
+			continue
+		}
+		err := s.store.Delete(ctx, key)
+		if err != nil {
+			s.l.Warn("删除上传失败的文件失败",
+				logger.String("key", key),
+				logger.Error(err))
+		}
+	}
+}
+
+func (s *mediaService) GetById(ctx context.Context, id int64) (domain.Media, error) {
+	m, err := s.repo.FindById(ctx, id)
+	if err != nil {
+		return domain.Media{}, err
+	}
+	return s.withURL(m), nil
+}
+
+func (s *mediaService) GetByIds(ctx context.Context, uid int64, ids []int64) (map[int64]domain.Media, error) {
+	ms, err := s.repo.FindByIds(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[int64]domain.Media, len(ms))
+	for _, m := range ms {
+		if m.Uid != uid {
+			continue
+		}
+		res[m.Id] = s.withURL(m)
+	}
+	return res, nil
+}
+
+func (s *mediaService) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	// 对象存储里面还有用户导出的个人数据，这里只能读媒体文件
+	if !strings.HasPrefix(key, "media/") {
+		return nil, objstore.ErrObjectNotFound
+	}
+	return s.store.Get(ctx, key)
+}
+
+func (s *mediaService) withURL(m domain.Media) domain.Media {
+	m.Url = s.store.URL(m.Key)
+	if m.ThumbKey != "" {
+		m.ThumbUrl = s.store.URL(m.ThumbKey)
+	}
+	return m
+}
@@ -0,0 +1,111 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+	"webook/internal/domain"
+	"webook/internal/repository"
+	repomocks "webook/internal/repository/mocks"
+	"webook/pkg/logger"
+	"webook/pkg/objstore"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMediaService_Upload(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) repository.MediaRepository
+		data []byte
+
+		wantErr  error
+		wantType string
+		// wantThumb 缩略图的宽度，0 表示没有缩略图
+		wantThumb int
+	}{
+		{
+			name: "上传图片，生成缩略图",
+			mock: func(ctrl *gomock.Controller) repository.MediaRepository {
+				repo := repomocks.NewMockMediaRepository(ctrl)
+				repo.EXPECT().Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, m domain.Media) (int64, error) {
+						assert.Equal(t, 64, m.Width)
+						assert.Equal(t, 32, m.Height)
+						return 1, nil
+					})
+				return repo
+			},
+			data:      testPNG(t, 64, 32),
+			wantType:  "image/png",
+			wantThumb: 16,
+		},
+		{
+			name: "类型不对",
+			mock: func(ctrl *gomock.Controller) repository.MediaRepository {
+				return repomocks.NewMockMediaRepository(ctrl)
+			},
+			data:    []byte("<html><script>alert(1)</script></html>"),
+			wantErr: ErrMediaTypeNotAllowed,
+		},
+		{
+			name: "文件太大",
+			mock: func(ctrl *gomock.Controller) repository.MediaRepository {
+				return repomocks.NewMockMediaRepository(ctrl)
+			},
+			data:    []byte(strings.Repeat("a", 1025)),
+			wantErr: ErrMediaTooLarge,
+		},
+		{
+			name: "伪装成图片",
+			mock: func(ctrl *gomock.Controller) repository.MediaRepository {
+				return repomocks.NewMockMediaRepository(ctrl)
+			},
+			// PNG 的文件头，但是后面不是图片
+			data:    append([]byte("\x89PNG\x0D\x0A\x1A\x0A"), []byte("not an image")...),
+			wantErr: ErrMediaInvalidImage,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			store := objstore.NewLocalStore(t.TempDir(), "/media/files")
+			svc := NewMediaService(tc.mock(ctrl), store, MediaConfig{
+				MaxSize:    1024,
+				ThumbWidth: 16,
+			}, &logger.NopLogger{})
+			m, err := svc.Upload(context.Background(), 123, bytes.NewReader(tc.data))
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			assert.Equal(t, tc.wantType, m.ContentType)
+			assert.Equal(t, "/media/files/"+m.Key, m.Url)
+			r, err := store.Get(context.Background(), m.ThumbKey)
+			require.NoError(t, err)
+			defer r.Close()
+			thumb, _, err := image.Decode(r)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantThumb, thumb.Bounds().Dx())
+		})
+	}
+}
+
+func testPNG(t *testing.T, w, h int) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for x := 0; x < w; x++ {
+		for y := 0; y < h; y++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./article_review.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./article_review.go -package=svcmocks -destination=./mocks/article_review.mock.go ArticleReviewService
+//
+
+// Package svcmocks is a generated GoMock package.
+package svcmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	domain "webook/internal/domain"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockArticleReviewService is a mock of ArticleReviewService interface.
+type MockArticleReviewService struct {
+	ctrl     *gomock.Controller
+	recorder *MockArticleReviewServiceMockRecorder
+}
+
+// MockArticleReviewServiceMockRecorder is the mock recorder for MockArticleReviewService.
+type MockArticleReviewServiceMockRecorder struct {
+	mock *MockArticleReviewService
+}
+
+// NewMockArticleReviewService creates a new mock instance.
+func NewMockArticleReviewService(ctrl *gomock.Controller) *MockArticleReviewService {
+	mock := &MockArticleReviewService{ctrl: ctrl}
+	mock.recorder = &MockArticleReviewServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockArticleReviewService) EXPECT() *MockArticleReviewServiceMockRecorder {
+	return m.recorder
+}
+
+// Approve mocks base method.
+func (m *MockArticleReviewService) Approve(ctx context.Context, reviewer, id int64, comment string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Approve", ctx, reviewer, id, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Approve indicates an expected call of Approve.
+func (mr *MockArticleReviewServiceMockRecorder) Approve(ctx, reviewer, id, comment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Approve", reflect.TypeOf((*MockArticleReviewService)(nil).Approve), ctx, reviewer, id, comment)
+}
+
+// Detail mocks base method.
+func (m *MockArticleReviewService) Detail(ctx context.Context, id int64) (domain.ArticleReview, domain.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Detail", ctx, id)
+	ret0, _ := ret[0].(domain.ArticleReview)
+	ret1, _ := ret[1].(domain.Article)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Detail indicates an expected call of Detail.
+func (mr *MockArticleReviewServiceMockRecorder) Detail(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Detail", reflect.TypeOf((*MockArticleReviewService)(nil).Detail), ctx, id)
+}
+
+// ListPending mocks base method.
+func (m *MockArticleReviewService) ListPending(ctx context.Context, offset, limit int) ([]domain.ArticleReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPending", ctx, offset, limit)
+	ret0, _ := ret[0].([]domain.ArticleReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPending indicates an expected call of ListPending.
+func (mr *MockArticleReviewServiceMockRecorder) ListPending(ctx, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPending", reflect.TypeOf((*MockArticleReviewService)(nil).ListPending), ctx, offset, limit)
+}
+
+// Reject mocks base method.
+func (m *MockArticleReviewService) Reject(ctx context.Context, reviewer, id int64, comment string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reject", ctx, reviewer, id, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reject indicates an expected call of Reject.
+func (mr *MockArticleReviewServiceMockRecorder) Reject(ctx, reviewer, id, comment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reject", reflect.TypeOf((*MockArticleReviewService)(nil).Reject), ctx, reviewer, id, comment)
+}
@@ -0,0 +1,172 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./column.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./column.go -package=svcmocks -destination=./mocks/column.mock.go ColumnService
+//
+
+// Package svcmocks is a generated GoMock package.
+package svcmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	domain "webook/internal/domain"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockColumnService is a mock of ColumnService interface.
+type MockColumnService struct {
+	ctrl     *gomock.Controller
+	recorder *MockColumnServiceMockRecorder
+}
+
+// MockColumnServiceMockRecorder is the mock recorder for MockColumnService.
+type MockColumnServiceMockRecorder struct {
+	mock *MockColumnService
+}
+
+// NewMockColumnService creates a new mock instance.
+func NewMockColumnService(ctrl *gomock.Controller) *MockColumnService {
+	mock := &MockColumnService{ctrl: ctrl}
+	mock.recorder = &MockColumnServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockColumnService) EXPECT() *MockColumnServiceMockRecorder {
+	return m.recorder
+}
+
+// AddArticle mocks base method.
+func (m *MockColumnService) AddArticle(ctx context.Context, uid, colId, artId int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddArticle", ctx, uid, colId, artId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddArticle indicates an expected call of AddArticle.
+func (mr *MockColumnServiceMockRecorder) AddArticle(ctx, uid, colId, artId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddArticle", reflect.TypeOf((*MockColumnService)(nil).AddArticle), ctx, uid, colId, artId)
+}
+
+// Delete mocks base method.
+func (m *MockColumnService) Delete(ctx context.Context, uid, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, uid, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockColumnServiceMockRecorder) Delete(ctx, uid, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockColumnService)(nil).Delete), ctx, uid, id)
+}
+
+// GetById mocks base method.
+func (m *MockColumnService) GetById(ctx context.Context, id int64) (domain.Column, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", ctx, id)
+	ret0, _ := ret[0].(domain.Column)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockColumnServiceMockRecorder) GetById(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockColumnService)(nil).GetById), ctx, id)
+}
+
+// ListByAuthor mocks base method.
+func (m *MockColumnService) ListByAuthor(ctx context.Context, uid int64) ([]domain.Column, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByAuthor", ctx, uid)
+	ret0, _ := ret[0].([]domain.Column)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByAuthor indicates an expected call of ListByAuthor.
+func (mr *MockColumnServiceMockRecorder) ListByAuthor(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByAuthor", reflect.TypeOf((*MockColumnService)(nil).ListByAuthor), ctx, uid)
+}
+
+// ListPubArticles mocks base method.
+func (m *MockColumnService) ListPubArticles(ctx context.Context, colId int64, offset, limit int) ([]domain.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPubArticles", ctx, colId, offset, limit)
+	ret0, _ := ret[0].([]domain.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPubArticles indicates an expected call of ListPubArticles.
+func (mr *MockColumnServiceMockRecorder) ListPubArticles(ctx, colId, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPubArticles", reflect.TypeOf((*MockColumnService)(nil).ListPubArticles), ctx, colId, offset, limit)
+}
+
+// Nav mocks base method.
+func (m *MockColumnService) Nav(ctx context.Context, artId int64) (domain.ColumnNav, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Nav", ctx, artId)
+	ret0, _ := ret[0].(domain.ColumnNav)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Nav indicates an expected call of Nav.
+func (mr *MockColumnServiceMockRecorder) Nav(ctx, artId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Nav", reflect.TypeOf((*MockColumnService)(nil).Nav), ctx, artId)
+}
+
+// RemoveArticle mocks base method.
+func (m *MockColumnService) RemoveArticle(ctx context.Context, uid, colId, artId int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveArticle", ctx, uid, colId, artId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveArticle indicates an expected call of RemoveArticle.
+func (mr *MockColumnServiceMockRecorder) RemoveArticle(ctx, uid, colId, artId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveArticle", reflect.TypeOf((*MockColumnService)(nil).RemoveArticle), ctx, uid, colId, artId)
+}
+
+// Reorder mocks base method.
+func (m *MockColumnService) Reorder(ctx context.Context, uid, colId int64, artIds []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reorder", ctx, uid, colId, artIds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reorder indicates an expected call of Reorder.
+func (mr *MockColumnServiceMockRecorder) Reorder(ctx, uid, colId, artIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reorder", reflect.TypeOf((*MockColumnService)(nil).Reorder), ctx, uid, colId, artIds)
+}
+
+// Save mocks base method.
+func (m *MockColumnService) Save(ctx context.Context, c domain.Column) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, c)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockColumnServiceMockRecorder) Save(ctx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockColumnService)(nil).Save), ctx, c)
+}
@@ -40,6 +40,20 @@ func (m *MockInteractiveService) EXPECT() *MockInteractiveServiceMockRecorder {
 	return m.recorder
 }
 
+// CancelCollect mocks base method.
+func (m *MockInteractiveService) CancelCollect(ctx context.Context, biz string, bizId, uid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelCollect", ctx, biz, bizId, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelCollect indicates an expected call of CancelCollect.
+func (mr *MockInteractiveServiceMockRecorder) CancelCollect(ctx, biz, bizId, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelCollect", reflect.TypeOf((*MockInteractiveService)(nil).CancelCollect), ctx, biz, bizId, uid)
+}
+
 // CancelLike mocks base method.
 func (m *MockInteractiveService) CancelLike(ctx context.Context, biz string, bizId, uid int64) error {
 	m.ctrl.T.Helper()
@@ -68,6 +82,20 @@ func (mr *MockInteractiveServiceMockRecorder) Collect(ctx, biz, bizId, cid, uid
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Collect", reflect.TypeOf((*MockInteractiveService)(nil).Collect), ctx, biz, bizId, cid, uid)
 }
 
+// DeleteUserData mocks base method.
+func (m *MockInteractiveService) DeleteUserData(ctx context.Context, uid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserData", ctx, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserData indicates an expected call of DeleteUserData.
+func (mr *MockInteractiveServiceMockRecorder) DeleteUserData(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserData", reflect.TypeOf((*MockInteractiveService)(nil).DeleteUserData), ctx, uid)
+}
+
 // Get mocks base method.
 func (m *MockInteractiveService) Get(ctx context.Context, biz string, bizId, uid int64) (domain.Interactive, error) {
 	m.ctrl.T.Helper()
@@ -98,6 +126,22 @@ func (mr *MockInteractiveServiceMockRecorder) GetByIds(ctx, biz, ids any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIds", reflect.TypeOf((*MockInteractiveService)(nil).GetByIds), ctx, biz, ids)
 }
 
+// GetUserData mocks base method.
+func (m *MockInteractiveService) GetUserData(ctx context.Context, uid int64) ([]domain.UserLike, []domain.UserCollection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserData", ctx, uid)
+	ret0, _ := ret[0].([]domain.UserLike)
+	ret1, _ := ret[1].([]domain.UserCollection)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserData indicates an expected call of GetUserData.
+func (mr *MockInteractiveServiceMockRecorder) GetUserData(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserData", reflect.TypeOf((*MockInteractiveService)(nil).GetUserData), ctx, uid)
+}
+
 // IncrReadCnt mocks base method.
 func (m *MockInteractiveService) IncrReadCnt(ctx context.Context, biz string, bizId int64) error {
 	m.ctrl.T.Helper()
@@ -125,3 +169,47 @@ func (mr *MockInteractiveServiceMockRecorder) Like(ctx, biz, bizId, uid any) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Like", reflect.TypeOf((*MockInteractiveService)(nil).Like), ctx, biz, bizId, uid)
 }
+
+// ListUserCollections mocks base method.
+func (m *MockInteractiveService) ListUserCollections(ctx context.Context, uid int64, offset, limit int) ([]domain.UserCollection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserCollections", ctx, uid, offset, limit)
+	ret0, _ := ret[0].([]domain.UserCollection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserCollections indicates an expected call of ListUserCollections.
+func (mr *MockInteractiveServiceMockRecorder) ListUserCollections(ctx, uid, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserCollections", reflect.TypeOf((*MockInteractiveService)(nil).ListUserCollections), ctx, uid, offset, limit)
+}
+
+// ListUserLikes mocks base method.
+func (m *MockInteractiveService) ListUserLikes(ctx context.Context, uid int64, offset, limit int) ([]domain.UserLike, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserLikes", ctx, uid, offset, limit)
+	ret0, _ := ret[0].([]domain.UserLike)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserLikes indicates an expected call of ListUserLikes.
+func (mr *MockInteractiveServiceMockRecorder) ListUserLikes(ctx, uid, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserLikes", reflect.TypeOf((*MockInteractiveService)(nil).ListUserLikes), ctx, uid, offset, limit)
+}
+
+// MergeUserData mocks base method.
+func (m *MockInteractiveService) MergeUserData(ctx context.Context, sourceUid, targetUid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeUserData", ctx, sourceUid, targetUid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MergeUserData indicates an expected call of MergeUserData.
+func (mr *MockInteractiveServiceMockRecorder) MergeUserData(ctx, sourceUid, targetUid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeUserData", reflect.TypeOf((*MockInteractiveService)(nil).MergeUserData), ctx, sourceUid, targetUid)
+}
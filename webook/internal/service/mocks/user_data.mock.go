@@ -0,0 +1,161 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./user_data.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./user_data.go -package=svcmocks -destination=./mocks/user_data.mock.go UserDataService
+//
+
+// Package svcmocks is a generated GoMock package.
+package svcmocks
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+	time "time"
+	domain "webook/internal/domain"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserDataService is a mock of UserDataService interface.
+type MockUserDataService struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserDataServiceMockRecorder
+}
+
+// MockUserDataServiceMockRecorder is the mock recorder for MockUserDataService.
+type MockUserDataServiceMockRecorder struct {
+	mock *MockUserDataService
+}
+
+// NewMockUserDataService creates a new mock instance.
+func NewMockUserDataService(ctrl *gomock.Controller) *MockUserDataService {
+	mock := &MockUserDataService{ctrl: ctrl}
+	mock.recorder = &MockUserDataServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserDataService) EXPECT() *MockUserDataServiceMockRecorder {
+	return m.recorder
+}
+
+// CancelDeletion mocks base method.
+func (m *MockUserDataService) CancelDeletion(ctx context.Context, uid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelDeletion", ctx, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelDeletion indicates an expected call of CancelDeletion.
+func (mr *MockUserDataServiceMockRecorder) CancelDeletion(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelDeletion", reflect.TypeOf((*MockUserDataService)(nil).CancelDeletion), ctx, uid)
+}
+
+// DeleteDue mocks base method.
+func (m *MockUserDataService) DeleteDue(ctx context.Context, now time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDue", ctx, now)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDue indicates an expected call of DeleteDue.
+func (mr *MockUserDataServiceMockRecorder) DeleteDue(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDue", reflect.TypeOf((*MockUserDataService)(nil).DeleteDue), ctx, now)
+}
+
+// ExpireExports mocks base method.
+func (m *MockUserDataService) ExpireExports(ctx context.Context, now time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireExports", ctx, now)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireExports indicates an expected call of ExpireExports.
+func (mr *MockUserDataServiceMockRecorder) ExpireExports(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireExports", reflect.TypeOf((*MockUserDataService)(nil).ExpireExports), ctx, now)
+}
+
+// Export mocks base method.
+func (m *MockUserDataService) Export(ctx context.Context, uid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockUserDataServiceMockRecorder) Export(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockUserDataService)(nil).Export), ctx, uid)
+}
+
+// GetExport mocks base method.
+func (m *MockUserDataService) GetExport(ctx context.Context, uid int64) (domain.UserExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExport", ctx, uid)
+	ret0, _ := ret[0].(domain.UserExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExport indicates an expected call of GetExport.
+func (mr *MockUserDataServiceMockRecorder) GetExport(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExport", reflect.TypeOf((*MockUserDataService)(nil).GetExport), ctx, uid)
+}
+
+// OpenExport mocks base method.
+func (m *MockUserDataService) OpenExport(ctx context.Context, uid int64) (domain.UserExport, io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenExport", ctx, uid)
+	ret0, _ := ret[0].(domain.UserExport)
+	ret1, _ := ret[1].(io.ReadCloser)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// OpenExport indicates an expected call of OpenExport.
+func (mr *MockUserDataServiceMockRecorder) OpenExport(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenExport", reflect.TypeOf((*MockUserDataService)(nil).OpenExport), ctx, uid)
+}
+
+// RequestDeletion mocks base method.
+func (m *MockUserDataService) RequestDeletion(ctx context.Context, uid int64, password, code string) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestDeletion", ctx, uid, password, code)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestDeletion indicates an expected call of RequestDeletion.
+func (mr *MockUserDataServiceMockRecorder) RequestDeletion(ctx, uid, password, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestDeletion", reflect.TypeOf((*MockUserDataService)(nil).RequestDeletion), ctx, uid, password, code)
+}
+
+// RequestExport mocks base method.
+func (m *MockUserDataService) RequestExport(ctx context.Context, uid int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestExport", ctx, uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestExport indicates an expected call of RequestExport.
+func (mr *MockUserDataServiceMockRecorder) RequestExport(ctx, uid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestExport", reflect.TypeOf((*MockUserDataService)(nil).RequestExport), ctx, uid)
+}
@@ -0,0 +1,37 @@
+package moderation
+
+import (
+	"context"
+	"webook/internal/domain"
+	"webook/pkg/logger"
+)
+
+// Chain 依次调用所有的 Moderator，合并所有的理由
+type Chain struct {
+	ms []Moderator
+	l  logger.LoggerV1
+}
+
+func NewChain(l logger.LoggerV1, ms ...Moderator) Moderator {
+	return &Chain{
+		ms: ms,
+		l:  l,
+	}
+}
+
+func (c *Chain) Check(ctx context.Context, art domain.Article) (Result, error) {
+	var res Result
+	for _, m := range c.ms {
+		r, err := m.Check(ctx, art)
+		if err != nil {
+			// 外部的分类服务出问题的时候不能直接放过，转人工审核
+			c.l.Error("自动审核失败",
+				logger.Int64("aid", art.Id),
+				logger.Error(err))
+			res.Reasons = append(res.Reasons, "自动审核失败")
+			continue
+		}
+		res.Reasons = append(res.Reasons, r.Reasons...)
+	}
+	return res, nil
+}
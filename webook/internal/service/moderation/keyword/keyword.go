@@ -0,0 +1,64 @@
+package keyword
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"webook/internal/domain"
+	"webook/internal/service/moderation"
+	"webook/pkg/ahocorasick"
+	"webook/pkg/mdx"
+
+	"golang.org/x/text/width"
+)
+
+// Moderator 敏感词和正则过滤，标题和正文都会检查。
+// 敏感词一般有成千上万个，用 Aho-Corasick 扫一遍就够了；
+// 正则用来处理手机号、网址这种没法穷举的内容，数量不宜太多。
+// 正文检查的是去掉 Markdown 标记之后的纯文本，并且全角转半角、统一小写，
+// 避免用 **加粗** 或者全角字符把敏感词拆开绕过
+type Moderator struct {
+	words    *ahocorasick.Matcher
+	patterns []*regexp.Regexp
+}
+
+func NewModerator(words []string, patterns []string) (moderation.Moderator, error) {
+	res := &Moderator{
+		words:    ahocorasick.New(words),
+		patterns: make([]*regexp.Regexp, 0, len(patterns)),
+	}
+	for _, p := range patterns {
+		reg, err := regexp.Compile(p)
+		if err != nil {
+			return nil, err
+		}
+		res.patterns = append(res.patterns, reg)
+	}
+	return res, nil
+}
+
+func (m *Moderator) Check(ctx context.Context, art domain.Article) (moderation.Result, error) {
+	var res moderation.Result
+	// 同一个词命中多次只报一次
+	seen := make(map[string]struct{})
+	texts := []string{normalize(art.Title), normalize(mdx.PlainText(art.Content))}
+	for _, text := range texts {
+		for _, match := range m.words.FindAll(text) {
+			if _, ok := seen[match.Pattern]; ok {
+				continue
+			}
+			seen[match.Pattern] = struct{}{}
+			res.Reasons = append(res.Reasons, "敏感词："+match.Pattern)
+		}
+	}
+	for _, reg := range m.patterns {
+		if reg.MatchString(texts[0]) || reg.MatchString(texts[1]) {
+			res.Reasons = append(res.Reasons, "命中规则："+reg.String())
+		}
+	}
+	return res, nil
+}
+
+func normalize(text string) string {
+	return strings.ToLower(width.Fold.String(text))
+}
@@ -0,0 +1,64 @@
+package keyword
+
+import (
+	"context"
+	"testing"
+	"webook/internal/domain"
+	"webook/internal/service/moderation"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModerator_Check(t *testing.T) {
+	const phone = `(?:^|\D)1[3-9]\d{9}(?:\D|$)`
+	m, err := NewModerator([]string{"赌博", "代开发票", "casino"}, []string{phone})
+	require.NoError(t, err)
+	testCases := []struct {
+		name string
+		art  domain.Article
+		want moderation.Result
+	}{
+		{
+			name: "正常的文章",
+			art:  domain.Article{Title: "Go 并发", Content: "channel 和 select"},
+		},
+		{
+			name: "标题和正文都有敏感词",
+			art:  domain.Article{Title: "赌博", Content: "网上赌博，代开发票"},
+			want: moderation.Result{Reasons: []string{"敏感词：赌博", "敏感词：代开发票"}},
+		},
+		{
+			name: "命中正则",
+			art:  domain.Article{Title: "联系我", Content: "电话 13812345678"},
+			want: moderation.Result{Reasons: []string{"命中规则：" + phone}},
+		},
+		{
+			name: "用 Markdown 标记拆开敏感词",
+			art:  domain.Article{Title: "标题", Content: "网上**赌**博"},
+			want: moderation.Result{Reasons: []string{"敏感词：赌博"}},
+		},
+		{
+			name: "全角字符和大写",
+			art:  domain.Article{Title: "ＣＡＳＩＮＯ", Content: "电话 １３８１２３４５６７８"},
+			want: moderation.Result{Reasons: []string{"敏感词：casino", "命中规则：" + phone}},
+		},
+		{
+			name: "更长的数字里面的手机号不算",
+			art:  domain.Article{Title: "订单", Content: "订单号 2013812345678901"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := m.Check(context.Background(), tc.art)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestNewModerator(t *testing.T) {
+	_, err := NewModerator(nil, []string{"("})
+	assert.Error(t, err)
+}
@@ -0,0 +1,23 @@
+package moderation
+
+import (
+	"context"
+	"webook/internal/domain"
+)
+
+// Moderator 发表之前的自动审核。关键词过滤和外部的内容分类服务都实现这个接口，
+// 有任何一个理由就要转人工审核
+//
+//go:generate mockgen -source=./types.go -package=moderationmocks -destination=./mocks/moderation.mock.go Moderator
+type Moderator interface {
+	Check(ctx context.Context, art domain.Article) (Result, error)
+}
+
+type Result struct {
+	// Reasons 为什么要人工审核，给审核的人看的
+	Reasons []string
+}
+
+func (r Result) Flagged() bool {
+	return len(r.Reasons) > 0
+}
@@ -0,0 +1,116 @@
+package oauth2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Config 一个标准 OAuth2 客户端需要的配置
+type Config struct {
+	ClientId     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
+}
+
+// Token 授权码换来的 token，字段参考 RFC 6749 5.1
+type Token struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+	Scope       string `json:"scope"`
+	// IdToken 只有 OIDC 才有
+	IdToken string `json:"id_token"`
+
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// BuildAuthURL 拼接授权链接，统一带上 PKCE 的参数
+func BuildAuthURL(endpoint string, cfg Config, state string, codeChallenge string,
+	extra url.Values) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", err
+	}
+	q := u.Query()
+	q.Set("response_type", "code")
+	q.Set("client_id", cfg.ClientId)
+	q.Set("redirect_uri", cfg.RedirectURL)
+	q.Set("state", state)
+	q.Set("code_challenge", codeChallenge)
+	q.Set("code_challenge_method", "S256")
+	if len(cfg.Scopes) > 0 {
+		q.Set("scope", strings.Join(cfg.Scopes, " "))
+	}
+	for key, vals := range extra {
+		for _, val := range vals {
+			q.Add(key, val)
+		}
+	}
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// ExchangeCode 用授权码换 token
+func ExchangeCode(ctx context.Context, client *http.Client, endpoint string,
+	cfg Config, code string, codeVerifier string) (Token, error) {
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", cfg.RedirectURL)
+	form.Set("client_id", cfg.ClientId)
+	form.Set("client_secret", cfg.ClientSecret)
+	form.Set("code_verifier", codeVerifier)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint,
+		strings.NewReader(form.Encode()))
+	if err != nil {
+		return Token{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	// GitHub 默认返回的是 form 格式，要明确要求 JSON
+	req.Header.Set("Accept", "application/json")
+	var token Token
+	err = doJSON(client, req, &token)
+	if err != nil {
+		return Token{}, err
+	}
+	if token.Error != "" {
+		return Token{}, fmt.Errorf("换取 token 失败 %s: %s", token.Error, token.ErrorDescription)
+	}
+	if token.AccessToken == "" {
+		return Token{}, fmt.Errorf("换取 token 失败，没有 access_token")
+	}
+	return token, nil
+}
+
+// GetJSON 带着 access token 调用第三方的接口
+func GetJSON(ctx context.Context, client *http.Client, endpoint string,
+	accessToken string, val any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+	}
+	return doJSON(client, req, val)
+}
+
+func doJSON(client *http.Client, req *http.Request, val any) error {
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// 4xx 的时候很多第三方也会返回 JSON 格式的错误，交给调用方处理
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("调用 %s 失败，状态码 %d", req.URL.Host, resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(val)
+}
@@ -0,0 +1,81 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"webook/internal/domain"
+	"webook/internal/service/oauth2"
+)
+
+const (
+	authURL  = "https://github.com/login/oauth/authorize"
+	tokenURL = "https://github.com/login/oauth/access_token"
+	userURL  = "https://api.github.com/user"
+)
+
+// Provider GitHub 是标准的 OAuth2，没有 OIDC，用户信息要单独调接口获取
+type Provider struct {
+	name   string
+	cfg    oauth2.Config
+	client *http.Client
+
+	// 测试的时候换成本地的服务器
+	authURL  string
+	tokenURL string
+	userURL  string
+}
+
+func NewProvider(name string, cfg oauth2.Config) *Provider {
+	if len(cfg.Scopes) == 0 {
+		cfg.Scopes = []string{"read:user", "user:email"}
+	}
+	return &Provider{
+		name:   name,
+		cfg:    cfg,
+		client: http.DefaultClient,
+
+		authURL:  authURL,
+		tokenURL: tokenURL,
+		userURL:  userURL,
+	}
+}
+
+func (p *Provider) Name() string {
+	return p.name
+}
+
+func (p *Provider) AuthURL(ctx context.Context, state string, codeChallenge string) (string, error) {
+	return oauth2.BuildAuthURL(p.authURL, p.cfg, state, codeChallenge, nil)
+}
+
+func (p *Provider) VerifyCode(ctx context.Context, code string, codeVerifier string) (domain.ExternalIdentity, error) {
+	token, err := oauth2.ExchangeCode(ctx, p.client, p.tokenURL, p.cfg, code, codeVerifier)
+	if err != nil {
+		return domain.ExternalIdentity{}, err
+	}
+	var user User
+	err = oauth2.GetJSON(ctx, p.client, p.userURL, token.AccessToken, &user)
+	if err != nil {
+		return domain.ExternalIdentity{}, err
+	}
+	if user.Id == 0 {
+		return domain.ExternalIdentity{}, fmt.Errorf("获取 GitHub 用户信息失败 %s", user.Message)
+	}
+	return domain.ExternalIdentity{
+		Provider: p.name,
+		// login 是可以改的，只有 id 不会变
+		Subject:  strconv.FormatInt(user.Id, 10),
+		Email:    user.Email,
+		Nickname: user.Login,
+	}, nil
+}
+
+type User struct {
+	Id    int64  `json:"id"`
+	Login string `json:"login"`
+	Email string `json:"email"`
+
+	Message string `json:"message"`
+}
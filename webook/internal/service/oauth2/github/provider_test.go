@@ -0,0 +1,127 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"webook/internal/domain"
+	"webook/internal/service/oauth2"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProvider_VerifyCode(t *testing.T) {
+	verifier, err := oauth2.GenerateCodeVerifier()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		verifier string
+		user     func(w http.ResponseWriter)
+
+		wantErr      bool
+		wantIdentity domain.ExternalIdentity
+	}{
+		{
+			name:     "登录成功",
+			verifier: verifier,
+			user: func(w http.ResponseWriter) {
+				_ = json.NewEncoder(w).Encode(User{
+					Id:    10086,
+					Login: "tom",
+					Email: "123@qq.com",
+				})
+			},
+			wantIdentity: domain.ExternalIdentity{
+				Provider: "github",
+				Subject:  "10086",
+				Email:    "123@qq.com",
+				Nickname: "tom",
+			},
+		},
+		{
+			name:     "code_verifier 不对",
+			verifier: "wrong",
+			wantErr:  true,
+		},
+		{
+			name:     "token 失效了",
+			verifier: verifier,
+			user: func(w http.ResponseWriter) {
+				w.WriteHeader(http.StatusUnauthorized)
+				_ = json.NewEncoder(w).Encode(User{Message: "Bad credentials"})
+			},
+			wantErr: true,
+		},
+		{
+			name:     "GitHub 挂了",
+			verifier: verifier,
+			user: func(w http.ResponseWriter) {
+				w.WriteHeader(http.StatusBadGateway)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/token":
+					_ = r.ParseForm()
+					// 模拟 GitHub 校验 PKCE
+					if r.PostForm.Get("code") != "abc" ||
+						oauth2.CodeChallenge(r.PostForm.Get("code_verifier")) != oauth2.CodeChallenge(verifier) {
+						_ = json.NewEncoder(w).Encode(oauth2.Token{Error: "bad_verification_code"})
+						return
+					}
+					_ = json.NewEncoder(w).Encode(oauth2.Token{AccessToken: "token"})
+				case "/user":
+					if r.Header.Get("Authorization") != "Bearer token" {
+						w.WriteHeader(http.StatusUnauthorized)
+						return
+					}
+					tc.user(w)
+				}
+			}))
+			defer server.Close()
+
+			p := NewProvider("github", oauth2.Config{
+				ClientId:    "client",
+				RedirectURL: "http://localhost/oauth2/github/callback",
+			})
+			p.tokenURL = server.URL + "/token"
+			p.userURL = server.URL + "/user"
+			ei, err := p.VerifyCode(context.Background(), "abc", tc.verifier)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantIdentity, ei)
+		})
+	}
+}
+
+func TestProvider_AuthURL(t *testing.T) {
+	p := NewProvider("github", oauth2.Config{
+		ClientId:    "client",
+		RedirectURL: "http://localhost/oauth2/github/callback",
+	})
+	res, err := p.AuthURL(context.Background(), "state", "challenge")
+	require.NoError(t, err)
+	u, err := url.Parse(res)
+	require.NoError(t, err)
+	assert.Equal(t, "github.com", u.Host)
+	q := u.Query()
+	assert.Equal(t, "client", q.Get("client_id"))
+	assert.Equal(t, "state", q.Get("state"))
+	assert.Equal(t, "challenge", q.Get("code_challenge"))
+	assert.Equal(t, "S256", q.Get("code_challenge_method"))
+	// 没有配置 scope 的时候用默认的
+	assert.Equal(t, "read:user user:email", q.Get("scope"))
+}
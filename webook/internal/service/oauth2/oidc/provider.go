@@ -0,0 +1,135 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"webook/internal/domain"
+	"webook/internal/service/oauth2"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Provider 通用的 OpenID Connect 第三方，endpoint 通过 discovery 获取
+type Provider struct {
+	name   string
+	issuer string
+	cfg    oauth2.Config
+	client *http.Client
+
+	mu        sync.Mutex
+	discovery *Discovery
+}
+
+func NewProvider(name string, issuer string, cfg oauth2.Config) *Provider {
+	if len(cfg.Scopes) == 0 {
+		cfg.Scopes = []string{"openid", "email", "profile"}
+	}
+	return &Provider{
+		name:   name,
+		issuer: strings.TrimSuffix(issuer, "/"),
+		cfg:    cfg,
+		client: http.DefaultClient,
+	}
+}
+
+func (p *Provider) Name() string {
+	return p.name
+}
+
+func (p *Provider) AuthURL(ctx context.Context, state string, codeChallenge string) (string, error) {
+	d, err := p.getDiscovery(ctx)
+	if err != nil {
+		return "", err
+	}
+	return oauth2.BuildAuthURL(d.AuthorizationEndpoint, p.cfg, state, codeChallenge, nil)
+}
+
+func (p *Provider) VerifyCode(ctx context.Context, code string, codeVerifier string) (domain.ExternalIdentity, error) {
+	d, err := p.getDiscovery(ctx)
+	if err != nil {
+		return domain.ExternalIdentity{}, err
+	}
+	token, err := oauth2.ExchangeCode(ctx, p.client, d.TokenEndpoint, p.cfg, code, codeVerifier)
+	if err != nil {
+		return domain.ExternalIdentity{}, err
+	}
+	if token.IdToken == "" {
+		return domain.ExternalIdentity{}, errors.New("第三方没有返回 id_token")
+	}
+	claims, err := p.parseIdToken(token.IdToken)
+	if err != nil {
+		return domain.ExternalIdentity{}, err
+	}
+	nickname := claims.Name
+	if nickname == "" {
+		nickname = claims.PreferredUsername
+	}
+	ei := domain.ExternalIdentity{
+		Provider: p.name,
+		Subject:  claims.Subject,
+		Nickname: nickname,
+	}
+	// 没有验证过的邮箱不能用，不然可以冒充别人
+	if claims.EmailVerified {
+		ei.Email = claims.Email
+	}
+	return ei, nil
+}
+
+// parseIdToken id_token 是直接从 token endpoint 通过 TLS 拿到的，
+// 按照 OIDC Core 3.1.3.7 可以不校验签名，但是 iss、aud 和 exp 还是要校验的
+func (p *Provider) parseIdToken(idToken string) (IdTokenClaims, error) {
+	var claims IdTokenClaims
+	_, _, err := jwt.NewParser().ParseUnverified(idToken, &claims)
+	if err != nil {
+		return IdTokenClaims{}, err
+	}
+	v := jwt.NewValidator(jwt.WithIssuer(p.issuer),
+		jwt.WithAudience(p.cfg.ClientId), jwt.WithExpirationRequired())
+	err = v.Validate(claims)
+	if err != nil {
+		return IdTokenClaims{}, fmt.Errorf("id_token 不合法 %w", err)
+	}
+	if claims.Subject == "" {
+		return IdTokenClaims{}, errors.New("id_token 缺少 sub")
+	}
+	return claims, nil
+}
+
+// getDiscovery 第一次用到的时候再去获取，避免第三方不可用导致启动失败
+func (p *Provider) getDiscovery(ctx context.Context) (*Discovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+	var d Discovery
+	err := oauth2.GetJSON(ctx, p.client, p.issuer+"/.well-known/openid-configuration", "", &d)
+	if err != nil {
+		return nil, err
+	}
+	if d.AuthorizationEndpoint == "" || d.TokenEndpoint == "" {
+		return nil, fmt.Errorf("%s 的 discovery 文档不完整", p.issuer)
+	}
+	p.discovery = &d
+	return p.discovery, nil
+}
+
+type Discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+}
+
+type IdTokenClaims struct {
+	jwt.RegisteredClaims
+	Email             string `json:"email"`
+	EmailVerified     bool   `json:"email_verified"`
+	Name              string `json:"name"`
+	PreferredUsername string `json:"preferred_username"`
+}
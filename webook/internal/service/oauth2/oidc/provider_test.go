@@ -0,0 +1,185 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+	"webook/internal/domain"
+	"webook/internal/service/oauth2"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProvider_VerifyCode(t *testing.T) {
+	verifier, err := oauth2.GenerateCodeVerifier()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		claims   func(issuer string) IdTokenClaims
+		verifier string
+
+		wantErr      bool
+		wantIdentity domain.ExternalIdentity
+	}{
+		{
+			name: "登录成功",
+			claims: func(issuer string) IdTokenClaims {
+				return IdTokenClaims{
+					RegisteredClaims: jwt.RegisteredClaims{
+						Issuer:    issuer,
+						Subject:   "10086",
+						Audience:  jwt.ClaimStrings{"client"},
+						ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+					},
+					Email:         "123@qq.com",
+					EmailVerified: true,
+					Name:          "Tom",
+				}
+			},
+			verifier: verifier,
+			wantIdentity: domain.ExternalIdentity{
+				Provider: "google",
+				Subject:  "10086",
+				Email:    "123@qq.com",
+				Nickname: "Tom",
+			},
+		},
+		{
+			name: "邮箱没有验证",
+			claims: func(issuer string) IdTokenClaims {
+				return IdTokenClaims{
+					RegisteredClaims: jwt.RegisteredClaims{
+						Issuer:    issuer,
+						Subject:   "10086",
+						Audience:  jwt.ClaimStrings{"client"},
+						ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+					},
+					Email:             "123@qq.com",
+					PreferredUsername: "tom",
+				}
+			},
+			verifier: verifier,
+			wantIdentity: domain.ExternalIdentity{
+				Provider: "google",
+				Subject:  "10086",
+				Nickname: "tom",
+			},
+		},
+		{
+			name: "aud 不对",
+			claims: func(issuer string) IdTokenClaims {
+				return IdTokenClaims{
+					RegisteredClaims: jwt.RegisteredClaims{
+						Issuer:    issuer,
+						Subject:   "10086",
+						Audience:  jwt.ClaimStrings{"other"},
+						ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+					},
+				}
+			},
+			verifier: verifier,
+			wantErr:  true,
+		},
+		{
+			name: "id_token 过期",
+			claims: func(issuer string) IdTokenClaims {
+				return IdTokenClaims{
+					RegisteredClaims: jwt.RegisteredClaims{
+						Issuer:    issuer,
+						Subject:   "10086",
+						Audience:  jwt.ClaimStrings{"client"},
+						ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Minute)),
+					},
+				}
+			},
+			verifier: verifier,
+			wantErr:  true,
+		},
+		{
+			name: "code_verifier 不对",
+			claims: func(issuer string) IdTokenClaims {
+				return IdTokenClaims{}
+			},
+			verifier: "wrong",
+			wantErr:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var server *httptest.Server
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/.well-known/openid-configuration":
+					_ = json.NewEncoder(w).Encode(Discovery{
+						Issuer:                server.URL,
+						AuthorizationEndpoint: server.URL + "/authorize",
+						TokenEndpoint:         server.URL + "/token",
+					})
+				case "/token":
+					_ = r.ParseForm()
+					// 模拟授权服务器校验 PKCE
+					if r.PostForm.Get("code") != "abc" ||
+						oauth2.CodeChallenge(r.PostForm.Get("code_verifier")) != oauth2.CodeChallenge(verifier) {
+						w.WriteHeader(http.StatusBadRequest)
+						_ = json.NewEncoder(w).Encode(oauth2.Token{Error: "invalid_grant"})
+						return
+					}
+					idToken, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, tc.claims(server.URL)).
+						SignedString([]byte("key"))
+					_ = json.NewEncoder(w).Encode(oauth2.Token{
+						AccessToken: "token",
+						IdToken:     idToken,
+					})
+				}
+			}))
+			defer server.Close()
+
+			p := NewProvider("google", server.URL, oauth2.Config{
+				ClientId:    "client",
+				RedirectURL: "http://localhost/oauth2/google/callback",
+			})
+			ei, err := p.VerifyCode(context.Background(), "abc", tc.verifier)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantIdentity, ei)
+		})
+	}
+}
+
+func TestProvider_AuthURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(Discovery{
+			AuthorizationEndpoint: "https://accounts.example.com/authorize",
+			TokenEndpoint:         "https://accounts.example.com/token",
+		})
+	}))
+	defer server.Close()
+
+	p := NewProvider("google", server.URL, oauth2.Config{
+		ClientId:    "client",
+		RedirectURL: "http://localhost/oauth2/google/callback",
+	})
+	res, err := p.AuthURL(context.Background(), "state", "challenge")
+	require.NoError(t, err)
+	u, err := url.Parse(res)
+	require.NoError(t, err)
+	assert.Equal(t, "accounts.example.com", u.Host)
+	q := u.Query()
+	assert.Equal(t, "code", q.Get("response_type"))
+	assert.Equal(t, "client", q.Get("client_id"))
+	assert.Equal(t, "state", q.Get("state"))
+	assert.Equal(t, "challenge", q.Get("code_challenge"))
+	assert.Equal(t, "S256", q.Get("code_challenge_method"))
+	assert.Equal(t, "openid email profile", q.Get("scope"))
+}
@@ -0,0 +1,53 @@
+// Package oauth2 和具体第三方无关的 OAuth2 / OIDC 登录抽象。
+// 微信因为历史原因还是单独的 wechat.Service，其余第三方都通过 Provider 接入
+package oauth2
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"webook/internal/domain"
+)
+
+var ErrUnknownProvider = errors.New("未知的第三方登录")
+
+//go:generate mockgen -source=./types.go -package=oauth2mocks -destination=./mocks/oauth2.mock.go Provider
+type Provider interface {
+	// Name 第三方的名字，同时也是回调路径的一部分
+	Name() string
+	// AuthURL 构造跳转链接，codeChallenge 是 PKCE 的 S256 challenge
+	AuthURL(ctx context.Context, state string, codeChallenge string) (string, error)
+	// VerifyCode 用授权码和 PKCE 的 code_verifier 换取第三方的用户信息
+	VerifyCode(ctx context.Context, code string, codeVerifier string) (domain.ExternalIdentity, error)
+}
+
+// Registry 根据配置启用的所有第三方
+type Registry struct {
+	providers map[string]Provider
+}
+
+func NewRegistry(providers ...Provider) *Registry {
+	r := &Registry{providers: make(map[string]Provider, len(providers))}
+	for _, p := range providers {
+		r.providers[p.Name()] = p
+	}
+	return r
+}
+
+func (r *Registry) Get(name string) (Provider, error) {
+	p, ok := r.providers[name]
+	if !ok {
+		return nil, ErrUnknownProvider
+	}
+	return p, nil
+}
+
+// Names 按照字母序返回所有第三方的名字，前端用来展示登录按钮
+func (r *Registry) Names() []string {
+	res := make([]string, 0, len(r.providers))
+	for name := range r.providers {
+		res = append(res, name)
+	}
+	sort.Strings(res)
+	return res
+}
@@ -62,9 +62,10 @@ func (rs *BatchRankingService) TopN(ctx context.Context) error {
 }
 
 func (rs *BatchRankingService) topN(ctx context.Context) ([]domain.Article, error) {
-	offset := 0
 	start := time.Now()
 	ddl := start.Add(-7 * 24 * time.Hour)
+	// Id 是 0，第一批就是 utime 在 start 之前的所有文章
+	cursor := domain.ArticleCursor{Utime: start}
 
 	type Score struct {
 		score float64
@@ -83,7 +84,7 @@ func (rs *BatchRankingService) topN(ctx context.Context) ([]domain.Article, erro
 
 	for {
 		// 取数据
-		arts, err := rs.artSvc.ListPub(ctx, start, offset, rs.batchSize)
+		arts, err := rs.artSvc.ListPub(ctx, cursor, rs.batchSize)
 		if err != nil {
 			return nil, err
 		}
@@ -124,14 +125,14 @@ func (rs *BatchRankingService) topN(ctx context.Context) ([]domain.Article, erro
 				}
 			}
 		}
-		offset = offset + len(arts)
 		// 没有取够一批，我们就直接中断执行
 		// 没有下一批了
 		if len(arts) < rs.batchSize ||
-			// 这个是一个优化
+			// 按照 utime 倒序，后面的只会更早
 			arts[len(arts)-1].Utime.Before(ddl) {
 			break
 		}
+		cursor = domain.CursorOf(arts[len(arts)-1])
 	}
 
 	// 这边 topN 里面就是最终结果
@@ -8,6 +8,7 @@ import (
 
 	domain2 "webook/interactive/domain"
 	"webook/interactive/service"
+	"webook/internal/client"
 	svcmocks "webook/internal/service/mocks"
 
 	"github.com/stretchr/testify/assert"
@@ -32,19 +33,19 @@ func TestBatchRankingService_TopN(t *testing.T) {
 				artSvc := svcmocks.NewMockArticleService(ctrl)
 				// 先模拟批量获取数据
 				// 先模拟第一批
-				artSvc.EXPECT().ListPub(gomock.Any(), gomock.Any(), 0, 2).
+				artSvc.EXPECT().ListPub(gomock.Any(), gomock.Any(), 2).
 					Return([]domain.Article{
 						{Id: 1, Utime: now},
 						{Id: 2, Utime: now},
 					}, nil)
 				// 模拟第二批
-				artSvc.EXPECT().ListPub(gomock.Any(), gomock.Any(), 2, 2).
+				artSvc.EXPECT().ListPub(gomock.Any(), domain.ArticleCursor{Utime: now, Id: 2}, 2).
 					Return([]domain.Article{
 						{Id: 3, Utime: now},
 						{Id: 4, Utime: now},
 					}, nil)
 				// 模拟第三批
-				artSvc.EXPECT().ListPub(gomock.Any(), gomock.Any(), 4, 2).
+				artSvc.EXPECT().ListPub(gomock.Any(), domain.ArticleCursor{Utime: now, Id: 4}, 2).
 					// 没数据了
 					Return([]domain.Article{}, nil)
 
@@ -84,7 +85,8 @@ func TestBatchRankingService_TopN(t *testing.T) {
 			defer ctrl.Finish()
 			intrSvc, artSvc := tc.mock(ctrl)
 			svc := &BatchRankingService{
-				intrSvc:   intrSvc,
+				// 排行榜用的是 gRPC 客户端，用本地的适配器包装一下 mock
+				intrSvc:   client.NewLocalInteractiveServiceAdapter(intrSvc, nil),
 				artSvc:    artSvc,
 				batchSize: batchSize,
 				n:         3,
@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+	"webook/internal/domain"
+	"webook/internal/repository"
+	"webook/pkg/totp"
+)
+
+var (
+	ErrTwoFactorNotEnabled     = errors.New("没有开启两步验证")
+	ErrTwoFactorAlreadyEnabled = errors.New("已经开启了两步验证")
+	ErrTwoFactorNotEnrolled    = errors.New("还没有生成两步验证的密钥")
+	ErrInvalidTwoFactorCode    = errors.New("两步验证码不对")
+)
+
+const (
+	totpIssuer = "webook"
+	// totpSkew 前后各容忍一个周期的时钟误差
+	totpSkew          = 1
+	recoveryCodeCount = 10
+)
+
+//go:generate mockgen -source=./two_factor.go -package=svcmocks -destination=./mocks/two_factor.mock.go TwoFactorService
+type TwoFactorService interface {
+	// Enroll 生成新的密钥和 otpauth 链接，Confirm 之前不会生效
+	Enroll(ctx context.Context, userId int64) (secret string, uri string, err error)
+	// Confirm 用验证器 App 上的验证码确认绑定，返回恢复码的明文，只会返回这一次
+	Confirm(ctx context.Context, userId int64, code string) ([]string, error)
+	// Disable 关闭两步验证，需要验证码或者恢复码
+	Disable(ctx context.Context, userId int64, code string) error
+	// Verify 校验验证码，也可以用恢复码，恢复码用过一次就失效
+	Verify(ctx context.Context, userId int64, code string) error
+}
+
+type totpTwoFactorService struct {
+	ur repository.UserRepository
+}
+
+func NewTwoFactorService(ur repository.UserRepository) TwoFactorService {
+	return &totpTwoFactorService{
+		ur: ur,
+	}
+}
+
+func (s *totpTwoFactorService) Enroll(ctx context.Context, userId int64) (string, string, error) {
+	u, err := s.ur.FindById(ctx, userId)
+	if err != nil {
+		return "", "", err
+	}
+	if u.TwoFactor.Enabled {
+		return "", "", ErrTwoFactorAlreadyEnabled
+	}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return "", "", err
+	}
+	err = s.ur.UpdateTwoFactor(ctx, userId, domain.TwoFactor{
+		Secret: secret,
+	})
+	if err != nil {
+		return "", "", err
+	}
+	return secret, totp.URI(totpIssuer, s.accountName(u), secret), nil
+}
+
+func (s *totpTwoFactorService) Confirm(ctx context.Context, userId int64, code string) ([]string, error) {
+	u, err := s.ur.FindById(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	if u.TwoFactor.Enabled {
+		return nil, ErrTwoFactorAlreadyEnabled
+	}
+	if u.TwoFactor.Secret == "" {
+		return nil, ErrTwoFactorNotEnrolled
+	}
+	step, ok, err := totp.Match(u.TwoFactor.Secret, code, time.Now(), totpSkew)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrInvalidTwoFactorCode
+	}
+	err = s.useStep(ctx, userId, step)
+	if err != nil {
+		return nil, err
+	}
+	codes, hashes, err := s.generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	err = s.ur.UpdateTwoFactor(ctx, userId, domain.TwoFactor{
+		Secret:        u.TwoFactor.Secret,
+		Enabled:       true,
+		RecoveryCodes: hashes,
+	})
+	return codes, err
+}
+
+func (s *totpTwoFactorService) Disable(ctx context.Context, userId int64, code string) error {
+	err := s.Verify(ctx, userId, code)
+	if err != nil {
+		return err
+	}
+	return s.ur.UpdateTwoFactor(ctx, userId, domain.TwoFactor{})
+}
+
+func (s *totpTwoFactorService) Verify(ctx context.Context, userId int64, code string) error {
+	u, err := s.ur.FindById(ctx, userId)
+	if err != nil {
+		return err
+	}
+	if !u.TwoFactor.Enabled {
+		return ErrTwoFactorNotEnabled
+	}
+	step, ok, err := totp.Match(u.TwoFactor.Secret, code, time.Now(), totpSkew)
+	if err != nil {
+		return err
+	}
+	if ok {
+		return s.useStep(ctx, userId, step)
+	}
+	// 不是验证码，再试试是不是恢复码
+	hash := s.hashRecoveryCode(code)
+	for i, h := range u.TwoFactor.RecoveryCodes {
+		if subtle.ConstantTimeCompare([]byte(h), []byte(hash)) != 1 {
+			continue
+		}
+		codes := append(u.TwoFactor.RecoveryCodes[:i:i], u.TwoFactor.RecoveryCodes[i+1:]...)
+		// 条件更新，并发的两个请求用同一个恢复码，只有一个能成功
+		err = s.ur.UseRecoveryCode(ctx, userId, u.TwoFactor.RecoveryCodes, codes)
+		if err == repository.ErrTwoFactorCodeUsed {
+			return ErrInvalidTwoFactorCode
+		}
+		return err
+	}
+	return ErrInvalidTwoFactorCode
+}
+
+// useStep 同一个验证码在有效期内只能用一次，更早的验证码也不能再用
+func (s *totpTwoFactorService) useStep(ctx context.Context, userId int64, step int64) error {
+	err := s.ur.UseTOTPStep(ctx, userId, step)
+	if err == repository.ErrTwoFactorCodeUsed {
+		return ErrInvalidTwoFactorCode
+	}
+	return err
+}
+
+// generateRecoveryCodes 返回恢复码的明文和哈希，数据库里面只存哈希
+func (s *totpTwoFactorService) generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+	encoding := base32.StdEncoding.WithPadding(base32.NoPadding)
+	buf := make([]byte, 5)
+	for i := 0; i < recoveryCodeCount; i++ {
+		_, err := rand.Read(buf)
+		if err != nil {
+			return nil, nil, err
+		}
+		// 8 个字符，分成两段方便用户抄写
+		raw := strings.ToLower(encoding.EncodeToString(buf))
+		code := raw[:4] + "-" + raw[4:]
+		codes = append(codes, code)
+		hashes = append(hashes, s.hashRecoveryCode(code))
+	}
+	return codes, hashes, nil
+}
+
+// hashRecoveryCode 恢复码本身的熵足够，不需要 bcrypt 这种慢哈希
+func (s *totpTwoFactorService) hashRecoveryCode(code string) string {
+	code = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+// accountName 验证器 App 里面展示的账号名
+func (s *totpTwoFactorService) accountName(u domain.User) string {
+	switch {
+	case u.Email != "":
+		return u.Email
+	case u.Phone != "":
+		return u.Phone
+	default:
+		return strconv.FormatInt(u.Id, 10)
+	}
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"webook/internal/domain"
+	"webook/internal/repository"
+	repomocks "webook/internal/repository/mocks"
+	"webook/pkg/totp"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestTotpTwoFactorService_Verify(t *testing.T) {
+	// RFC 6238 附录 B 里面的密钥
+	const secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	svc := &totpTwoFactorService{}
+	recoveryHash := svc.hashRecoveryCode("abcd-efgh")
+	otherHash := svc.hashRecoveryCode("ijkl-mnop")
+	enabled := domain.User{
+		Id: 123,
+		TwoFactor: domain.TwoFactor{
+			Secret:        secret,
+			Enabled:       true,
+			RecoveryCodes: []string{otherHash, recoveryHash},
+		},
+	}
+	code, err := totp.Code(secret, time.Now())
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) repository.UserRepository
+		code string
+
+		wantErr error
+	}{
+		{
+			name: "验证码正确",
+			mock: func(ctrl *gomock.Controller) repository.UserRepository {
+				repo := repomocks.NewMockUserRepository(ctrl)
+				repo.EXPECT().FindById(gomock.Any(), int64(123)).Return(enabled, nil)
+				repo.EXPECT().UseTOTPStep(gomock.Any(), int64(123), gomock.Any()).Return(nil)
+				return repo
+			},
+			code: code,
+		},
+		{
+			name: "验证码已经用过了",
+			mock: func(ctrl *gomock.Controller) repository.UserRepository {
+				repo := repomocks.NewMockUserRepository(ctrl)
+				repo.EXPECT().FindById(gomock.Any(), int64(123)).Return(enabled, nil)
+				repo.EXPECT().UseTOTPStep(gomock.Any(), int64(123), gomock.Any()).
+					Return(repository.ErrTwoFactorCodeUsed)
+				return repo
+			},
+			code:    code,
+			wantErr: ErrInvalidTwoFactorCode,
+		},
+		{
+			name: "恢复码正确",
+			mock: func(ctrl *gomock.Controller) repository.UserRepository {
+				repo := repomocks.NewMockUserRepository(ctrl)
+				repo.EXPECT().FindById(gomock.Any(), int64(123)).Return(enabled, nil)
+				repo.EXPECT().UseRecoveryCode(gomock.Any(), int64(123),
+					[]string{otherHash, recoveryHash}, []string{otherHash}).Return(nil)
+				return repo
+			},
+			code: "ABCD-EFGH",
+		},
+		{
+			name: "恢复码被并发的请求用掉了",
+			mock: func(ctrl *gomock.Controller) repository.UserRepository {
+				repo := repomocks.NewMockUserRepository(ctrl)
+				repo.EXPECT().FindById(gomock.Any(), int64(123)).Return(enabled, nil)
+				repo.EXPECT().UseRecoveryCode(gomock.Any(), int64(123),
+					[]string{otherHash, recoveryHash}, []string{otherHash}).
+					Return(repository.ErrTwoFactorCodeUsed)
+				return repo
+			},
+			code:    "abcd-efgh",
+			wantErr: ErrInvalidTwoFactorCode,
+		},
+		{
+			name: "验证码和恢复码都不对",
+			mock: func(ctrl *gomock.Controller) repository.UserRepository {
+				repo := repomocks.NewMockUserRepository(ctrl)
+				repo.EXPECT().FindById(gomock.Any(), int64(123)).Return(enabled, nil)
+				return repo
+			},
+			code:    "qrst-uvwx",
+			wantErr: ErrInvalidTwoFactorCode,
+		},
+		{
+			name: "没有开启两步验证",
+			mock: func(ctrl *gomock.Controller) repository.UserRepository {
+				repo := repomocks.NewMockUserRepository(ctrl)
+				repo.EXPECT().FindById(gomock.Any(), int64(123)).Return(domain.User{Id: 123}, nil)
+				return repo
+			},
+			code:    code,
+			wantErr: ErrTwoFactorNotEnabled,
+		},
+		{
+			name: "数据库错误",
+			mock: func(ctrl *gomock.Controller) repository.UserRepository {
+				repo := repomocks.NewMockUserRepository(ctrl)
+				repo.EXPECT().FindById(gomock.Any(), int64(123)).Return(enabled, nil)
+				repo.EXPECT().UseTOTPStep(gomock.Any(), int64(123), gomock.Any()).
+					Return(errors.New("db错误"))
+				return repo
+			},
+			code:    code,
+			wantErr: errors.New("db错误"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			svc := NewTwoFactorService(tc.mock(ctrl))
+			err := svc.Verify(context.Background(), 123, tc.code)
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+func TestTotpTwoFactorService_Confirm(t *testing.T) {
+	const secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	code, err := totp.Code(secret, time.Now())
+	require.NoError(t, err)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := repomocks.NewMockUserRepository(ctrl)
+	repo.EXPECT().FindById(gomock.Any(), int64(123)).Return(domain.User{
+		Id:        123,
+		TwoFactor: domain.TwoFactor{Secret: secret},
+	}, nil)
+	// 绑定用的验证码也要记下来，不能马上拿去登录
+	repo.EXPECT().UseTOTPStep(gomock.Any(), int64(123), gomock.Any()).Return(nil)
+	repo.EXPECT().UpdateTwoFactor(gomock.Any(), int64(123), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, userId int64, tf domain.TwoFactor) error {
+			assert.True(t, tf.Enabled)
+			assert.Len(t, tf.RecoveryCodes, recoveryCodeCount)
+			return nil
+		})
+	codes, err := NewTwoFactorService(repo).Confirm(context.Background(), 123, code)
+	require.NoError(t, err)
+	assert.Len(t, codes, recoveryCodeCount)
+}
@@ -2,9 +2,19 @@ package service
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 	"webook/internal/domain"
+	"webook/internal/events/user"
 	"webook/internal/repository"
+	"webook/internal/service/email"
 
 	"go.uber.org/zap"
 	"golang.org/x/crypto/bcrypt"
@@ -12,7 +22,21 @@ import (
 
 var (
 	ErrDuplicateUser         = repository.ErrDuplicateUser
+	ErrUserNotFound          = repository.ErrUserNotFound
 	ErrInvalidUserOrPassword = errors.New("用户名或密码不正确")
+	ErrEmailNotVerified      = errors.New("邮箱还没有验证")
+	ErrEmailAlreadyVerified  = errors.New("邮箱已经验证过了")
+	ErrInvalidUserToken      = errors.New("链接无效或者已经过期")
+	ErrUserTokenSendTooMany  = repository.ErrUserTokenSendTooMany
+	ErrIdentityConflict      = repository.ErrIdentityConflict
+	ErrIdentityAlreadyBound  = repository.ErrIdentityAlreadyBound
+	ErrLastIdentity          = repository.ErrLastIdentity
+	ErrMergeSelf             = errors.New("不能和自己合并")
+)
+
+const (
+	bizVerifyEmail   = "verify_email"
+	bizResetPassword = "reset_password"
 )
 
 //go:generate mockgen -source=./user.go -package=svcmocks -destination=./mocks/user.mock.go UserService
@@ -23,16 +47,69 @@ type UserService interface {
 	GetProfile(ctx context.Context, userId int64) (domain.User, error)
 	FindOrCreate(ctx context.Context, phone string) (domain.User, error)
 	FindOrCreateByWechat(ctx context.Context, wechatInfo domain.WechatInfo) (domain.User, error)
+	// FindOrCreateByIdentity 通用的第三方登录，第一次登录的时候创建用户
+	FindOrCreateByIdentity(ctx context.Context, identity domain.ExternalIdentity) (domain.User, error)
+
+	// SendEmailVerification 给用户的邮箱发送验证链接
+	SendEmailVerification(ctx context.Context, userId int64) error
+	// VerifyEmail 校验邮件里面的 token，通过之后标记邮箱已验证
+	VerifyEmail(ctx context.Context, token string) error
+	// ForgotPassword 发送重置密码的邮件，邮箱不存在的时候也不会返回错误，
+	// 避免被人用来探测哪些邮箱注册过
+	ForgotPassword(ctx context.Context, email string) error
+	// ResetPassword 使用邮件里面的 token 重置密码，返回被重置的用户 ID
+	ResetPassword(ctx context.Context, token string, password string) (int64, error)
+
+	// FindByPhone 只查找，不会创建用户
+	FindByPhone(ctx context.Context, phone string) (domain.User, error)
+	// BindPhone 给当前账号绑定手机号，调用之前要先校验过验证码
+	BindPhone(ctx context.Context, userId int64, phone string) error
+	// BindEmail 给当前账号绑定邮箱并设置密码，绑定之后会发送验证邮件，
+	// 密码要等邮箱验证通过之后才能用来登录
+	BindEmail(ctx context.Context, userId int64, email string, password string) error
+	// BindWechat 给当前账号绑定微信，调用之前要先通过 OAuth2 回调
+	BindWechat(ctx context.Context, userId int64, wechatInfo domain.WechatInfo) error
+	// BindIdentity 给当前账号绑定通用的第三方账号
+	BindIdentity(ctx context.Context, userId int64, identity domain.ExternalIdentity) error
+	Unbind(ctx context.Context, userId int64, typ domain.IdentityType) error
+	// Merge 把 sourceId 合并到 userId 上，调用之前要先证明 sourceId 也是自己的
+	Merge(ctx context.Context, userId int64, sourceId int64) error
+
+	// UpdateRoles 管理员修改用户的角色，会覆盖原来的角色
+	UpdateRoles(ctx context.Context, userId int64, roles []string) error
+}
+
+// UserConfig 用户模块可以配置的部分
+type UserConfig struct {
+	// TokenKey 邮件链接里面 token 的签名密钥
+	TokenKey []byte
+	// LinkPrefix 邮件链接的前缀，一般是前端页面的地址
+	LinkPrefix string
+	// RequireEmailVerified 为 true 的时候拒绝邮箱没有验证的账号登录
+	RequireEmailVerified bool
+	// VerifyEmailExpiration 邮箱验证链接的有效期
+	VerifyEmailExpiration time.Duration
+	// ResetPasswordExpiration 重置密码链接的有效期
+	ResetPasswordExpiration time.Duration
 }
 
 type userService struct {
-	ur repository.UserRepository
+	ur       repository.UserRepository
+	tr       repository.UserTokenRepository
+	emailSvc email.Service
+	producer user.Producer
+	cfg      UserConfig
 	// logger *zap.Logger
 }
 
-func NewUserService(repo repository.UserRepository) UserService {
+func NewUserService(repo repository.UserRepository, tokenRepo repository.UserTokenRepository,
+	emailSvc email.Service, producer user.Producer, cfg UserConfig) UserService {
 	return &userService{
-		ur: repo,
+		ur:       repo,
+		tr:       tokenRepo,
+		emailSvc: emailSvc,
+		producer: producer,
+		cfg:      cfg,
 		// logger: zap.L(),
 	}
 }
@@ -43,7 +120,19 @@ func (us *userService) SignUp(ctx context.Context, user domain.User) error {
 		return err
 	}
 	user.Password = string(hash)
-	return us.ur.Create(ctx, user)
+	err = us.ur.Create(ctx, user)
+	if err != nil {
+		return err
+	}
+	// 注册已经成功了，验证邮件发不出去用户也可以自己重新发送
+	u, err := us.ur.FindByEmail(ctx, user.Email)
+	if err == nil {
+		err = us.sendEmailVerification(ctx, u)
+	}
+	if err != nil {
+		zap.L().Error("发送邮箱验证邮件失败", zap.Error(err))
+	}
+	return nil
 }
 
 func (us *userService) Login(ctx context.Context, email string, password string) (domain.User, error) {
@@ -58,6 +147,9 @@ func (us *userService) Login(ctx context.Context, email string, password string)
 	if err != nil {
 		return domain.User{}, ErrInvalidUserOrPassword
 	}
+	if us.cfg.RequireEmailVerified && !u.EmailVerified {
+		return domain.User{}, ErrEmailNotVerified
+	}
 	return u, nil
 }
 
@@ -102,3 +194,209 @@ func (us *userService) FindOrCreateByWechat(ctx context.Context,
 	}
 	return us.ur.FindByWechat(ctx, wechatInfo.OpenId)
 }
+
+func (us *userService) FindOrCreateByIdentity(ctx context.Context,
+	identity domain.ExternalIdentity) (domain.User, error) {
+	u, err := us.ur.FindByIdentity(ctx, identity.Provider, identity.Subject)
+	if err != repository.ErrUserNotFound {
+		return u, err
+	}
+	zap.L().Info("新用户", zap.Any("identity", identity))
+	u, err = us.ur.CreateWithIdentity(ctx, identity)
+	if err != repository.ErrDuplicateUser {
+		return u, err
+	}
+	// 并发登录的时候别人已经创建好了
+	return us.ur.FindByIdentity(ctx, identity.Provider, identity.Subject)
+}
+
+func (us *userService) SendEmailVerification(ctx context.Context, userId int64) error {
+	u, err := us.ur.FindById(ctx, userId)
+	if err != nil {
+		return err
+	}
+	if u.EmailVerified {
+		return ErrEmailAlreadyVerified
+	}
+	return us.sendEmailVerification(ctx, u)
+}
+
+func (us *userService) sendEmailVerification(ctx context.Context, u domain.User) error {
+	if u.Email == "" {
+		return errors.New("用户没有绑定邮箱")
+	}
+	token, err := us.issueToken(ctx, bizVerifyEmail, u.Id, us.cfg.VerifyEmailExpiration)
+	if err != nil {
+		return err
+	}
+	link := fmt.Sprintf("%s/users/email/verify?token=%s", us.cfg.LinkPrefix, token)
+	return us.emailSvc.Send(ctx, "验证你的邮箱",
+		fmt.Sprintf("点击链接完成邮箱验证，%d 分钟内有效：%s",
+			int(us.cfg.VerifyEmailExpiration.Minutes()), link), u.Email)
+}
+
+func (us *userService) VerifyEmail(ctx context.Context, token string) error {
+	uid, err := us.consumeToken(ctx, bizVerifyEmail, token)
+	if err != nil {
+		return err
+	}
+	return us.ur.MarkEmailVerified(ctx, uid)
+}
+
+func (us *userService) ForgotPassword(ctx context.Context, email string) error {
+	// 先按照邮箱限流，不然只有注册过的邮箱会返回发送太频繁
+	err := us.tr.Throttle(ctx, bizResetPassword, email)
+	if err != nil {
+		return err
+	}
+	u, err := us.ur.FindByEmail(ctx, email)
+	if err == repository.ErrUserNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	token, err := us.issueToken(ctx, bizResetPassword, u.Id, us.cfg.ResetPasswordExpiration)
+	if err != nil {
+		return err
+	}
+	link := fmt.Sprintf("%s/users/password/reset?token=%s", us.cfg.LinkPrefix, token)
+	return us.emailSvc.Send(ctx, "重置你的密码",
+		fmt.Sprintf("点击链接重置密码，%d 分钟内有效，如果不是你本人操作请忽略：%s",
+			int(us.cfg.ResetPasswordExpiration.Minutes()), link), u.Email)
+}
+
+func (us *userService) ResetPassword(ctx context.Context, token string, password string) (int64, error) {
+	uid, err := us.consumeToken(ctx, bizResetPassword, token)
+	if err != nil {
+		return 0, err
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return 0, err
+	}
+	err = us.ur.UpdatePassword(ctx, uid, string(hash))
+	if err != nil {
+		return 0, err
+	}
+	// 能够收到重置邮件，说明邮箱也是有效的
+	err = us.ur.MarkEmailVerified(ctx, uid)
+	return uid, err
+}
+
+func (us *userService) FindByPhone(ctx context.Context, phone string) (domain.User, error) {
+	return us.ur.FindByPhone(ctx, phone)
+}
+
+func (us *userService) BindPhone(ctx context.Context, userId int64, phone string) error {
+	return us.ur.BindPhone(ctx, userId, phone)
+}
+
+func (us *userService) BindEmail(ctx context.Context, userId int64, email string, password string) error {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	err = us.ur.BindEmail(ctx, userId, email, string(hash))
+	if err != nil {
+		return err
+	}
+	// 和注册一样，验证邮件发不出去用户可以自己重新发送
+	u, err := us.ur.FindById(ctx, userId)
+	if err == nil {
+		err = us.sendEmailVerification(ctx, u)
+	}
+	if err != nil {
+		zap.L().Error("发送邮箱验证邮件失败", zap.Error(err))
+	}
+	return nil
+}
+
+func (us *userService) BindWechat(ctx context.Context, userId int64, wechatInfo domain.WechatInfo) error {
+	return us.ur.BindWechat(ctx, userId, wechatInfo)
+}
+
+func (us *userService) BindIdentity(ctx context.Context, userId int64, identity domain.ExternalIdentity) error {
+	return us.ur.BindIdentity(ctx, userId, identity)
+}
+
+func (us *userService) Unbind(ctx context.Context, userId int64, typ domain.IdentityType) error {
+	return us.ur.Unbind(ctx, userId, typ)
+}
+
+func (us *userService) Merge(ctx context.Context, userId int64, sourceId int64) error {
+	if userId == sourceId {
+		return ErrMergeSelf
+	}
+	err := us.ur.Merge(ctx, userId, sourceId)
+	if err != nil {
+		return err
+	}
+	// 点赞、收藏、关注和评论在别的服务里面，通知它们把 source 的数据转过来
+	err = us.producer.ProduceMergedEvent(user.MergedEvent{
+		SourceUid: sourceId,
+		TargetUid: userId,
+	})
+	if err != nil {
+		// 账号已经合并了，这里只能记下来人工补发
+		zap.L().Error("发送账号合并消息失败", zap.Error(err),
+			zap.Int64("source", sourceId), zap.Int64("target", userId))
+	}
+	return nil
+}
+
+// UpdateRoles 直接覆盖原来的角色，用户不存在的时候返回 ErrUserNotFound
+func (us *userService) UpdateRoles(ctx context.Context, userId int64, roles []string) error {
+	// 确认用户存在，不然更新了 0 行也不知道
+	_, err := us.ur.FindById(ctx, userId)
+	if err != nil {
+		return err
+	}
+	return us.ur.UpdateRoles(ctx, userId, roles)
+}
+
+// issueToken 生成 uid.nonce.sig 格式的 token，
+// 签名保证 token 没有被篡改，Redis 里面的 nonce 保证只能用一次并且会过期
+func (us *userService) issueToken(ctx context.Context, biz string,
+	uid int64, expiration time.Duration) (string, error) {
+	buf := make([]byte, 16)
+	_, err := rand.Read(buf)
+	if err != nil {
+		return "", err
+	}
+	nonce := base64.RawURLEncoding.EncodeToString(buf)
+	err = us.tr.Set(ctx, biz, uid, nonce, expiration)
+	if err != nil {
+		return "", err
+	}
+	payload := fmt.Sprintf("%d.%s", uid, nonce)
+	return payload + "." + us.sign(biz, payload), nil
+}
+
+// consumeToken 校验 token 并且让它失效，返回 token 对应的用户 ID
+func (us *userService) consumeToken(ctx context.Context, biz string, token string) (int64, error) {
+	segs := strings.Split(token, ".")
+	if len(segs) != 3 {
+		return 0, ErrInvalidUserToken
+	}
+	payload := segs[0] + "." + segs[1]
+	if !hmac.Equal([]byte(segs[2]), []byte(us.sign(biz, payload))) {
+		return 0, ErrInvalidUserToken
+	}
+	uid, err := strconv.ParseInt(segs[0], 10, 64)
+	if err != nil {
+		return 0, ErrInvalidUserToken
+	}
+	err = us.tr.Verify(ctx, biz, uid, segs[1])
+	if err == repository.ErrUserTokenInvalid {
+		return 0, ErrInvalidUserToken
+	}
+	return uid, err
+}
+
+func (us *userService) sign(biz string, payload string) string {
+	// 把 biz 也签进去，防止验证邮箱的 token 被拿去重置密码
+	mac := hmac.New(sha256.New, us.cfg.TokenKey)
+	mac.Write([]byte(biz + "." + payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,447 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+	commentv1 "webook/api/proto/gen/comment/v1"
+	followv1 "webook/api/proto/gen/follow/v1"
+	intrv1 "webook/api/proto/gen/intr/v1"
+	"webook/internal/domain"
+	"webook/internal/events/user"
+	"webook/internal/repository"
+	"webook/pkg/objstore"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	ErrUserExportInProgress = errors.New("正在导出数据")
+	ErrUserExportNotReady   = errors.New("没有可以下载的导出数据")
+	ErrUserAlreadyDeleted   = errors.New("用户已经注销")
+	// ErrUserReauthUnavailable 既没有密码也没有开启两步验证，没有办法重新确认身份
+	ErrUserReauthUnavailable = errors.New("请先设置密码或者开启两步验证")
+)
+
+// deletedNickname 注销之后展示的昵称
+const deletedNickname = "已注销用户"
+
+// exportBatchSize 分批从各个服务拉数据
+const exportBatchSize = 50
+
+// exportKeyPrefix 导出的压缩包在对象存储里面的前缀，过期之后由定时任务删除
+const exportKeyPrefix = "exports/"
+
+// UserDataConfig 个人数据导出和注销的配置
+type UserDataConfig struct {
+	// ExportExpiration 导出的数据能下载多久，过期之后压缩包会从对象存储里面删掉
+	ExportExpiration time.Duration
+	// DeletionGracePeriod 申请注销之后的冷静期，期间可以撤销
+	DeletionGracePeriod time.Duration
+}
+
+//go:generate mockgen -source=./user_data.go -package=svcmocks -destination=./mocks/user_data.mock.go UserDataService
+type UserDataService interface {
+	// RequestExport 申请导出个人数据，真正的导出是异步的
+	RequestExport(ctx context.Context, uid int64) error
+	// Export 收集用户的所有数据并打包，由消费者调用
+	Export(ctx context.Context, uid int64) error
+	GetExport(ctx context.Context, uid int64) (domain.UserExport, error)
+	// OpenExport 打开导出的压缩包，没有可以下载的时候返回 ErrUserExportNotReady，
+	// 调用者负责关闭返回的 ReadCloser
+	OpenExport(ctx context.Context, uid int64) (domain.UserExport, io.ReadCloser, error)
+	// ExpireExports 删除已经过期的压缩包，返回删除了多少个
+	ExpireExports(ctx context.Context, now time.Time) (int, error)
+
+	// RequestDeletion 申请注销，返回真正注销的时间。
+	// 有密码的要校验密码，开启了两步验证的还要校验验证码
+	RequestDeletion(ctx context.Context, uid int64, password string, code string) (time.Time, error)
+	CancelDeletion(ctx context.Context, uid int64) error
+	// DeleteDue 注销冷静期已经过了的用户，返回注销了多少个
+	DeleteDue(ctx context.Context, now time.Time) (int, error)
+}
+
+type userDataService struct {
+	userRepo   repository.UserRepository
+	artRepo    repository.ArticleRepository
+	exportRepo repository.UserExportRepository
+	intrSvc    intrv1.InteractiveServiceClient
+	commentSvc commentv1.CommentServiceClient
+	followSvc  followv1.FollowServiceClient
+	tfSvc      TwoFactorService
+	store      objstore.Store
+	producer   user.Producer
+	cfg        UserDataConfig
+}
+
+func NewUserDataService(userRepo repository.UserRepository,
+	artRepo repository.ArticleRepository,
+	exportRepo repository.UserExportRepository,
+	intrSvc intrv1.InteractiveServiceClient,
+	commentSvc commentv1.CommentServiceClient,
+	followSvc followv1.FollowServiceClient,
+	tfSvc TwoFactorService,
+	store objstore.Store,
+	producer user.Producer,
+	cfg UserDataConfig) UserDataService {
+	return &userDataService{
+		userRepo:   userRepo,
+		artRepo:    artRepo,
+		exportRepo: exportRepo,
+		intrSvc:    intrSvc,
+		commentSvc: commentSvc,
+		followSvc:  followSvc,
+		tfSvc:      tfSvc,
+		store:      store,
+		producer:   producer,
+		cfg:        cfg,
+	}
+}
+
+func (svc *userDataService) RequestExport(ctx context.Context, uid int64) error {
+	export, err := svc.exportRepo.Get(ctx, uid)
+	if err != nil {
+		return err
+	}
+	// 不需要特别严格，并发申请最多就是多导出一次
+	if export.Status == domain.UserExportStatusPending {
+		return ErrUserExportInProgress
+	}
+	now := time.Now()
+	err = svc.exportRepo.Set(ctx, domain.UserExport{
+		Uid:    uid,
+		Status: domain.UserExportStatusPending,
+		Ctime:  now,
+		Utime:  now,
+	}, svc.cfg.ExportExpiration)
+	if err != nil {
+		return err
+	}
+	return svc.producer.ProduceExportEvent(user.ExportEvent{Uid: uid})
+}
+
+func (svc *userDataService) GetExport(ctx context.Context, uid int64) (domain.UserExport, error) {
+	return svc.exportRepo.Get(ctx, uid)
+}
+
+func (svc *userDataService) Export(ctx context.Context, uid int64) error {
+	export, err := svc.exportRepo.Get(ctx, uid)
+	if err != nil {
+		return err
+	}
+	if export.Ctime.IsZero() {
+		export.Ctime = time.Now()
+	}
+	export.Uid = uid
+	oldKey := export.Key
+	key, err := svc.export(ctx, uid)
+	if err != nil {
+		export.Status = domain.UserExportStatusFailed
+	} else {
+		export.Status = domain.UserExportStatusDone
+		export.Key = key
+	}
+	export.Utime = time.Now()
+	er := svc.exportRepo.Set(ctx, export, svc.cfg.ExportExpiration)
+	if er != nil {
+		// 状态没有更新成功，用户那边会一直看到导出中，只能重新申请
+		zap.L().Error("更新导出状态失败", zap.Int64("uid", uid), zap.Error(er))
+		return err
+	}
+	// 一个用户只保留最新的一份，删不掉也会在过期之后被定时任务删掉
+	if err == nil && oldKey != "" && oldKey != key {
+		er = svc.store.Delete(ctx, oldKey)
+		if er != nil {
+			zap.L().Warn("删除旧的导出数据失败", zap.Int64("uid", uid), zap.Error(er))
+		}
+	}
+	return err
+}
+
+func (svc *userDataService) export(ctx context.Context, uid int64) (string, error) {
+	data, err := svc.collect(ctx, uid)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	err = writeUserDataArchive(&buf, data)
+	if err != nil {
+		return "", err
+	}
+	// key 里面带上 UUID，对象存储的地址公开了也猜不到
+	key := fmt.Sprintf("%s%d/%s.zip", exportKeyPrefix, uid, uuid.NewString())
+	return key, svc.store.Put(ctx, key, &buf, int64(buf.Len()), "application/zip")
+}
+
+func (svc *userDataService) OpenExport(ctx context.Context, uid int64) (domain.UserExport, io.ReadCloser, error) {
+	export, err := svc.exportRepo.Get(ctx, uid)
+	if err != nil {
+		return domain.UserExport{}, nil, err
+	}
+	if export.Status != domain.UserExportStatusDone ||
+		time.Since(export.Utime) > svc.cfg.ExportExpiration {
+		return domain.UserExport{}, nil, ErrUserExportNotReady
+	}
+	r, err := svc.store.Get(ctx, export.Key)
+	if err == objstore.ErrObjectNotFound {
+		return domain.UserExport{}, nil, ErrUserExportNotReady
+	}
+	return export, r, err
+}
+
+func (svc *userDataService) ExpireExports(ctx context.Context, now time.Time) (int, error) {
+	return svc.store.DeleteBefore(ctx, exportKeyPrefix, now.Add(-svc.cfg.ExportExpiration))
+}
+
+func (svc *userDataService) collect(ctx context.Context, uid int64) (userData, error) {
+	u, err := svc.userRepo.FindById(ctx, uid)
+	if err != nil {
+		return userData{}, err
+	}
+	res := userData{
+		Profile: exportProfile{
+			Id:       u.Id,
+			Email:    u.Email,
+			Phone:    u.Phone,
+			Nickname: u.Nickname,
+			Birthday: u.Birthday,
+			AboutMe:  u.AboutMe,
+			Ctime:    u.Ctime,
+		},
+	}
+	for _, ei := range u.ExternalIdentities {
+		res.Profile.Identities = append(res.Profile.Identities, ei.Provider)
+	}
+
+	// GetByAuthor 第一页是 100 条的时候会走缓存，这里不需要
+	var cursor domain.ArticleCursor
+	for {
+		arts, err := svc.artRepo.GetByAuthor(ctx, uid, 0, cursor, exportBatchSize)
+		if err != nil {
+			return userData{}, err
+		}
+		for _, art := range arts {
+			res.Articles = append(res.Articles, exportArticle{
+				Id:      art.Id,
+				Title:   art.Title,
+				Content: art.Content,
+				Status:  art.Status.ToUint8(),
+				Ctime:   art.Ctime,
+				Utime:   art.Utime,
+			})
+		}
+		if len(arts) < exportBatchSize {
+			break
+		}
+		cursor = domain.CursorOf(arts[len(arts)-1])
+	}
+
+	var minId int64
+	for {
+		resp, err := svc.commentSvc.GetUserComments(ctx, &commentv1.GetUserCommentsRequest{
+			Uid:   uid,
+			MinId: minId,
+			Limit: exportBatchSize,
+		})
+		if err != nil {
+			return userData{}, err
+		}
+		for _, c := range resp.GetComments() {
+			res.Comments = append(res.Comments, exportComment{
+				Id:      c.GetId(),
+				Biz:     c.GetBiz(),
+				BizId:   c.GetBizid(),
+				Content: c.GetContent(),
+				Ctime:   c.GetCtime().AsTime(),
+			})
+			minId = c.GetId()
+		}
+		if len(resp.GetComments()) < exportBatchSize {
+			break
+		}
+	}
+
+	intrResp, err := svc.intrSvc.GetUserData(ctx, &intrv1.GetUserDataRequest{Uid: uid})
+	if err != nil {
+		return userData{}, err
+	}
+	for _, l := range intrResp.GetLikes() {
+		res.Likes = append(res.Likes, exportInteraction{
+			Biz:   l.GetBiz(),
+			BizId: l.GetBizId(),
+			Ctime: time.UnixMilli(l.GetCtime()),
+		})
+	}
+	for _, c := range intrResp.GetCollections() {
+		res.Collections = append(res.Collections, exportInteraction{
+			Biz:   c.GetBiz(),
+			BizId: c.GetBizId(),
+			Cid:   c.GetCid(),
+			Ctime: time.UnixMilli(c.GetCtime()),
+		})
+	}
+
+	for offset := int64(0); ; offset += exportBatchSize {
+		resp, err := svc.followSvc.GetFollowee(ctx, &followv1.GetFolloweeRequest{
+			Follower: uid,
+			Offset:   offset,
+			Limit:    exportBatchSize,
+		})
+		if err != nil {
+			return userData{}, err
+		}
+		for _, fr := range resp.GetFollowRelations() {
+			res.Following = append(res.Following, fr.GetFollowee())
+		}
+		if len(resp.GetFollowRelations()) < exportBatchSize {
+			break
+		}
+	}
+	return res, nil
+}
+
+func (svc *userDataService) RequestDeletion(ctx context.Context, uid int64,
+	password string, code string) (time.Time, error) {
+	u, err := svc.userRepo.FindById(ctx, uid)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if u.Deleted {
+		return time.Time{}, ErrUserAlreadyDeleted
+	}
+	// 登录态可能是别人拿到的，注销之前要重新确认一次身份
+	err = svc.reauth(ctx, u, password, code)
+	if err != nil {
+		return time.Time{}, err
+	}
+	// 重复申请不会推迟注销时间
+	if !u.DeleteAt.IsZero() {
+		return u.DeleteAt, nil
+	}
+	deleteAt := time.Now().Add(svc.cfg.DeletionGracePeriod)
+	return deleteAt, svc.userRepo.UpdateDeleteAt(ctx, uid, deleteAt)
+}
+
+func (svc *userDataService) reauth(ctx context.Context, u domain.User, password string, code string) error {
+	if u.Password == "" && !u.TwoFactor.Enabled {
+		return ErrUserReauthUnavailable
+	}
+	if u.Password != "" {
+		err := bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(password))
+		if err != nil {
+			return ErrInvalidUserOrPassword
+		}
+	}
+	if u.TwoFactor.Enabled {
+		return svc.tfSvc.Verify(ctx, u.Id, code)
+	}
+	return nil
+}
+
+func (svc *userDataService) CancelDeletion(ctx context.Context, uid int64) error {
+	return svc.userRepo.UpdateDeleteAt(ctx, uid, time.Time{})
+}
+
+func (svc *userDataService) DeleteDue(ctx context.Context, now time.Time) (int, error) {
+	users, err := svc.userRepo.FindDueDeletions(ctx, now, exportBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	cnt := 0
+	for _, u := range users {
+		// 先发事件再匿名化，匿名化之后 delete_at 就清空了，不会再被查出来。
+		// 事件发出去了但是匿名化失败，下一次还会再发，下游的清理是幂等的
+		err = svc.producer.ProduceDeletedEvent(user.DeletedEvent{Uid: u.Id})
+		if err != nil {
+			return cnt, fmt.Errorf("发送用户 %d 的注销事件失败 %w", u.Id, err)
+		}
+		err = svc.userRepo.Anonymize(ctx, u.Id, deletedNickname)
+		if err != nil {
+			return cnt, err
+		}
+		cnt++
+	}
+	return cnt, nil
+}
+
+// userData 导出的个人数据，每个部分是压缩包里面的一个 JSON 文件
+type userData struct {
+	Profile     exportProfile
+	Articles    []exportArticle
+	Comments    []exportComment
+	Likes       []exportInteraction
+	Collections []exportInteraction
+	// Following 关注的用户 ID
+	Following []int64
+}
+
+type exportProfile struct {
+	Id         int64     `json:"id"`
+	Email      string    `json:"email,omitempty"`
+	Phone      string    `json:"phone,omitempty"`
+	Nickname   string    `json:"nickname"`
+	Birthday   string    `json:"birthday,omitempty"`
+	AboutMe    string    `json:"aboutMe,omitempty"`
+	Identities []string  `json:"identities,omitempty"`
+	Ctime      time.Time `json:"ctime"`
+}
+
+type exportArticle struct {
+	Id      int64     `json:"id"`
+	Title   string    `json:"title"`
+	Content string    `json:"content"`
+	Status  uint8     `json:"status"`
+	Ctime   time.Time `json:"ctime"`
+	Utime   time.Time `json:"utime"`
+}
+
+type exportComment struct {
+	Id      int64     `json:"id"`
+	Biz     string    `json:"biz"`
+	BizId   int64     `json:"bizId"`
+	Content string    `json:"content"`
+	Ctime   time.Time `json:"ctime"`
+}
+
+type exportInteraction struct {
+	Biz   string `json:"biz"`
+	BizId int64  `json:"bizId"`
+	// Cid 收藏夹，只有收藏才有
+	Cid   int64     `json:"cid,omitempty"`
+	Ctime time.Time `json:"ctime"`
+}
+
+func writeUserDataArchive(w io.Writer, data userData) error {
+	zw := zip.NewWriter(w)
+	files := []struct {
+		name string
+		val  any
+	}{
+		{name: "profile.json", val: data.Profile},
+		{name: "articles.json", val: data.Articles},
+		{name: "comments.json", val: data.Comments},
+		{name: "likes.json", val: data.Likes},
+		{name: "collections.json", val: data.Collections},
+		{name: "following.json", val: data.Following},
+	}
+	for _, file := range files {
+		w, err := zw.Create(file.name)
+		if err != nil {
+			return err
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(file.val)
+		if err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
@@ -0,0 +1,527 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+	commentv1 "webook/api/proto/gen/comment/v1"
+	followv1 "webook/api/proto/gen/follow/v1"
+	intrv1 "webook/api/proto/gen/intr/v1"
+	"webook/internal/domain"
+	"webook/internal/events/user"
+	"webook/internal/repository"
+	repomocks "webook/internal/repository/mocks"
+	svcmocks "webook/internal/service/mocks"
+	"webook/pkg/objstore"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestUserDataService_RequestDeletion(t *testing.T) {
+	deleteAt := time.UnixMilli(1700000000000)
+	hash, err := bcrypt.GenerateFromPassword([]byte("hello#world123"), bcrypt.MinCost)
+	require.NoError(t, err)
+	withPwd := domain.User{Id: 123, Password: string(hash)}
+	with2FA := domain.User{Id: 123, TwoFactor: domain.TwoFactor{Enabled: true}}
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (repository.UserRepository, TwoFactorService)
+
+		password string
+		code     string
+
+		wantErr error
+		// 新申请的注销时间是按照当前时间算的，只检查有没有值
+		wantDeleteAt time.Time
+		wantNew      bool
+	}{
+		{
+			name: "申请成功",
+			mock: func(ctrl *gomock.Controller) (repository.UserRepository, TwoFactorService) {
+				repo := repomocks.NewMockUserRepository(ctrl)
+				repo.EXPECT().FindById(gomock.Any(), int64(123)).Return(withPwd, nil)
+				repo.EXPECT().UpdateDeleteAt(gomock.Any(), int64(123), gomock.Any()).
+					Return(nil)
+				return repo, svcmocks.NewMockTwoFactorService(ctrl)
+			},
+			password: "hello#world123",
+			wantNew:  true,
+		},
+		{
+			name: "密码不对",
+			mock: func(ctrl *gomock.Controller) (repository.UserRepository, TwoFactorService) {
+				repo := repomocks.NewMockUserRepository(ctrl)
+				repo.EXPECT().FindById(gomock.Any(), int64(123)).Return(withPwd, nil)
+				return repo, svcmocks.NewMockTwoFactorService(ctrl)
+			},
+			password: "hello#world",
+			wantErr:  ErrInvalidUserOrPassword,
+		},
+		{
+			name: "两步验证通过",
+			mock: func(ctrl *gomock.Controller) (repository.UserRepository, TwoFactorService) {
+				repo := repomocks.NewMockUserRepository(ctrl)
+				repo.EXPECT().FindById(gomock.Any(), int64(123)).Return(with2FA, nil)
+				repo.EXPECT().UpdateDeleteAt(gomock.Any(), int64(123), gomock.Any()).
+					Return(nil)
+				tfSvc := svcmocks.NewMockTwoFactorService(ctrl)
+				tfSvc.EXPECT().Verify(gomock.Any(), int64(123), "123456").Return(nil)
+				return repo, tfSvc
+			},
+			code:    "123456",
+			wantNew: true,
+		},
+		{
+			name: "两步验证码不对",
+			mock: func(ctrl *gomock.Controller) (repository.UserRepository, TwoFactorService) {
+				repo := repomocks.NewMockUserRepository(ctrl)
+				repo.EXPECT().FindById(gomock.Any(), int64(123)).Return(with2FA, nil)
+				tfSvc := svcmocks.NewMockTwoFactorService(ctrl)
+				tfSvc.EXPECT().Verify(gomock.Any(), int64(123), "654321").
+					Return(ErrInvalidTwoFactorCode)
+				return repo, tfSvc
+			},
+			code:    "654321",
+			wantErr: ErrInvalidTwoFactorCode,
+		},
+		{
+			name: "没有密码也没有两步验证",
+			mock: func(ctrl *gomock.Controller) (repository.UserRepository, TwoFactorService) {
+				repo := repomocks.NewMockUserRepository(ctrl)
+				repo.EXPECT().FindById(gomock.Any(), int64(123)).
+					Return(domain.User{Id: 123, Phone: "15212345678"}, nil)
+				return repo, svcmocks.NewMockTwoFactorService(ctrl)
+			},
+			wantErr: ErrUserReauthUnavailable,
+		},
+		{
+			name: "重复申请，不推迟注销时间",
+			mock: func(ctrl *gomock.Controller) (repository.UserRepository, TwoFactorService) {
+				repo := repomocks.NewMockUserRepository(ctrl)
+				u := withPwd
+				u.DeleteAt = deleteAt
+				repo.EXPECT().FindById(gomock.Any(), int64(123)).Return(u, nil)
+				return repo, svcmocks.NewMockTwoFactorService(ctrl)
+			},
+			password:     "hello#world123",
+			wantDeleteAt: deleteAt,
+		},
+		{
+			name: "已经注销",
+			mock: func(ctrl *gomock.Controller) (repository.UserRepository, TwoFactorService) {
+				repo := repomocks.NewMockUserRepository(ctrl)
+				repo.EXPECT().FindById(gomock.Any(), int64(123)).
+					Return(domain.User{Id: 123, Deleted: true}, nil)
+				return repo, svcmocks.NewMockTwoFactorService(ctrl)
+			},
+			wantErr: ErrUserAlreadyDeleted,
+		},
+		{
+			name: "用户不存在",
+			mock: func(ctrl *gomock.Controller) (repository.UserRepository, TwoFactorService) {
+				repo := repomocks.NewMockUserRepository(ctrl)
+				repo.EXPECT().FindById(gomock.Any(), int64(123)).
+					Return(domain.User{}, repository.ErrUserNotFound)
+				return repo, svcmocks.NewMockTwoFactorService(ctrl)
+			},
+			wantErr: ErrUserNotFound,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			repo, tfSvc := tc.mock(ctrl)
+			svc := NewUserDataService(repo, nil, nil, nil, nil, nil, tfSvc, nil, nil,
+				UserDataConfig{DeletionGracePeriod: time.Hour})
+			start := time.Now()
+			res, err := svc.RequestDeletion(context.Background(), 123, tc.password, tc.code)
+			assert.Equal(t, tc.wantErr, err)
+			if tc.wantNew {
+				assert.False(t, res.Before(start.Add(time.Hour)))
+				return
+			}
+			assert.Equal(t, tc.wantDeleteAt, res)
+		})
+	}
+}
+
+func TestUserDataService_DeleteDue(t *testing.T) {
+	now := time.Now()
+	testCases := []struct {
+		name     string
+		mock     func(ctrl *gomock.Controller) repository.UserRepository
+		producer *fakeUserProducer
+
+		wantCnt  int
+		wantErr  error
+		wantUids []int64
+	}{
+		{
+			name: "注销成功",
+			mock: func(ctrl *gomock.Controller) repository.UserRepository {
+				repo := repomocks.NewMockUserRepository(ctrl)
+				repo.EXPECT().FindDueDeletions(gomock.Any(), now, exportBatchSize).
+					Return([]domain.User{{Id: 1}, {Id: 2}}, nil)
+				repo.EXPECT().Anonymize(gomock.Any(), int64(1), deletedNickname).Return(nil)
+				repo.EXPECT().Anonymize(gomock.Any(), int64(2), deletedNickname).Return(nil)
+				return repo
+			},
+			producer: &fakeUserProducer{},
+			wantCnt:  2,
+			wantUids: []int64{1, 2},
+		},
+		{
+			name: "发送事件失败，不匿名化，下一次重试",
+			mock: func(ctrl *gomock.Controller) repository.UserRepository {
+				repo := repomocks.NewMockUserRepository(ctrl)
+				repo.EXPECT().FindDueDeletions(gomock.Any(), now, exportBatchSize).
+					Return([]domain.User{{Id: 1}, {Id: 2}}, nil)
+				return repo
+			},
+			producer: &fakeUserProducer{err: errors.New("kafka 错误")},
+			wantErr:  fmt.Errorf("发送用户 %d 的注销事件失败 %w", 1, errors.New("kafka 错误")),
+			wantUids: []int64{1},
+		},
+		{
+			name: "匿名化失败",
+			mock: func(ctrl *gomock.Controller) repository.UserRepository {
+				repo := repomocks.NewMockUserRepository(ctrl)
+				repo.EXPECT().FindDueDeletions(gomock.Any(), now, exportBatchSize).
+					Return([]domain.User{{Id: 1}, {Id: 2}}, nil)
+				repo.EXPECT().Anonymize(gomock.Any(), int64(1), deletedNickname).Return(nil)
+				repo.EXPECT().Anonymize(gomock.Any(), int64(2), deletedNickname).
+					Return(errors.New("db 错误"))
+				return repo
+			},
+			producer: &fakeUserProducer{},
+			wantCnt:  1,
+			wantErr:  errors.New("db 错误"),
+			// 事件已经发出去了，下一次还会再发一次
+			wantUids: []int64{1, 2},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			svc := NewUserDataService(tc.mock(ctrl), nil, nil, nil, nil, nil, nil, nil,
+				tc.producer, UserDataConfig{})
+			cnt, err := svc.DeleteDue(context.Background(), now)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantCnt, cnt)
+			assert.Equal(t, tc.wantUids, tc.producer.deleted)
+		})
+	}
+}
+
+func TestUserDataService_Export(t *testing.T) {
+	ctime := time.UnixMilli(1700000000000)
+	// 多于一批，检查分页
+	arts := make([]domain.Article, exportBatchSize+1)
+	for i := range arts {
+		arts[i] = domain.Article{
+			Id:     int64(i + 1),
+			Title:  fmt.Sprintf("标题%d", i+1),
+			Status: domain.ArticleStatusPublished,
+			Utime:  ctime,
+		}
+	}
+	comments := make([]*commentv1.Comment, exportBatchSize+1)
+	for i := range comments {
+		comments[i] = &commentv1.Comment{
+			Id:      int64(i + 1),
+			Biz:     "article",
+			Bizid:   1,
+			Content: "评论",
+			Ctime:   timestamppb.New(ctime),
+		}
+	}
+	followees := make([]*followv1.FollowRelation, exportBatchSize+1)
+	for i := range followees {
+		followees[i] = &followv1.FollowRelation{Follower: 123, Followee: int64(1000 + i)}
+	}
+
+	testCases := []struct {
+		name    string
+		mock    func(ctrl *gomock.Controller) (repository.UserRepository, repository.ArticleRepository)
+		intrErr error
+		// oldKey 上一次导出的压缩包，导出成功之后要删掉
+		oldKey string
+
+		wantErr    error
+		wantStatus domain.UserExportStatus
+	}{
+		{
+			name: "导出成功",
+			mock: func(ctrl *gomock.Controller) (repository.UserRepository, repository.ArticleRepository) {
+				userRepo := repomocks.NewMockUserRepository(ctrl)
+				userRepo.EXPECT().FindById(gomock.Any(), int64(123)).Return(domain.User{
+					Id:       123,
+					Email:    "123@qq.com",
+					Nickname: "大明",
+					Ctime:    ctime,
+					ExternalIdentities: []domain.ExternalIdentity{
+						{Provider: "github", Subject: "10086"},
+					},
+				}, nil)
+				artRepo := repomocks.NewMockArticleRepository(ctrl)
+				artRepo.EXPECT().GetByAuthor(gomock.Any(), int64(123), int64(0),
+					domain.ArticleCursor{}, exportBatchSize).Return(arts[:exportBatchSize], nil)
+				artRepo.EXPECT().GetByAuthor(gomock.Any(), int64(123), int64(0),
+					domain.CursorOf(arts[exportBatchSize-1]), exportBatchSize).
+					Return(arts[exportBatchSize:], nil)
+				return userRepo, artRepo
+			},
+			oldKey:     "exports/123/old.zip",
+			wantStatus: domain.UserExportStatusDone,
+		},
+		{
+			name: "查询点赞收藏失败",
+			mock: func(ctrl *gomock.Controller) (repository.UserRepository, repository.ArticleRepository) {
+				userRepo := repomocks.NewMockUserRepository(ctrl)
+				userRepo.EXPECT().FindById(gomock.Any(), int64(123)).
+					Return(domain.User{Id: 123}, nil)
+				artRepo := repomocks.NewMockArticleRepository(ctrl)
+				artRepo.EXPECT().GetByAuthor(gomock.Any(), int64(123), int64(0),
+					domain.ArticleCursor{}, exportBatchSize).Return(nil, nil)
+				return userRepo, artRepo
+			},
+			intrErr:    errors.New("grpc 错误"),
+			oldKey:     "exports/123/old.zip",
+			wantErr:    errors.New("grpc 错误"),
+			wantStatus: domain.UserExportStatusFailed,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ctx := context.Background()
+			store := objstore.NewLocalStore(t.TempDir(), "/media/files")
+			err := store.Put(ctx, tc.oldKey, strings.NewReader("old"), 3, "application/zip")
+			require.NoError(t, err)
+
+			userRepo, artRepo := tc.mock(ctrl)
+			exportRepo := repomocks.NewMockUserExportRepository(ctrl)
+			exportRepo.EXPECT().Get(gomock.Any(), int64(123)).Return(domain.UserExport{
+				Uid:    123,
+				Status: domain.UserExportStatusPending,
+				Key:    tc.oldKey,
+				Ctime:  ctime,
+			}, nil)
+			var saved domain.UserExport
+			exportRepo.EXPECT().Set(gomock.Any(), gomock.Any(), time.Hour).
+				DoAndReturn(func(ctx context.Context, export domain.UserExport, expiration time.Duration) error {
+					saved = export
+					return nil
+				})
+			svc := NewUserDataService(userRepo, artRepo, exportRepo,
+				&fakeIntrClient{err: tc.intrErr},
+				&fakeCommentClient{comments: comments},
+				&fakeFollowClient{relations: followees},
+				nil, store, nil, UserDataConfig{ExportExpiration: time.Hour})
+			err = svc.Export(ctx, 123)
+			assert.Equal(t, tc.wantErr, err)
+			assert.Equal(t, tc.wantStatus, saved.Status)
+			assert.Equal(t, ctime, saved.Ctime)
+
+			_, err = store.Get(ctx, tc.oldKey)
+			if tc.wantErr != nil {
+				// 失败了旧的也不能删，记录里面还指向它
+				require.NoError(t, err)
+				return
+			}
+			assert.Equal(t, objstore.ErrObjectNotFound, err)
+			assert.True(t, strings.HasPrefix(saved.Key, "exports/123/"))
+			files := readExportArchive(t, store, saved.Key)
+
+			var profile exportProfile
+			require.NoError(t, json.Unmarshal(files["profile.json"], &profile))
+			// JSON 反序列化出来的时区不一样，时间单独比较
+			assert.True(t, ctime.Equal(profile.Ctime))
+			profile.Ctime = time.Time{}
+			assert.Equal(t, exportProfile{
+				Id:         123,
+				Email:      "123@qq.com",
+				Nickname:   "大明",
+				Identities: []string{"github"},
+			}, profile)
+			var gotArts []exportArticle
+			require.NoError(t, json.Unmarshal(files["articles.json"], &gotArts))
+			assert.Len(t, gotArts, len(arts))
+			var gotComments []exportComment
+			require.NoError(t, json.Unmarshal(files["comments.json"], &gotComments))
+			assert.Len(t, gotComments, len(comments))
+			var likes []exportInteraction
+			require.NoError(t, json.Unmarshal(files["likes.json"], &likes))
+			require.Len(t, likes, 1)
+			assert.True(t, ctime.Equal(likes[0].Ctime))
+			likes[0].Ctime = time.Time{}
+			assert.Equal(t, exportInteraction{Biz: "article", BizId: 1}, likes[0])
+			var colls []exportInteraction
+			require.NoError(t, json.Unmarshal(files["collections.json"], &colls))
+			require.Len(t, colls, 1)
+			assert.True(t, ctime.Equal(colls[0].Ctime))
+			colls[0].Ctime = time.Time{}
+			assert.Equal(t, exportInteraction{Biz: "article", BizId: 2, Cid: 3}, colls[0])
+			var following []int64
+			require.NoError(t, json.Unmarshal(files["following.json"], &following))
+			assert.Len(t, following, len(followees))
+			assert.Equal(t, int64(1000), following[0])
+		})
+	}
+}
+
+func TestUserDataService_OpenExport(t *testing.T) {
+	ctx := context.Background()
+	store := objstore.NewLocalStore(t.TempDir(), "/media/files")
+	err := store.Put(ctx, "exports/123/a.zip", strings.NewReader("zip"), 3, "application/zip")
+	require.NoError(t, err)
+	testCases := []struct {
+		name   string
+		export domain.UserExport
+
+		wantErr error
+	}{
+		{
+			name: "可以下载",
+			export: domain.UserExport{Uid: 123, Status: domain.UserExportStatusDone,
+				Key: "exports/123/a.zip", Utime: time.Now()},
+		},
+		{
+			name:    "还在导出",
+			export:  domain.UserExport{Uid: 123, Status: domain.UserExportStatusPending},
+			wantErr: ErrUserExportNotReady,
+		},
+		{
+			name: "已经过期",
+			export: domain.UserExport{Uid: 123, Status: domain.UserExportStatusDone,
+				Key: "exports/123/a.zip", Utime: time.Now().Add(-2 * time.Hour)},
+			wantErr: ErrUserExportNotReady,
+		},
+		{
+			name: "文件已经被删掉了",
+			export: domain.UserExport{Uid: 123, Status: domain.UserExportStatusDone,
+				Key: "exports/123/b.zip", Utime: time.Now()},
+			wantErr: ErrUserExportNotReady,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			exportRepo := repomocks.NewMockUserExportRepository(ctrl)
+			exportRepo.EXPECT().Get(gomock.Any(), int64(123)).Return(tc.export, nil)
+			svc := NewUserDataService(nil, nil, exportRepo, nil, nil, nil, nil, store, nil,
+				UserDataConfig{ExportExpiration: time.Hour})
+			_, r, err := svc.OpenExport(ctx, 123)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			data, err := io.ReadAll(r)
+			require.NoError(t, err)
+			assert.NoError(t, r.Close())
+			assert.Equal(t, "zip", string(data))
+		})
+	}
+}
+
+func readExportArchive(t *testing.T, store objstore.Store, key string) map[string][]byte {
+	r, err := store.Get(context.Background(), key)
+	require.NoError(t, err)
+	defer r.Close()
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	res := make(map[string][]byte, len(zr.File))
+	for _, f := range zr.File {
+		fr, err := f.Open()
+		require.NoError(t, err)
+		res[f.Name], err = io.ReadAll(fr)
+		require.NoError(t, err)
+		require.NoError(t, fr.Close())
+	}
+	return res
+}
+
+// fakeIntrClient 只实现导出用到的方法
+type fakeIntrClient struct {
+	intrv1.InteractiveServiceClient
+	err error
+}
+
+func (f *fakeIntrClient) GetUserData(ctx context.Context, in *intrv1.GetUserDataRequest, opts ...grpc.CallOption) (*intrv1.GetUserDataResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	ctime := time.UnixMilli(1700000000000).UnixMilli()
+	return &intrv1.GetUserDataResponse{
+		Likes: []*intrv1.UserLike{
+			{Biz: "article", BizId: 1, Ctime: ctime},
+		},
+		Collections: []*intrv1.UserCollection{
+			{Biz: "article", BizId: 2, Cid: 3, Ctime: ctime},
+		},
+	}, nil
+}
+
+// fakeCommentClient 按照 MinId 分页
+type fakeCommentClient struct {
+	commentv1.CommentServiceClient
+	comments []*commentv1.Comment
+}
+
+func (f *fakeCommentClient) GetUserComments(ctx context.Context, in *commentv1.GetUserCommentsRequest, opts ...grpc.CallOption) (*commentv1.GetUserCommentsResponse, error) {
+	var res []*commentv1.Comment
+	for _, c := range f.comments {
+		if c.GetId() > in.GetMinId() && len(res) < int(in.GetLimit()) {
+			res = append(res, c)
+		}
+	}
+	return &commentv1.GetUserCommentsResponse{Comments: res}, nil
+}
+
+// fakeFollowClient 按照 offset 分页
+type fakeFollowClient struct {
+	followv1.FollowServiceClient
+	relations []*followv1.FollowRelation
+}
+
+func (f *fakeFollowClient) GetFollowee(ctx context.Context, in *followv1.GetFolloweeRequest, opts ...grpc.CallOption) (*followv1.GetFolloweeResponse, error) {
+	start := min(int(in.GetOffset()), len(f.relations))
+	end := min(start+int(in.GetLimit()), len(f.relations))
+	return &followv1.GetFolloweeResponse{FollowRelations: f.relations[start:end]}, nil
+}
+
+type fakeUserProducer struct {
+	err     error
+	deleted []int64
+}
+
+func (f *fakeUserProducer) ProduceDeletedEvent(evt user.DeletedEvent) error {
+	f.deleted = append(f.deleted, evt.Uid)
+	return f.err
+}
+
+func (f *fakeUserProducer) ProduceExportEvent(evt user.ExportEvent) error {
+	return f.err
+}
+
+func (f *fakeUserProducer) ProduceMergedEvent(evt user.MergedEvent) error {
+	return f.err
+}
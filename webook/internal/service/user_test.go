@@ -110,10 +110,54 @@ func TestUserService_Login(t *testing.T) {
 			defer ctrl.Finish()
 
 			repo := tc.mock(ctrl)
-			svc := NewUserService(repo)
+			svc := NewUserService(repo, nil, nil, nil, UserConfig{})
 			user, err := svc.Login(tc.ctx, tc.email, tc.password)
 			assert.Equal(t, tc.wantErr, err)
 			assert.Equal(t, tc.wantUser, user)
 		})
 	}
 }
+
+func TestUserService_ForgotPassword(t *testing.T) {
+	testCases := []struct {
+		name string
+
+		mock func(ctrl *gomock.Controller) (repository.UserRepository, repository.UserTokenRepository)
+
+		wantErr error
+	}{
+		{
+			name: "邮箱没有注册",
+			mock: func(ctrl *gomock.Controller) (repository.UserRepository, repository.UserTokenRepository) {
+				ur := repomocks.NewMockUserRepository(ctrl)
+				tr := repomocks.NewMockUserTokenRepository(ctrl)
+				tr.EXPECT().Throttle(gomock.Any(), bizResetPassword, "123@qq.com").Return(nil)
+				ur.EXPECT().FindByEmail(gomock.Any(), "123@qq.com").
+					Return(domain.User{}, repository.ErrUserNotFound)
+				return ur, tr
+			},
+		},
+		{
+			// 不管邮箱有没有注册，都是先限流，所以响应是一样的
+			name: "发送太频繁",
+			mock: func(ctrl *gomock.Controller) (repository.UserRepository, repository.UserTokenRepository) {
+				ur := repomocks.NewMockUserRepository(ctrl)
+				tr := repomocks.NewMockUserTokenRepository(ctrl)
+				tr.EXPECT().Throttle(gomock.Any(), bizResetPassword, "123@qq.com").
+					Return(repository.ErrUserTokenSendTooMany)
+				return ur, tr
+			},
+			wantErr: ErrUserTokenSendTooMany,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			ur, tr := tc.mock(ctrl)
+			svc := NewUserService(ur, tr, nil, nil, UserConfig{})
+			err := svc.ForgotPassword(context.Background(), "123@qq.com")
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package web
+
+import (
+	"webook/internal/errs"
+	"webook/internal/service"
+	ijwt "webook/internal/web/jwt"
+	"webook/pkg/ginx"
+	rbacx "webook/pkg/ginx/middleware/rbac"
+	"webook/pkg/rbac"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+const permUserRoleWrite = "user:role:write"
+
+// AdminHandler 管理后台的接口，每个接口都要单独声明需要的权限
+type AdminHandler struct {
+	ijwt.Handler
+	userSvc service.UserService
+	policy  *rbac.Policy
+	rbac    *rbacx.Builder
+}
+
+func NewAdminHandler(userSvc service.UserService, hdl ijwt.Handler,
+	policy *rbac.Policy, rbacBuilder *rbacx.Builder) *AdminHandler {
+	return &AdminHandler{
+		Handler: hdl,
+		userSvc: userSvc,
+		policy:  policy,
+		rbac:    rbacBuilder,
+	}
+}
+
+func (h *AdminHandler) RegisterRoutes(server *gin.Engine) {
+	g := server.Group("/admin")
+	g.POST("/users/roles", h.rbac.Require(permUserRoleWrite),
+		ginx.WrapClaimsAndReq[UpdateRolesReq](h.UpdateRoles))
+}
+
+type UpdateRolesReq struct {
+	Uid   int64    `json:"uid"`
+	Roles []string `json:"roles"`
+}
+
+func (h *AdminHandler) UpdateRoles(ctx *gin.Context, req UpdateRolesReq, uc ijwt.UserClaims) (ginx.Result, error) {
+	for _, role := range req.Roles {
+		if !h.policy.HasRole(role) {
+			return ginx.Result{
+				Code: errs.UserInvalidInput,
+				Msg:  "未知的角色 " + role,
+			}, nil
+		}
+	}
+	err := h.userSvc.UpdateRoles(ctx, req.Uid, req.Roles)
+	switch err {
+	case nil:
+	case service.ErrUserNotFound:
+		return ginx.Result{
+			Code: errs.UserInvalidInput,
+			Msg:  "用户不存在",
+		}, nil
+	default:
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, err
+	}
+	zap.L().Info("修改用户角色", zap.Int64("operator", uc.Uid),
+		zap.Int64("uid", req.Uid), zap.Strings("roles", req.Roles))
+	// 角色在短 token 里面，踢掉所有登录让新的角色马上生效，
+	// 不然被收回的权限要等到短 token 过期才失效
+	err = h.RevokeOtherSessions(ctx, req.Uid, "")
+	if err != nil {
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, err
+	}
+	return ginx.Result{
+		Msg: "OK",
+	}, nil
+}
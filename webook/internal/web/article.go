@@ -11,27 +11,33 @@ import (
 	"webook/internal/web/jwt"
 	"webook/pkg/ginx"
 	"webook/pkg/logger"
+	"webook/pkg/mdx"
 
 	"github.com/ecodeclub/ekit/slice"
 	"github.com/gin-gonic/gin"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type ArticleHandler struct {
-	as  service.ArticleService
-	is  intrv1.InteractiveServiceClient
-	l   logger.LoggerV1
-	biz string
+	as   service.ArticleService
+	is   intrv1.InteractiveServiceClient
+	site SiteConfig
+	l    logger.LoggerV1
+	biz  string
 }
 
 func NewArticleHandler(as service.ArticleService,
 	is intrv1.InteractiveServiceClient,
+	site SiteConfig,
 	l logger.LoggerV1) *ArticleHandler {
 	return &ArticleHandler{
-		as:  as,
-		is:  is,
-		l:   l,
-		biz: "article",
+		as:   as,
+		is:   is,
+		site: site,
+		l:    l,
+		biz:  "article",
 	}
 }
 
@@ -39,13 +45,19 @@ func (ah *ArticleHandler) RegisterRoutes(server *gin.Engine) {
 	ag := server.Group("/articles")
 	ag.POST("/edit", ah.Edit)
 	ag.POST("/publish", ah.Publish)
+	// 定时发表
+	ag.POST("/schedule", ginx.WrapClaimsAndReq[ScheduleReq](ah.Schedule))
 	ag.POST("/withdraw", ah.Withdraw)
 
 	// 创作者接口
 	ag.GET("/detail/:id", ah.Detail)
 	// 按照道理来说，这边就是 GET 方法
-	// /list?offset=?&limit=?
+	// /list?cursor=?&limit=?
 	ag.POST("/list", ah.List)
+	// 历史版本
+	ah.registerRevisionRoutes(ag)
+	// 协作者和操作记录
+	ah.registerCollaboratorRoutes(ag)
 
 	pub := ag.Group("/pub")
 	pub.GET("/:id", ginx.WrapClaims(ah.PubDetail))
@@ -53,27 +65,44 @@ func (ah *ArticleHandler) RegisterRoutes(server *gin.Engine) {
 	pub.POST("/collect", ah.Collect)
 }
 
-// Edit 接收 Article 输入，返回一个 ID，文章的 ID
+// Edit 接收 Article 输入，返回文章的 ID 和保存之后的版本号。
+// 协作者也可以保存，Version 是读到的版本号，不是最新的就会保存失败
 func (ah *ArticleHandler) Edit(ctx *gin.Context) {
 	type Req struct {
 		Id      int64
 		Title   string `json:"title"`
 		Content string `json:"content"`
+		CoverId int64  `json:"coverId"`
+		Version int64  `json:"version"`
 	}
 	var req Req
 	if err := ctx.Bind(&req); err != nil {
 		return
 	}
 	uc := ctx.MustGet("user").(jwt.UserClaims)
-	id, err := ah.as.Save(ctx, domain.Article{
+	art := domain.Article{
 		Id:      req.Id,
 		Title:   req.Title,
 		Content: req.Content,
+		Version: req.Version,
 		Author: domain.Author{
 			Id: uc.Uid,
 		},
-	})
-	if err != nil {
+		Cover: domain.Media{
+			Id: req.CoverId,
+		},
+	}
+	id, err := ah.as.Save(ctx, art)
+	switch err {
+	case nil:
+	case service.ErrArticleCoverNotFound, service.ErrArticleVersionConflict,
+		service.ErrArticleForbidden:
+		ctx.JSON(http.StatusOK, ginx.Result{
+			Code: 4,
+			Msg:  err.Error(),
+		})
+		return
+	default:
 		ctx.JSON(http.StatusOK, ginx.Result{
 			Msg: "系统错误",
 		})
@@ -83,15 +112,21 @@ func (ah *ArticleHandler) Edit(ctx *gin.Context) {
 		return
 	}
 	ctx.JSON(http.StatusOK, ginx.Result{
-		Data: id,
+		Data: ArticleEditVo{
+			Id:      id,
+			Version: art.Version + 1,
+		},
 	})
 }
 
+// Publish 和 Edit 一样返回文章的 ID 和保存之后的版本号，发表之后还能接着编辑
 func (ah *ArticleHandler) Publish(ctx *gin.Context) {
 	type Req struct {
 		Id      int64
 		Title   string `json:"title"`
 		Content string `json:"content"`
+		CoverId int64  `json:"coverId"`
+		Version int64  `json:"version"`
 	}
 	var req Req
 	if err := ctx.Bind(&req); err != nil {
@@ -102,10 +137,33 @@ func (ah *ArticleHandler) Publish(ctx *gin.Context) {
 		Id:      req.Id,
 		Title:   req.Title,
 		Content: req.Content,
+		Version: req.Version,
 		Author: domain.Author{
 			Id: uc.Uid,
 		},
+		Cover: domain.Media{
+			Id: req.CoverId,
+		},
 	})
+	vo := ArticleEditVo{
+		Id:      id,
+		Version: req.Version + 1,
+	}
+	if err == service.ErrArticleCoverNotFound || err == service.ErrArticleVersionConflict {
+		ctx.JSON(http.StatusOK, ginx.Result{
+			Code: 4,
+			Msg:  err.Error(),
+		})
+		return
+	}
+	if err == service.ErrArticlePendingReview {
+		// 保存成功了，只是还没发表，前端根据 Msg 提示作者
+		ctx.JSON(http.StatusOK, ginx.Result{
+			Msg:  err.Error(),
+			Data: vo,
+		})
+		return
+	}
 	if err != nil {
 		ctx.JSON(http.StatusOK, ginx.Result{
 			Code: 5,
@@ -117,10 +175,52 @@ func (ah *ArticleHandler) Publish(ctx *gin.Context) {
 		return
 	}
 	ctx.JSON(http.StatusOK, ginx.Result{
-		Data: id,
+		Data: vo,
 	})
 }
 
+func (ah *ArticleHandler) Schedule(ctx *gin.Context, req ScheduleReq, uc jwt.UserClaims) (ginx.Result, error) {
+	id, err := ah.as.Schedule(ctx, domain.Article{
+		Id:      req.Id,
+		Title:   req.Title,
+		Content: req.Content,
+		Version: req.Version,
+		Author: domain.Author{
+			Id: uc.Uid,
+		},
+		Cover: domain.Media{
+			Id: req.CoverId,
+		},
+		PublishAt: time.UnixMilli(req.PublishAt),
+	})
+	vo := ArticleEditVo{
+		Id:      id,
+		Version: req.Version + 1,
+	}
+	switch err {
+	case nil:
+		return ginx.Result{
+			Data: vo,
+		}, nil
+	case service.ErrArticlePendingReview:
+		return ginx.Result{
+			Msg:  err.Error(),
+			Data: vo,
+		}, nil
+	case service.ErrInvalidPublishAt, service.ErrArticleCoverNotFound,
+		service.ErrArticleVersionConflict:
+		return ginx.Result{
+			Code: 4,
+			Msg:  err.Error(),
+		}, nil
+	default:
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, fmt.Errorf("定时发表文章失败 %w", err)
+	}
+}
+
 func (ah *ArticleHandler) Withdraw(ctx *gin.Context) {
 	type Req struct {
 		Id int64
@@ -147,14 +247,38 @@ func (ah *ArticleHandler) Withdraw(ctx *gin.Context) {
 	})
 }
 
+// ArticleListReq ColumnId 不为 0 的时候只列出这个专栏里面的文章
+type ArticleListReq struct {
+	CursorPage
+	ColumnId int64 `json:"columnId"`
+}
+
 func (ah *ArticleHandler) List(ctx *gin.Context) {
-	var page Page
+	var page ArticleListReq
 	if err := ctx.Bind(&page); err != nil {
 		return
 	}
+	if page.Limit <= 0 || page.Limit > 100 {
+		ctx.JSON(http.StatusOK, ginx.Result{
+			Code: 4,
+			Msg:  "分页参数错误",
+		})
+		return
+	}
+	cursor, err := domain.ParseArticleCursor(page.Cursor)
+	if err != nil {
+		ctx.JSON(http.StatusOK, ginx.Result{
+			Code: 4,
+			Msg:  "分页参数错误",
+		})
+		ah.l.Warn("文章列表的游标不对",
+			logger.String("cursor", page.Cursor),
+			logger.Error(err))
+		return
+	}
 
 	uc := ctx.MustGet("user").(jwt.UserClaims)
-	arts, err := ah.as.GetByAuthor(ctx, uc.Uid, page.Offset, page.Limit)
+	arts, err := ah.as.GetByAuthor(ctx, uc.Uid, page.ColumnId, cursor, page.Limit)
 	if err != nil {
 		ctx.JSON(http.StatusOK, ginx.Result{
 			Code: 5,
@@ -162,26 +286,31 @@ func (ah *ArticleHandler) List(ctx *gin.Context) {
 		})
 		ah.l.Error("查找文章列表失败",
 			logger.Error(err),
-			logger.Int("offset", page.Offset),
+			logger.String("cursor", page.Cursor),
 			logger.Int("limit", page.Limit),
+			logger.Int64("cid", page.ColumnId),
 			logger.Int64("uid", uc.Uid))
 		return
 	}
 	ctx.JSON(http.StatusOK, ginx.Result{
-		Data: slice.Map[domain.Article, ArticleVo](arts, func(idx int, src domain.Article) ArticleVo {
-			return ArticleVo{
-				Id:       src.Id,
-				Title:    src.Title,
-				Abstract: src.Abstract(),
-
-				//Content:  src.Content,
-				AuthorId: src.Author.Id,
-				// 列表，你不需要
-				Status: src.Status.ToUint8(),
-				Ctime:  src.Ctime.Format(time.DateTime),
-				Utime:  src.Utime.Format(time.DateTime),
-			}
-		}),
+		Data: ArticleListVo{
+			Articles: slice.Map[domain.Article, ArticleVo](arts, func(idx int, src domain.Article) ArticleVo {
+				return ArticleVo{
+					Id:       src.Id,
+					Title:    src.Title,
+					Abstract: src.Abstract(),
+
+					//Content:  src.Content,
+					AuthorId: src.Author.Id,
+					CoverId:  src.Cover.Id,
+					// 列表，你不需要
+					Status: src.Status.ToUint8(),
+					Ctime:  src.Ctime.Format(time.DateTime),
+					Utime:  src.Utime.Format(time.DateTime),
+				}
+			}),
+			Cursor: domain.NextArticleCursor(arts, page.Limit).Encode(),
+		},
 	})
 }
 
@@ -210,7 +339,19 @@ func (ah *ArticleHandler) Detail(ctx *gin.Context) {
 		return
 	}
 	uc := ctx.MustGet("user").(jwt.UserClaims)
-	if art.Author.Id != uc.Uid {
+	role, err := ah.as.Role(ctx, uc.Uid, art)
+	if err != nil {
+		ctx.JSON(http.StatusOK, ginx.Result{
+			Msg:  "系统错误",
+			Code: 5,
+		})
+		ah.l.Error("查询文章权限失败",
+			logger.Int64("id", id),
+			logger.Int64("uid", uc.Uid),
+			logger.Error(err))
+		return
+	}
+	if !role.CanView() {
 		// 有人在搞鬼
 		ctx.JSON(http.StatusOK, ginx.Result{
 			Msg:  "系统错误",
@@ -222,15 +363,25 @@ func (ah *ArticleHandler) Detail(ctx *gin.Context) {
 		return
 	}
 
+	stats := art.TextStats()
 	vo := ArticleVo{
 		Id:    art.Id,
 		Title: art.Title,
 
-		Content:  art.Content,
-		AuthorId: art.Author.Id,
-		Status:   art.Status.ToUint8(),
-		Ctime:    art.Ctime.Format(time.DateTime),
-		Utime:    art.Utime.Format(time.DateTime),
+		Content:     art.Content,
+		AuthorId:    art.Author.Id,
+		Status:      art.Status.ToUint8(),
+		Version:     art.Version,
+		Role:        role.ToUint8(),
+		CoverId:     art.Cover.Id,
+		CoverUrl:    art.Cover.Url,
+		WordCount:   stats.Total(),
+		ReadingTime: int(stats.ReadingTime() / time.Minute),
+		Ctime:       art.Ctime.Format(time.DateTime),
+		Utime:       art.Utime.Format(time.DateTime),
+	}
+	if !art.PublishAt.IsZero() {
+		vo.PublishAt = art.PublishAt.Format(time.DateTime)
 	}
 	ctx.JSON(http.StatusOK, ginx.Result{Data: vo})
 }
@@ -274,16 +425,29 @@ func (ah *ArticleHandler) PubDetail(ctx *gin.Context, uc jwt.UserClaims) (ginx.R
 		}, fmt.Errorf("获取文章信息失败 %w", err)
 	}
 
+	stats := art.TextStats()
 	return ginx.Result{
 		Data: ArticleVo{
 			Id:    art.Id,
 			Title: art.Title,
 
 			Content:    art.Content,
+			Html:       art.Rendered.Html,
+			Toc:        ah.toTocVos(art.Rendered.Toc),
+			CoverId:    art.Cover.Id,
+			CoverUrl:   art.Cover.Url,
+			Abstract:   art.Abstract(),
 			AuthorId:   art.Author.Id,
 			AuthorName: art.Author.Name,
 
+			WordCount:   stats.Total(),
+			ReadingTime: int(stats.ReadingTime() / time.Minute),
+			Slug:        art.Slug(),
+			Seo:         ah.site.toSeoVo(art),
+
 			ReadCnt:    intr.Intr.ReadCnt,
+			UvCnt:      intr.Intr.UvCnt,
+			TodayUvCnt: intr.Intr.TodayUvCnt,
 			CollectCnt: intr.Intr.CollectCnt,
 			LikeCnt:    intr.Intr.LikeCnt,
 			Liked:      intr.Intr.Liked,
@@ -331,6 +495,13 @@ func (ah *ArticleHandler) Collect(ctx *gin.Context) {
 	_, err := ah.is.Collect(ctx, &intrv1.CollectRequest{
 		Biz: ah.biz, BizId: req.Id, Uid: uc.Uid, Cid: req.Cid,
 	})
+	if status.Code(err) == codes.NotFound {
+		ctx.JSON(http.StatusOK, ginx.Result{
+			Code: 4,
+			Msg:  "收藏夹不存在",
+		})
+		return
+	}
 	if err != nil {
 		ctx.JSON(http.StatusOK, ginx.Result{
 			Code: 5,
@@ -347,7 +518,27 @@ func (ah *ArticleHandler) Collect(ctx *gin.Context) {
 	})
 }
 
+func (ah *ArticleHandler) toTocVos(toc []mdx.Heading) []TocItemVo {
+	return slice.Map[mdx.Heading, TocItemVo](toc, func(idx int, src mdx.Heading) TocItemVo {
+		return TocItemVo{
+			Level: src.Level,
+			Id:    src.Id,
+			Title: src.Title,
+		}
+	})
+}
+
 type LikeReq struct {
 	Id   int64 `json:"id"`
 	Like bool  `json:"like"`
 }
+
+type ScheduleReq struct {
+	Id      int64  `json:"id"`
+	Title   string `json:"title"`
+	Content string `json:"content"`
+	CoverId int64  `json:"coverId"`
+	Version int64  `json:"version"`
+	// PublishAt 毫秒数
+	PublishAt int64 `json:"publishAt"`
+}
@@ -0,0 +1,158 @@
+package web
+
+import (
+	"fmt"
+	"time"
+	"webook/internal/domain"
+	"webook/internal/service"
+	"webook/internal/web/jwt"
+	"webook/pkg/ginx"
+
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/gin-gonic/gin"
+)
+
+func (ah *ArticleHandler) registerCollaboratorRoutes(ag *gin.RouterGroup) {
+	cg := ag.Group("/collaborators")
+	cg.POST("/list", ginx.WrapClaimsAndReq[CollaboratorListReq](ah.Collaborators))
+	cg.POST("/invite", ginx.WrapClaimsAndReq[InviteCollaboratorReq](ah.InviteCollaborator))
+	cg.POST("/remove", ginx.WrapClaimsAndReq[RemoveCollaboratorReq](ah.RemoveCollaborator))
+	ag.POST("/audits/list", ginx.WrapClaimsAndReq[AuditLogListReq](ah.AuditLogs))
+}
+
+type CollaboratorListReq struct {
+	ArticleId int64 `json:"articleId"`
+}
+
+type InviteCollaboratorReq struct {
+	ArticleId int64 `json:"articleId"`
+	Uid       int64 `json:"uid"`
+	// Role 1 只读，2 可以编辑
+	Role uint8 `json:"role"`
+}
+
+type RemoveCollaboratorReq struct {
+	ArticleId int64 `json:"articleId"`
+	Uid       int64 `json:"uid"`
+}
+
+type AuditLogListReq struct {
+	ArticleId int64 `json:"articleId"`
+	Offset    int   `json:"offset"`
+	Limit     int   `json:"limit"`
+}
+
+func (ah *ArticleHandler) Collaborators(ctx *gin.Context, req CollaboratorListReq, uc jwt.UserClaims) (ginx.Result, error) {
+	cs, err := ah.as.ListCollaborators(ctx, uc.Uid, req.ArticleId)
+	switch err {
+	case nil:
+		return ginx.Result{
+			Data: slice.Map[domain.ArticleCollaborator, CollaboratorVo](cs,
+				func(idx int, src domain.ArticleCollaborator) CollaboratorVo {
+					return CollaboratorVo{
+						Uid:     src.Uid,
+						Role:    src.Role.ToUint8(),
+						Inviter: src.Inviter,
+						Ctime:   src.Ctime.Format(time.DateTime),
+						Utime:   src.Utime.Format(time.DateTime),
+					}
+				}),
+		}, nil
+	case service.ErrArticleForbidden:
+		return ginx.Result{
+			Code: 4,
+			Msg:  err.Error(),
+		}, nil
+	default:
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, fmt.Errorf("查询文章 %d 的协作者失败 %w", req.ArticleId, err)
+	}
+}
+
+func (ah *ArticleHandler) InviteCollaborator(ctx *gin.Context, req InviteCollaboratorReq, uc jwt.UserClaims) (ginx.Result, error) {
+	err := ah.as.Invite(ctx, uc.Uid, domain.ArticleCollaborator{
+		ArticleId: req.ArticleId,
+		Uid:       req.Uid,
+		Role:      domain.ArticleRole(req.Role),
+	})
+	switch err {
+	case nil:
+		return ginx.Result{
+			Msg: "OK",
+		}, nil
+	case service.ErrArticleForbidden, service.ErrInvalidCollaborator:
+		return ginx.Result{
+			Code: 4,
+			Msg:  err.Error(),
+		}, nil
+	default:
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, fmt.Errorf("邀请 %d 协作文章 %d 失败 %w", req.Uid, req.ArticleId, err)
+	}
+}
+
+func (ah *ArticleHandler) RemoveCollaborator(ctx *gin.Context, req RemoveCollaboratorReq, uc jwt.UserClaims) (ginx.Result, error) {
+	err := ah.as.RemoveCollaborator(ctx, uc.Uid, req.ArticleId, req.Uid)
+	switch err {
+	case nil:
+		return ginx.Result{
+			Msg: "OK",
+		}, nil
+	case service.ErrArticleForbidden:
+		return ginx.Result{
+			Code: 4,
+			Msg:  err.Error(),
+		}, nil
+	case service.ErrCollaboratorNotFound:
+		return ginx.Result{
+			Code: 4,
+			Msg:  "协作者不存在",
+		}, nil
+	default:
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, fmt.Errorf("移除文章 %d 的协作者 %d 失败 %w", req.ArticleId, req.Uid, err)
+	}
+}
+
+// AuditLogs 谁在什么时候改了什么，具体的内容看历史版本
+func (ah *ArticleHandler) AuditLogs(ctx *gin.Context, req AuditLogListReq, uc jwt.UserClaims) (ginx.Result, error) {
+	if req.Offset < 0 || req.Limit <= 0 || req.Limit > 100 {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "分页参数错误",
+		}, nil
+	}
+	logs, err := ah.as.ListAuditLogs(ctx, uc.Uid, req.ArticleId, req.Offset, req.Limit)
+	switch err {
+	case nil:
+		return ginx.Result{
+			Data: slice.Map[domain.ArticleAuditLog, ArticleAuditLogVo](logs,
+				func(idx int, src domain.ArticleAuditLog) ArticleAuditLogVo {
+					return ArticleAuditLogVo{
+						Id:      src.Id,
+						Uid:     src.Uid,
+						Action:  src.Action.String(),
+						Version: src.Version,
+						Detail:  src.Detail,
+						Ctime:   src.Ctime.Format(time.DateTime),
+					}
+				}),
+		}, nil
+	case service.ErrArticleForbidden:
+		return ginx.Result{
+			Code: 4,
+			Msg:  err.Error(),
+		}, nil
+	default:
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, fmt.Errorf("查询文章 %d 的操作记录失败 %w", req.ArticleId, err)
+	}
+}
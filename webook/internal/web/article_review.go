@@ -0,0 +1,191 @@
+package web
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+	"webook/internal/domain"
+	"webook/internal/service"
+	"webook/internal/web/jwt"
+	"webook/pkg/ginx"
+	rbacx "webook/pkg/ginx/middleware/rbac"
+
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/gin-gonic/gin"
+)
+
+const permArticleReview = "article:review"
+
+// ArticleReviewHandler 人工审核没有通过自动审核的文章，挂在管理后台下面
+type ArticleReviewHandler struct {
+	svc  service.ArticleReviewService
+	rbac *rbacx.Builder
+}
+
+func NewArticleReviewHandler(svc service.ArticleReviewService, rbacBuilder *rbacx.Builder) *ArticleReviewHandler {
+	return &ArticleReviewHandler{
+		svc:  svc,
+		rbac: rbacBuilder,
+	}
+}
+
+func (h *ArticleReviewHandler) RegisterRoutes(server *gin.Engine) {
+	g := server.Group("/admin/articles/reviews", h.rbac.Require(permArticleReview))
+	g.POST("/list", ginx.WrapReq[ArticleReviewListReq](h.List))
+	g.GET("/:id", ginx.WrapClaims(h.Detail))
+	g.POST("/approve", ginx.WrapClaimsAndReq[ArticleReviewReq](h.Approve))
+	g.POST("/reject", ginx.WrapClaimsAndReq[ArticleReviewReq](h.Reject))
+}
+
+type ArticleReviewListReq struct {
+	Offset int `json:"offset"`
+	Limit  int `json:"limit"`
+}
+
+type ArticleReviewReq struct {
+	Id int64 `json:"id"`
+	// Comment 审核意见，拒绝的时候必须写，会通知作者
+	Comment string `json:"comment"`
+}
+
+type ArticleReviewVo struct {
+	Id        int64    `json:"id"`
+	ArticleId int64    `json:"articleId"`
+	AuthorId  int64    `json:"authorId"`
+	Version   int64    `json:"version"`
+	Title     string   `json:"title"`
+	Reasons   []string `json:"reasons"`
+	Status    uint8    `json:"status"`
+	Reviewer  int64    `json:"reviewer"`
+	Comment   string   `json:"comment"`
+	Ctime     string   `json:"ctime"`
+	Utime     string   `json:"utime"`
+}
+
+// ArticleReviewDetailVo Outdated 说明作者在审核期间改过了，Article 是改过之后的内容
+type ArticleReviewDetailVo struct {
+	Review   ArticleReviewVo `json:"review"`
+	Article  ArticleVo       `json:"article"`
+	Outdated bool            `json:"outdated"`
+}
+
+func (h *ArticleReviewHandler) List(ctx *gin.Context, req ArticleReviewListReq) (ginx.Result, error) {
+	if req.Limit <= 0 || req.Limit > 100 {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "limit 必须在 1 到 100 之间",
+		}, nil
+	}
+	rs, err := h.svc.ListPending(ctx, req.Offset, req.Limit)
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, fmt.Errorf("查询待审核的文章失败 %w", err)
+	}
+	return ginx.Result{
+		Data: slice.Map[domain.ArticleReview, ArticleReviewVo](rs,
+			func(idx int, src domain.ArticleReview) ArticleReviewVo {
+				return h.toVo(src)
+			}),
+	}, nil
+}
+
+func (h *ArticleReviewHandler) Detail(ctx *gin.Context, uc jwt.UserClaims) (ginx.Result, error) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "参数错误",
+		}, nil
+	}
+	r, art, err := h.svc.Detail(ctx, id)
+	switch err {
+	case nil:
+		return ginx.Result{
+			Data: ArticleReviewDetailVo{
+				Review: h.toVo(r),
+				Article: ArticleVo{
+					Id:       art.Id,
+					Title:    art.Title,
+					Content:  art.Content,
+					Html:     art.Rendered.Html,
+					CoverId:  art.Cover.Id,
+					AuthorId: art.Author.Id,
+					Status:   art.Status.ToUint8(),
+					Version:  art.Version,
+					Ctime:    art.Ctime.Format(time.DateTime),
+					Utime:    art.Utime.Format(time.DateTime),
+				},
+				Outdated: art.Version != r.Version ||
+					art.Status != domain.ArticleStatusPendingReview,
+			},
+		}, nil
+	case service.ErrArticleReviewNotFound:
+		return ginx.Result{
+			Code: 4,
+			Msg:  "审核记录不存在",
+		}, nil
+	default:
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, fmt.Errorf("查询审核记录 %d 失败 %w", id, err)
+	}
+}
+
+func (h *ArticleReviewHandler) Approve(ctx *gin.Context, req ArticleReviewReq, uc jwt.UserClaims) (ginx.Result, error) {
+	err := h.svc.Approve(ctx, uc.Uid, req.Id, req.Comment)
+	return h.resolveResult(req.Id, err)
+}
+
+func (h *ArticleReviewHandler) Reject(ctx *gin.Context, req ArticleReviewReq, uc jwt.UserClaims) (ginx.Result, error) {
+	if req.Comment == "" {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "请告诉作者为什么没有通过审核",
+		}, nil
+	}
+	err := h.svc.Reject(ctx, uc.Uid, req.Id, req.Comment)
+	return h.resolveResult(req.Id, err)
+}
+
+func (h *ArticleReviewHandler) resolveResult(id int64, err error) (ginx.Result, error) {
+	switch err {
+	case nil:
+		return ginx.Result{
+			Msg: "OK",
+		}, nil
+	case service.ErrArticleReviewResolved, service.ErrArticleReviewOutdated:
+		return ginx.Result{
+			Code: 4,
+			Msg:  err.Error(),
+		}, nil
+	case service.ErrArticleReviewNotFound:
+		return ginx.Result{
+			Code: 4,
+			Msg:  "审核记录不存在",
+		}, nil
+	default:
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, fmt.Errorf("处理审核记录 %d 失败 %w", id, err)
+	}
+}
+
+func (h *ArticleReviewHandler) toVo(r domain.ArticleReview) ArticleReviewVo {
+	return ArticleReviewVo{
+		Id:        r.Id,
+		ArticleId: r.ArticleId,
+		AuthorId:  r.Author.Id,
+		Version:   r.Version,
+		Title:     r.Title,
+		Reasons:   r.Reasons,
+		Status:    r.Status.ToUint8(),
+		Reviewer:  r.Reviewer,
+		Comment:   r.Comment,
+		Ctime:     r.Ctime.Format(time.DateTime),
+		Utime:     r.Utime.Format(time.DateTime),
+	}
+}
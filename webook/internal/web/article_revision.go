@@ -0,0 +1,164 @@
+package web
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+	"webook/internal/domain"
+	"webook/internal/service"
+	"webook/internal/web/jwt"
+	"webook/pkg/diffx"
+	"webook/pkg/ginx"
+
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/gin-gonic/gin"
+)
+
+func (ah *ArticleHandler) registerRevisionRoutes(ag *gin.RouterGroup) {
+	rg := ag.Group("/revisions")
+	rg.POST("/list", ginx.WrapClaimsAndReq[RevisionListReq](ah.Revisions))
+	rg.GET("/:rid", ginx.WrapClaims(ah.RevisionDetail))
+	rg.POST("/diff", ginx.WrapClaimsAndReq[RevisionDiffReq](ah.DiffRevisions))
+	rg.POST("/restore", ginx.WrapClaimsAndReq[RestoreRevisionReq](ah.RestoreRevision))
+}
+
+type RevisionListReq struct {
+	ArticleId int64 `json:"articleId"`
+	Offset    int   `json:"offset"`
+	Limit     int   `json:"limit"`
+}
+
+type RevisionDiffReq struct {
+	From int64 `json:"from"`
+	To   int64 `json:"to"`
+}
+
+type RestoreRevisionReq struct {
+	Id int64 `json:"id"`
+}
+
+// Revisions 历史版本列表，不返回内容
+func (ah *ArticleHandler) Revisions(ctx *gin.Context, req RevisionListReq, uc jwt.UserClaims) (ginx.Result, error) {
+	if req.Offset < 0 || req.Limit <= 0 || req.Limit > 100 {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "分页参数错误",
+		}, nil
+	}
+	revs, err := ah.as.ListRevisions(ctx, uc.Uid, req.ArticleId, req.Offset, req.Limit)
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, fmt.Errorf("查询文章 %d 的历史版本失败 %w", req.ArticleId, err)
+	}
+	return ginx.Result{
+		Data: slice.Map[domain.ArticleRevision, ArticleRevisionVo](revs,
+			func(idx int, src domain.ArticleRevision) ArticleRevisionVo {
+				return ah.toRevisionVo(src, false)
+			}),
+	}, nil
+}
+
+func (ah *ArticleHandler) RevisionDetail(ctx *gin.Context, uc jwt.UserClaims) (ginx.Result, error) {
+	ridstr := ctx.Param("rid")
+	rid, err := strconv.ParseInt(ridstr, 10, 64)
+	if err != nil {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "参数错误",
+		}, fmt.Errorf("历史版本的 ID %s 不正确, %w", ridstr, err)
+	}
+	rev, err := ah.as.GetRevision(ctx, uc.Uid, rid)
+	switch err {
+	case nil:
+		return ginx.Result{
+			Data: ah.toRevisionVo(rev, true),
+		}, nil
+	case service.ErrArticleRevisionNotFound:
+		return ginx.Result{
+			Code: 4,
+			Msg:  "历史版本不存在",
+		}, nil
+	default:
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+}
+
+func (ah *ArticleHandler) DiffRevisions(ctx *gin.Context, req RevisionDiffReq, uc jwt.UserClaims) (ginx.Result, error) {
+	diff, err := ah.as.DiffRevisions(ctx, uc.Uid, req.From, req.To)
+	switch err {
+	case nil:
+		return ginx.Result{
+			Data: ArticleDiffVo{
+				From:    ah.toRevisionVo(diff.From, false),
+				To:      ah.toRevisionVo(diff.To, false),
+				Title:   ah.toDiffLineVos(diff.Title),
+				Content: ah.toDiffLineVos(diff.Content),
+			},
+		}, nil
+	case service.ErrArticleRevisionNotFound:
+		return ginx.Result{
+			Code: 4,
+			Msg:  "历史版本不存在",
+		}, nil
+	case service.ErrArticleRevisionMismatch:
+		return ginx.Result{
+			Code: 4,
+			Msg:  err.Error(),
+		}, nil
+	default:
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+}
+
+// RestoreRevision 恢复成草稿之后，需要作者自己再发表一次
+func (ah *ArticleHandler) RestoreRevision(ctx *gin.Context, req RestoreRevisionReq, uc jwt.UserClaims) (ginx.Result, error) {
+	id, err := ah.as.RestoreRevision(ctx, uc.Uid, req.Id)
+	switch err {
+	case nil:
+		return ginx.Result{
+			Data: id,
+		}, nil
+	case service.ErrArticleRevisionNotFound:
+		return ginx.Result{
+			Code: 4,
+			Msg:  "历史版本不存在",
+		}, nil
+	default:
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+}
+
+func (ah *ArticleHandler) toRevisionVo(rev domain.ArticleRevision, withContent bool) ArticleRevisionVo {
+	vo := ArticleRevisionVo{
+		Id:        rev.Id,
+		ArticleId: rev.ArticleId,
+		Title:     rev.Title,
+		Status:    rev.Status.ToUint8(),
+		Kind:      rev.Kind.ToUint8(),
+		Ctime:     rev.Ctime.Format(time.DateTime),
+	}
+	if withContent {
+		vo.Content = rev.Content
+	}
+	return vo
+}
+
+func (ah *ArticleHandler) toDiffLineVos(lines []diffx.Line) []DiffLineVo {
+	return slice.Map[diffx.Line, DiffLineVo](lines, func(idx int, src diffx.Line) DiffLineVo {
+		return DiffLineVo{
+			Op:   uint8(src.Op),
+			Text: src.Text,
+		}
+	})
+}
@@ -44,7 +44,7 @@ func TestArticleHandler_Publish(t *testing.T) {
 			reqBody:  `{"title":"我的标题", "content":"我的内容"}`,
 			wantCode: http.StatusOK,
 			wantRes: ginx.Result{
-				Data: float64(1),
+				Data: map[string]any{"id": float64(1), "version": float64(1)},
 			},
 		},
 		{
@@ -55,16 +55,37 @@ func TestArticleHandler_Publish(t *testing.T) {
 					Id:      123,
 					Title:   "我的标题",
 					Content: "我的内容",
+					Version: 3,
 					Author: domain.Author{
 						Id: 123,
 					},
 				}).Return(int64(123), nil)
 				return svc
 			},
-			reqBody:  `{"id": 123, "title":"我的标题", "content":"我的内容"}`,
+			reqBody:  `{"id": 123, "title":"我的标题", "content":"我的内容", "version": 3}`,
 			wantCode: http.StatusOK,
 			wantRes: ginx.Result{
-				Data: float64(123),
+				Data: map[string]any{"id": float64(123), "version": float64(4)},
+			},
+		},
+		{
+			name: "需要人工审核",
+			mock: func(ctrl *gomock.Controller) service.ArticleService {
+				svc := svcmocks.NewMockArticleService(ctrl)
+				svc.EXPECT().Publish(gomock.Any(), domain.Article{
+					Title:   "我的标题",
+					Content: "我的内容",
+					Author: domain.Author{
+						Id: 123,
+					},
+				}).Return(int64(1), service.ErrArticlePendingReview)
+				return svc
+			},
+			reqBody:  `{"title":"我的标题", "content":"我的内容"}`,
+			wantCode: http.StatusOK,
+			wantRes: ginx.Result{
+				Msg:  service.ErrArticlePendingReview.Error(),
+				Data: map[string]any{"id": float64(1), "version": float64(1)},
 			},
 		},
 		{
@@ -104,7 +125,7 @@ func TestArticleHandler_Publish(t *testing.T) {
 			defer ctrl.Finish()
 
 			articleSvc := tc.mock(ctrl)
-			hdl := NewArticleHandler(articleSvc, nil, logger.NewNopLogger())
+			hdl := NewArticleHandler(articleSvc, nil, SiteConfig{}, logger.NewNopLogger())
 
 			server := gin.Default()
 			server.Use(func(ctx *gin.Context) {
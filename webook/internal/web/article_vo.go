@@ -1,22 +1,103 @@
 package web
 
+// ArticleListVo Cursor 为空说明没有下一页了
+type ArticleListVo struct {
+	Articles []ArticleVo `json:"articles"`
+	Cursor   string      `json:"cursor,omitempty"`
+}
+
+// ArticleEditVo 继续编辑的时候要带上新的版本号
+type ArticleEditVo struct {
+	Id      int64 `json:"id"`
+	Version int64 `json:"version"`
+}
+
 type ArticleVo struct {
-	Id         int64  `json:"id,omitempty"`
-	Title      string `json:"title,omitempty"`
-	Abstract   string `json:"abstract,omitempty"`
-	Content    string `json:"content,omitempty"`
-	AuthorId   int64  `json:"authorId,omitempty"`
-	AuthorName string `json:"authorName,omitempty"`
-	Status     uint8  `json:"status,omitempty"`
-	Ctime      string `json:"ctime,omitempty"`
-	Utime      string `json:"utime,omitempty"`
+	Id         int64       `json:"id,omitempty"`
+	Title      string      `json:"title,omitempty"`
+	Abstract   string      `json:"abstract,omitempty"`
+	Content    string      `json:"content,omitempty"`
+	Html       string      `json:"html,omitempty"`
+	Toc        []TocItemVo `json:"toc,omitempty"`
+	CoverId    int64       `json:"coverId,omitempty"`
+	CoverUrl   string      `json:"coverUrl,omitempty"`
+	AuthorId   int64       `json:"authorId,omitempty"`
+	AuthorName string      `json:"authorName,omitempty"`
+	Status     uint8       `json:"status,omitempty"`
+	Ctime      string      `json:"ctime,omitempty"`
+	Utime      string      `json:"utime,omitempty"`
+	// PublishAt 定时发表的时间
+	PublishAt string `json:"publishAt,omitempty"`
+	// Version 保存草稿的时候要带上
+	Version int64 `json:"version,omitempty"`
+	// Role 当前用户对草稿的权限
+	Role uint8 `json:"role,omitempty"`
+
+	// WordCount 一个汉字和一个英文单词都算一个字
+	WordCount int `json:"wordCount,omitempty"`
+	// ReadingTime 阅读时间，单位是分钟
+	ReadingTime int    `json:"readingTime,omitempty"`
+	Slug        string `json:"slug,omitempty"`
+	// Seo 只有读者看的详情页有
+	Seo *SeoVo `json:"seo,omitempty"`
 
 	// 点赞之类的信息
 	LikeCnt    int64 `json:"likeCnt"`
 	CollectCnt int64 `json:"collectCnt"`
 	ReadCnt    int64 `json:"readCnt"`
+	// UvCnt 去重之后的读者数，TodayUvCnt 是今天的
+	UvCnt      int64 `json:"uvCnt"`
+	TodayUvCnt int64 `json:"todayUvCnt"`
 
 	// 个人是否点赞的信息
 	Liked     bool `json:"liked"`
 	Collected bool `json:"collected"`
 }
+
+type TocItemVo struct {
+	Level int    `json:"level"`
+	Id    string `json:"id"`
+	Title string `json:"title"`
+}
+
+type ArticleRevisionVo struct {
+	Id        int64  `json:"id"`
+	ArticleId int64  `json:"articleId"`
+	Title     string `json:"title"`
+	Content   string `json:"content,omitempty"`
+	Status    uint8  `json:"status"`
+	// Kind 1-保存 2-发表
+	Kind  uint8  `json:"kind"`
+	Ctime string `json:"ctime"`
+}
+
+type ArticleDiffVo struct {
+	From    ArticleRevisionVo `json:"from"`
+	To      ArticleRevisionVo `json:"to"`
+	Title   []DiffLineVo      `json:"title"`
+	Content []DiffLineVo      `json:"content"`
+}
+
+type DiffLineVo struct {
+	// Op 0-没有变化 1-新增 2-删除
+	Op   uint8  `json:"op"`
+	Text string `json:"text"`
+}
+
+type CollaboratorVo struct {
+	Uid int64 `json:"uid"`
+	// Role 1 只读，2 可以编辑
+	Role    uint8  `json:"role"`
+	Inviter int64  `json:"inviter"`
+	Ctime   string `json:"ctime"`
+	Utime   string `json:"utime"`
+}
+
+type ArticleAuditLogVo struct {
+	Id      int64  `json:"id"`
+	Uid     int64  `json:"uid"`
+	Action  string `json:"action"`
+	Version int64  `json:"version,omitempty"`
+	Detail  string `json:"detail"`
+	Ctime   string `json:"ctime"`
+}
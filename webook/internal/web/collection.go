@@ -0,0 +1,309 @@
+package web
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+	"unicode/utf8"
+	intrv1 "webook/api/proto/gen/intr/v1"
+	"webook/internal/web/jwt"
+	"webook/pkg/ginx"
+	"webook/pkg/logger"
+
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CollectionHandler 收藏夹，目前只能收藏文章
+type CollectionHandler struct {
+	is  intrv1.InteractiveServiceClient
+	biz string
+	l   logger.LoggerV1
+}
+
+func NewCollectionHandler(is intrv1.InteractiveServiceClient, l logger.LoggerV1) *CollectionHandler {
+	return &CollectionHandler{
+		is:  is,
+		biz: "article",
+		l:   l,
+	}
+}
+
+func (h *CollectionHandler) RegisterRoutes(server *gin.Engine) {
+	cg := server.Group("/collections")
+	cg.POST("/edit", ginx.WrapClaimsAndReq[CollectionEditReq](h.Edit))
+	cg.POST("/delete", ginx.WrapClaimsAndReq[CollectionIdReq](h.Delete))
+	// 别人的收藏夹只能看到公开的
+	cg.POST("/list", ginx.WrapClaimsAndReq[CollectionListReq](h.List))
+	cg.GET("/detail/:id", ginx.WrapClaims(h.Detail))
+	cg.POST("/items", ginx.WrapClaimsAndReq[CollectionItemsReq](h.Items))
+	cg.POST("/items/move", ginx.WrapClaimsAndReq[CollectionMoveReq](h.Move))
+	// 取消收藏
+	cg.POST("/items/remove", ginx.WrapClaimsAndReq[CollectionIdReq](h.Remove))
+}
+
+type CollectionEditReq struct {
+	Id          int64  `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	// 1-私有，2-公开
+	Visibility int32 `json:"visibility"`
+}
+
+type CollectionIdReq struct {
+	Id int64 `json:"id"`
+}
+
+type CollectionListReq struct {
+	// Uid 为 0 的时候是自己的收藏夹
+	Uid    int64 `json:"uid"`
+	Offset int   `json:"offset"`
+	Limit  int   `json:"limit"`
+}
+
+type CollectionItemsReq struct {
+	// Uid 为 0 的时候是自己的收藏夹
+	Uid int64 `json:"uid"`
+	// Cid 为 0 的时候是默认收藏夹
+	Cid    int64 `json:"cid"`
+	Offset int   `json:"offset"`
+	Limit  int   `json:"limit"`
+}
+
+type CollectionMoveReq struct {
+	// Id 文章 ID
+	Id  int64 `json:"id"`
+	Cid int64 `json:"cid"`
+}
+
+type CollectionVo struct {
+	Id          int64  `json:"id"`
+	Uid         int64  `json:"uid"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Visibility  int32  `json:"visibility"`
+	ItemCnt     int64  `json:"itemCnt"`
+	Ctime       string `json:"ctime"`
+	Utime       string `json:"utime"`
+}
+
+type CollectionItemVo struct {
+	Biz   string `json:"biz"`
+	BizId int64  `json:"bizId"`
+	Cid   int64  `json:"cid"`
+	Ctime string `json:"ctime"`
+}
+
+func (h *CollectionHandler) Edit(ctx *gin.Context, req CollectionEditReq, uc jwt.UserClaims) (ginx.Result, error) {
+	if req.Name == "" || utf8.RuneCountInString(req.Name) > 64 ||
+		utf8.RuneCountInString(req.Description) > 256 ||
+		(req.Visibility != 1 && req.Visibility != 2) {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "名字、简介或者可见性不对",
+		}, nil
+	}
+	resp, err := h.is.SaveCollection(ctx, &intrv1.SaveCollectionRequest{
+		Collection: &intrv1.Collection{
+			Id:          req.Id,
+			Uid:         uc.Uid,
+			Name:        req.Name,
+			Description: req.Description,
+			Visibility:  req.Visibility,
+		},
+	})
+	if status.Code(err) == codes.NotFound {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "收藏夹不存在",
+		}, nil
+	}
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, fmt.Errorf("保存收藏夹 %d 失败 %w", req.Id, err)
+	}
+	return ginx.Result{
+		Data: resp.GetId(),
+	}, nil
+}
+
+func (h *CollectionHandler) Delete(ctx *gin.Context, req CollectionIdReq, uc jwt.UserClaims) (ginx.Result, error) {
+	_, err := h.is.DeleteCollection(ctx, &intrv1.DeleteCollectionRequest{
+		Uid: uc.Uid,
+		Id:  req.Id,
+	})
+	if status.Code(err) == codes.NotFound {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "收藏夹不存在",
+		}, nil
+	}
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, fmt.Errorf("删除收藏夹 %d 失败 %w", req.Id, err)
+	}
+	return ginx.Result{
+		Msg: "OK",
+	}, nil
+}
+
+func (h *CollectionHandler) List(ctx *gin.Context, req CollectionListReq, uc jwt.UserClaims) (ginx.Result, error) {
+	if req.Offset < 0 || req.Limit <= 0 || req.Limit > 100 {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "分页参数错误",
+		}, nil
+	}
+	uid := req.Uid
+	if uid == 0 {
+		uid = uc.Uid
+	}
+	resp, err := h.is.ListCollections(ctx, &intrv1.ListCollectionsRequest{
+		Uid:    uid,
+		Viewer: uc.Uid,
+		Offset: int32(req.Offset),
+		Limit:  int32(req.Limit),
+	})
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, fmt.Errorf("查询 %d 的收藏夹失败 %w", uid, err)
+	}
+	return ginx.Result{
+		Data: slice.Map(resp.GetCollections(), func(idx int, src *intrv1.Collection) CollectionVo {
+			return h.toVo(src)
+		}),
+	}, nil
+}
+
+func (h *CollectionHandler) Detail(ctx *gin.Context, uc jwt.UserClaims) (ginx.Result, error) {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "参数错误",
+		}, nil
+	}
+	resp, err := h.is.GetCollection(ctx, &intrv1.GetCollectionRequest{
+		Id:     id,
+		Viewer: uc.Uid,
+	})
+	if status.Code(err) == codes.NotFound {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "收藏夹不存在",
+		}, nil
+	}
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, fmt.Errorf("查询收藏夹 %d 失败 %w", id, err)
+	}
+	return ginx.Result{
+		Data: h.toVo(resp.GetCollection()),
+	}, nil
+}
+
+func (h *CollectionHandler) Items(ctx *gin.Context, req CollectionItemsReq, uc jwt.UserClaims) (ginx.Result, error) {
+	if req.Offset < 0 || req.Limit <= 0 || req.Limit > 100 {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "分页参数错误",
+		}, nil
+	}
+	uid := req.Uid
+	if uid == 0 {
+		uid = uc.Uid
+	}
+	resp, err := h.is.ListCollectionItems(ctx, &intrv1.ListCollectionItemsRequest{
+		Uid:    uid,
+		Cid:    req.Cid,
+		Viewer: uc.Uid,
+		Offset: int32(req.Offset),
+		Limit:  int32(req.Limit),
+	})
+	if status.Code(err) == codes.NotFound {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "收藏夹不存在",
+		}, nil
+	}
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, fmt.Errorf("查询收藏夹 %d 的内容失败 %w", req.Cid, err)
+	}
+	return ginx.Result{
+		Data: slice.Map(resp.GetItems(), func(idx int, src *intrv1.UserCollection) CollectionItemVo {
+			return CollectionItemVo{
+				Biz:   src.GetBiz(),
+				BizId: src.GetBizId(),
+				Cid:   src.GetCid(),
+				Ctime: time.UnixMilli(src.GetCtime()).Format(time.DateTime),
+			}
+		}),
+	}, nil
+}
+
+func (h *CollectionHandler) Move(ctx *gin.Context, req CollectionMoveReq, uc jwt.UserClaims) (ginx.Result, error) {
+	_, err := h.is.MoveCollectionItem(ctx, &intrv1.MoveCollectionItemRequest{
+		Biz:   h.biz,
+		BizId: req.Id,
+		Uid:   uc.Uid,
+		Cid:   req.Cid,
+	})
+	if status.Code(err) == codes.NotFound {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "收藏夹不存在",
+		}, nil
+	}
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, fmt.Errorf("把文章 %d 挪到收藏夹 %d 失败 %w", req.Id, req.Cid, err)
+	}
+	return ginx.Result{
+		Msg: "OK",
+	}, nil
+}
+
+func (h *CollectionHandler) Remove(ctx *gin.Context, req CollectionIdReq, uc jwt.UserClaims) (ginx.Result, error) {
+	_, err := h.is.CancelCollect(ctx, &intrv1.CancelCollectRequest{
+		Biz:   h.biz,
+		BizId: req.Id,
+		Uid:   uc.Uid,
+	})
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, fmt.Errorf("取消收藏文章 %d 失败 %w", req.Id, err)
+	}
+	return ginx.Result{
+		Msg: "OK",
+	}, nil
+}
+
+func (h *CollectionHandler) toVo(c *intrv1.Collection) CollectionVo {
+	return CollectionVo{
+		Id:          c.GetId(),
+		Uid:         c.GetUid(),
+		Name:        c.GetName(),
+		Description: c.GetDescription(),
+		Visibility:  c.GetVisibility(),
+		ItemCnt:     c.GetItemCnt(),
+		Ctime:       time.UnixMilli(c.GetCtime()).Format(time.DateTime),
+		Utime:       time.UnixMilli(c.GetUtime()).Format(time.DateTime),
+	}
+}
@@ -0,0 +1,148 @@
+package web
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	intrdomain "webook/interactive/domain"
+	intrsvc "webook/interactive/service"
+	intrsvcmocks "webook/interactive/service/mocks"
+	"webook/internal/client"
+	ijwt "webook/internal/web/jwt"
+	"webook/pkg/ginx"
+	"webook/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCollectionHandler(t *testing.T) {
+	initBizCodeCounter()
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) intrsvc.CollectionService
+		path string
+		body string
+
+		wantRes ginx.Result
+	}{
+		{
+			name: "查看别人的私有收藏夹",
+			mock: func(ctrl *gomock.Controller) intrsvc.CollectionService {
+				svc := intrsvcmocks.NewMockCollectionService(ctrl)
+				svc.EXPECT().Get(gomock.Any(), int64(2), int64(123)).
+					Return(intrdomain.Collection{}, intrsvc.ErrCollectionNotFound)
+				return svc
+			},
+			path:    "/collections/detail/2",
+			wantRes: ginx.Result{Code: 4, Msg: "收藏夹不存在"},
+		},
+		{
+			name: "查看收藏夹系统错误",
+			mock: func(ctrl *gomock.Controller) intrsvc.CollectionService {
+				svc := intrsvcmocks.NewMockCollectionService(ctrl)
+				svc.EXPECT().Get(gomock.Any(), int64(2), int64(123)).
+					Return(intrdomain.Collection{}, errors.New("mock db 错误"))
+				return svc
+			},
+			path:    "/collections/detail/2",
+			wantRes: ginx.Result{Code: 5, Msg: "系统错误"},
+		},
+		{
+			name: "删除别人的收藏夹",
+			mock: func(ctrl *gomock.Controller) intrsvc.CollectionService {
+				svc := intrsvcmocks.NewMockCollectionService(ctrl)
+				svc.EXPECT().Delete(gomock.Any(), int64(123), int64(2)).
+					Return(intrsvc.ErrCollectionNotFound)
+				return svc
+			},
+			path:    "/collections/delete",
+			body:    `{"id":2}`,
+			wantRes: ginx.Result{Code: 4, Msg: "收藏夹不存在"},
+		},
+		{
+			name: "挪到别人的收藏夹",
+			mock: func(ctrl *gomock.Controller) intrsvc.CollectionService {
+				svc := intrsvcmocks.NewMockCollectionService(ctrl)
+				svc.EXPECT().MoveItem(gomock.Any(), "article", int64(1), int64(123), int64(2)).
+					Return(intrsvc.ErrCollectionNotFound)
+				return svc
+			},
+			path:    "/collections/items/move",
+			body:    `{"id":1,"cid":2}`,
+			wantRes: ginx.Result{Code: 4, Msg: "收藏夹不存在"},
+		},
+		{
+			name: "收藏夹列表分页参数错误",
+			mock: func(ctrl *gomock.Controller) intrsvc.CollectionService {
+				return intrsvcmocks.NewMockCollectionService(ctrl)
+			},
+			path:    "/collections/list",
+			body:    `{"offset":0,"limit":101}`,
+			wantRes: ginx.Result{Code: 4, Msg: "分页参数错误"},
+		},
+		{
+			name: "收藏夹内容分页参数错误",
+			mock: func(ctrl *gomock.Controller) intrsvc.CollectionService {
+				return intrsvcmocks.NewMockCollectionService(ctrl)
+			},
+			path:    "/collections/items",
+			body:    `{"cid":2,"offset":-1,"limit":10}`,
+			wantRes: ginx.Result{Code: 4, Msg: "分页参数错误"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// 走本地调用的适配器，错误码的转换和 gRPC 服务端是一样的
+			is := client.NewLocalInteractiveServiceAdapter(nil, tc.mock(ctrl))
+			hdl := NewCollectionHandler(is, logger.NewNopLogger())
+			server := gin.Default()
+			server.Use(func(ctx *gin.Context) {
+				ctx.Set("user", ijwt.UserClaims{
+					Uid: 123,
+				})
+			})
+			hdl.RegisterRoutes(server)
+
+			method := http.MethodPost
+			if tc.body == "" {
+				method = http.MethodGet
+			}
+			req, err := http.NewRequest(method, tc.path, bytes.NewBufferString(tc.body))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			recorder := httptest.NewRecorder()
+			server.ServeHTTP(recorder, req)
+			require.Equal(t, http.StatusOK, recorder.Code)
+
+			var res ginx.Result
+			err = json.NewDecoder(recorder.Body).Decode(&res)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantRes, res)
+		})
+	}
+}
+
+var bizCodeCounterOnce sync.Once
+
+// initBizCodeCounter ginx 的包变量只能注册一次，用到 ginx.Wrap 系列的测试都调用这个
+func initBizCodeCounter() {
+	bizCodeCounterOnce.Do(func() {
+		ginx.InitCounter(prometheus.CounterOpts{
+			Namespace: "test",
+			Subsystem: "webook",
+			Name:      "biz_code",
+		})
+	})
+}
@@ -0,0 +1,316 @@
+package web
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+	"unicode/utf8"
+	"webook/internal/domain"
+	"webook/internal/service"
+	"webook/internal/web/jwt"
+	"webook/pkg/ginx"
+	"webook/pkg/logger"
+
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/gin-gonic/gin"
+)
+
+// ColumnHandler 专栏，创作者把文章组织成一个系列
+type ColumnHandler struct {
+	svc service.ColumnService
+	l   logger.LoggerV1
+}
+
+func NewColumnHandler(svc service.ColumnService, l logger.LoggerV1) *ColumnHandler {
+	return &ColumnHandler{
+		svc: svc,
+		l:   l,
+	}
+}
+
+func (h *ColumnHandler) RegisterRoutes(server *gin.Engine) {
+	cg := server.Group("/columns")
+	// 创作者接口
+	cg.POST("/edit", ginx.WrapClaimsAndReq[ColumnEditReq](h.Edit))
+	cg.POST("/delete", ginx.WrapClaimsAndReq[ColumnIdReq](h.Delete))
+	cg.POST("/list", ginx.WrapClaims(h.List))
+	cg.POST("/articles/add", ginx.WrapClaimsAndReq[ColumnArticleReq](h.AddArticle))
+	cg.POST("/articles/remove", ginx.WrapClaimsAndReq[ColumnArticleReq](h.RemoveArticle))
+	cg.POST("/articles/reorder", ginx.WrapClaimsAndReq[ColumnReorderReq](h.Reorder))
+
+	// 读者接口
+	pub := cg.Group("/pub")
+	pub.GET("/:id", ginx.WrapClaims(h.PubDetail))
+	pub.POST("/articles", ginx.WrapClaimsAndReq[ColumnPubArticlesReq](h.PubArticles))
+	// 文章详情页里面的上一篇和下一篇
+	pub.GET("/nav/:aid", ginx.WrapClaims(h.Nav))
+}
+
+type ColumnEditReq struct {
+	Id          int64  `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+type ColumnIdReq struct {
+	Id int64 `json:"id"`
+}
+
+type ColumnArticleReq struct {
+	ColumnId  int64 `json:"columnId"`
+	ArticleId int64 `json:"articleId"`
+}
+
+type ColumnReorderReq struct {
+	ColumnId int64 `json:"columnId"`
+	// ArticleIds 专栏里面全部文章的新顺序
+	ArticleIds []int64 `json:"articleIds"`
+}
+
+type ColumnPubArticlesReq struct {
+	ColumnId int64 `json:"columnId"`
+	Offset   int   `json:"offset"`
+	Limit    int   `json:"limit"`
+}
+
+type ColumnVo struct {
+	Id          int64  `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	AuthorId    int64  `json:"authorId"`
+	Ctime       string `json:"ctime"`
+	Utime       string `json:"utime"`
+}
+
+// ColumnNavVo 文章不在专栏里面的时候 Column 是 nil
+type ColumnNavVo struct {
+	Column *ColumnVo  `json:"column"`
+	Prev   *ArticleVo `json:"prev"`
+	Next   *ArticleVo `json:"next"`
+}
+
+func (h *ColumnHandler) Edit(ctx *gin.Context, req ColumnEditReq, uc jwt.UserClaims) (ginx.Result, error) {
+	if req.Title == "" || utf8.RuneCountInString(req.Title) > 64 ||
+		utf8.RuneCountInString(req.Description) > 256 {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "标题或者简介不对",
+		}, nil
+	}
+	id, err := h.svc.Save(ctx, domain.Column{
+		Id:          req.Id,
+		Title:       req.Title,
+		Description: req.Description,
+		Author: domain.Author{
+			Id: uc.Uid,
+		},
+	})
+	switch err {
+	case nil:
+		return ginx.Result{
+			Data: id,
+		}, nil
+	case service.ErrColumnNotFound:
+		return ginx.Result{
+			Code: 4,
+			Msg:  "专栏不存在",
+		}, nil
+	default:
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, fmt.Errorf("保存专栏 %d 失败 %w", req.Id, err)
+	}
+}
+
+func (h *ColumnHandler) Delete(ctx *gin.Context, req ColumnIdReq, uc jwt.UserClaims) (ginx.Result, error) {
+	err := h.svc.Delete(ctx, uc.Uid, req.Id)
+	switch err {
+	case nil:
+		return ginx.Result{
+			Msg: "OK",
+		}, nil
+	case service.ErrColumnNotFound:
+		return ginx.Result{
+			Code: 4,
+			Msg:  "专栏不存在",
+		}, nil
+	default:
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, fmt.Errorf("删除专栏 %d 失败 %w", req.Id, err)
+	}
+}
+
+// List 自己的专栏
+func (h *ColumnHandler) List(ctx *gin.Context, uc jwt.UserClaims) (ginx.Result, error) {
+	cs, err := h.svc.ListByAuthor(ctx, uc.Uid)
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, fmt.Errorf("查询 %d 的专栏失败 %w", uc.Uid, err)
+	}
+	return ginx.Result{
+		Data: slice.Map[domain.Column, ColumnVo](cs, func(idx int, src domain.Column) ColumnVo {
+			return h.toVo(src)
+		}),
+	}, nil
+}
+
+func (h *ColumnHandler) AddArticle(ctx *gin.Context, req ColumnArticleReq, uc jwt.UserClaims) (ginx.Result, error) {
+	res, err := h.articleResult(h.svc.AddArticle(ctx, uc.Uid, req.ColumnId, req.ArticleId))
+	if err != nil {
+		return res, fmt.Errorf("添加专栏 %d 的文章 %d 失败 %w", req.ColumnId, req.ArticleId, err)
+	}
+	return res, nil
+}
+
+func (h *ColumnHandler) RemoveArticle(ctx *gin.Context, req ColumnArticleReq, uc jwt.UserClaims) (ginx.Result, error) {
+	res, err := h.articleResult(h.svc.RemoveArticle(ctx, uc.Uid, req.ColumnId, req.ArticleId))
+	if err != nil {
+		return res, fmt.Errorf("移除专栏 %d 的文章 %d 失败 %w", req.ColumnId, req.ArticleId, err)
+	}
+	return res, nil
+}
+
+func (h *ColumnHandler) Reorder(ctx *gin.Context, req ColumnReorderReq, uc jwt.UserClaims) (ginx.Result, error) {
+	res, err := h.articleResult(h.svc.Reorder(ctx, uc.Uid, req.ColumnId, req.ArticleIds))
+	if err != nil {
+		return res, fmt.Errorf("调整专栏 %d 的文章顺序失败 %w", req.ColumnId, err)
+	}
+	return res, nil
+}
+
+// articleResult 专栏里面的文章的操作，错误处理都是一样的，只有系统错误会返回 error
+func (h *ColumnHandler) articleResult(err error) (ginx.Result, error) {
+	switch err {
+	case nil:
+		return ginx.Result{
+			Msg: "OK",
+		}, nil
+	case service.ErrColumnNotFound:
+		return ginx.Result{
+			Code: 4,
+			Msg:  "专栏不存在",
+		}, nil
+	case service.ErrColumnArticleNotFound, service.ErrArticleAlreadyInColumn,
+		service.ErrColumnArticlesMismatch:
+		return ginx.Result{
+			Code: 4,
+			Msg:  err.Error(),
+		}, nil
+	default:
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+}
+
+func (h *ColumnHandler) PubDetail(ctx *gin.Context, uc jwt.UserClaims) (ginx.Result, error) {
+	idstr := ctx.Param("id")
+	id, err := strconv.ParseInt(idstr, 10, 64)
+	if err != nil {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "参数错误",
+		}, fmt.Errorf("查询专栏的 ID 不对 %s %w", idstr, err)
+	}
+	c, err := h.svc.GetById(ctx, id)
+	switch err {
+	case nil:
+		return ginx.Result{
+			Data: h.toVo(c),
+		}, nil
+	case service.ErrColumnNotFound:
+		return ginx.Result{
+			Code: 4,
+			Msg:  "专栏不存在",
+		}, nil
+	default:
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, fmt.Errorf("查询专栏 %d 失败 %w", id, err)
+	}
+}
+
+func (h *ColumnHandler) PubArticles(ctx *gin.Context, req ColumnPubArticlesReq, uc jwt.UserClaims) (ginx.Result, error) {
+	if req.Offset < 0 || req.Limit <= 0 || req.Limit > 100 {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "分页参数错误",
+		}, nil
+	}
+	arts, err := h.svc.ListPubArticles(ctx, req.ColumnId, req.Offset, req.Limit)
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, fmt.Errorf("查询专栏 %d 的文章失败 %w", req.ColumnId, err)
+	}
+	return ginx.Result{
+		Data: slice.Map[domain.Article, ArticleVo](arts, func(idx int, src domain.Article) ArticleVo {
+			return h.toArticleVo(src)
+		}),
+	}, nil
+}
+
+func (h *ColumnHandler) Nav(ctx *gin.Context, uc jwt.UserClaims) (ginx.Result, error) {
+	aidstr := ctx.Param("aid")
+	aid, err := strconv.ParseInt(aidstr, 10, 64)
+	if err != nil {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "参数错误",
+		}, fmt.Errorf("查询专栏导航的文章 ID 不对 %s %w", aidstr, err)
+	}
+	nav, err := h.svc.Nav(ctx, aid)
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, fmt.Errorf("查询文章 %d 的专栏导航失败 %w", aid, err)
+	}
+	var res ColumnNavVo
+	if nav.Column.Id > 0 {
+		c := h.toVo(nav.Column)
+		res.Column = &c
+	}
+	if nav.Prev.Id > 0 {
+		prev := h.toArticleVo(nav.Prev)
+		res.Prev = &prev
+	}
+	if nav.Next.Id > 0 {
+		next := h.toArticleVo(nav.Next)
+		res.Next = &next
+	}
+	return ginx.Result{
+		Data: res,
+	}, nil
+}
+
+func (h *ColumnHandler) toVo(c domain.Column) ColumnVo {
+	return ColumnVo{
+		Id:          c.Id,
+		Title:       c.Title,
+		Description: c.Description,
+		AuthorId:    c.Author.Id,
+		Ctime:       c.Ctime.Format(time.DateTime),
+		Utime:       c.Utime.Format(time.DateTime),
+	}
+}
+
+func (h *ColumnHandler) toArticleVo(art domain.Article) ArticleVo {
+	return ArticleVo{
+		Id:       art.Id,
+		Title:    art.Title,
+		Abstract: art.Abstract(),
+		AuthorId: art.Author.Id,
+		CoverId:  art.Cover.Id,
+		Ctime:    art.Ctime.Format(time.DateTime),
+		Utime:    art.Utime.Format(time.DateTime),
+	}
+}
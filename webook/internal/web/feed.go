@@ -0,0 +1,191 @@
+package web
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+	"webook/internal/domain"
+	"webook/internal/service"
+	"webook/pkg/logger"
+	"webook/pkg/seox"
+
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// feedSize 订阅源里面只放最新的这么多篇
+	feedSize = 20
+	// sitemapBatch 生成站点地图的时候每次取这么多篇
+	sitemapBatch = 500
+	// sitemapMaxURLs 一个站点地图最多五万个链接，这是协议的限制
+	sitemapMaxURLs = 50000
+	// sitemapTTL 生成一次要查很多次数据库，缓存起来，和 Cache-Control 保持一致
+	sitemapTTL = time.Hour
+)
+
+// FeedHandler 站点地图和 RSS、Atom 订阅源，都是给爬虫和阅读器用的，不需要登录
+type FeedHandler struct {
+	as   service.ArticleService
+	site SiteConfig
+	l    logger.LoggerV1
+
+	// mu 同时只有一个请求在生成站点地图，其它的等着用它的结果
+	mu            sync.Mutex
+	sitemap       []byte
+	sitemapExpire time.Time
+}
+
+func NewFeedHandler(as service.ArticleService, site SiteConfig, l logger.LoggerV1) *FeedHandler {
+	return &FeedHandler{
+		as:   as,
+		site: site,
+		l:    l,
+	}
+}
+
+func (h *FeedHandler) RegisterRoutes(server *gin.Engine) {
+	server.GET("/sitemap.xml", h.Sitemap)
+	fg := server.Group("/feed")
+	fg.GET("/rss", h.RSS)
+	fg.GET("/atom", h.Atom)
+	// 某个作者的订阅源
+	fg.GET("/authors/:uid/rss", h.RSS)
+	fg.GET("/authors/:uid/atom", h.Atom)
+}
+
+func (h *FeedHandler) Sitemap(ctx *gin.Context) {
+	data, err := h.getSitemap(ctx)
+	if err != nil {
+		ctx.AbortWithStatus(http.StatusInternalServerError)
+		h.l.Error("生成站点地图失败", logger.Error(err))
+		return
+	}
+	ctx.Header("Cache-Control", "public, max-age=3600")
+	ctx.Data(http.StatusOK, "application/xml; charset=utf-8", data)
+}
+
+// getSitemap 缓存过期了才重新生成
+func (h *FeedHandler) getSitemap(ctx context.Context) ([]byte, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	now := time.Now()
+	if h.sitemap != nil && now.Before(h.sitemapExpire) {
+		return h.sitemap, nil
+	}
+	data, err := h.buildSitemap(ctx)
+	if err != nil {
+		return nil, err
+	}
+	h.sitemap = data
+	h.sitemapExpire = now.Add(sitemapTTL)
+	return data, nil
+}
+
+func (h *FeedHandler) buildSitemap(ctx context.Context) ([]byte, error) {
+	var (
+		cursor  domain.ArticleCursor
+		entries []seox.Entry
+	)
+	for len(entries) < sitemapMaxURLs {
+		arts, err := h.as.ListPub(ctx, cursor, sitemapBatch)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, slice.Map(arts, func(idx int, src domain.Article) seox.Entry {
+			return h.toEntry(src)
+		})...)
+		cursor = domain.NextArticleCursor(arts, sitemapBatch)
+		if cursor.IsZero() {
+			break
+		}
+	}
+	if len(entries) > sitemapMaxURLs {
+		entries = entries[:sitemapMaxURLs]
+	}
+	return seox.Sitemap(entries)
+}
+
+func (h *FeedHandler) RSS(ctx *gin.Context) {
+	h.writeFeed(ctx, seox.RSS, "application/rss+xml; charset=utf-8")
+}
+
+func (h *FeedHandler) Atom(ctx *gin.Context) {
+	h.writeFeed(ctx, seox.Atom, "application/atom+xml; charset=utf-8")
+}
+
+// writeFeed 路径里面有 uid 的就是作者的订阅源
+func (h *FeedHandler) writeFeed(ctx *gin.Context,
+	build func(f seox.Feed) ([]byte, error), contentType string) {
+	var uid int64
+	if uidStr := ctx.Param("uid"); uidStr != "" {
+		var err error
+		uid, err = strconv.ParseInt(uidStr, 10, 64)
+		if err != nil || uid <= 0 {
+			ctx.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+	}
+	feed, err := h.feed(ctx, uid)
+	if err == nil {
+		feed.Self = h.site.AbsURL(ctx.Request.URL.Path)
+		var data []byte
+		data, err = build(feed)
+		if err == nil {
+			ctx.Header("Cache-Control", "public, max-age=600")
+			ctx.Data(http.StatusOK, contentType, data)
+			return
+		}
+	}
+	ctx.AbortWithStatus(http.StatusInternalServerError)
+	h.l.Error("生成订阅源失败",
+		logger.Int64("uid", uid),
+		logger.Error(err))
+}
+
+// feed uid 为 0 的时候是整站的订阅源
+func (h *FeedHandler) feed(ctx context.Context, uid int64) (seox.Feed, error) {
+	var (
+		arts []domain.Article
+		err  error
+	)
+	feed := seox.Feed{
+		Title:       h.site.Name,
+		Description: h.site.Description,
+		Link:        h.site.BaseURL,
+	}
+	if uid > 0 {
+		arts, err = h.as.ListPubByAuthor(ctx, uid, domain.ArticleCursor{}, feedSize)
+		feed.Link = h.site.BaseURL + "/authors/" + strconv.FormatInt(uid, 10)
+		if len(arts) > 0 && arts[0].Author.Name != "" {
+			feed.Title = arts[0].Author.Name + " - " + h.site.Name
+		}
+	} else {
+		arts, err = h.as.ListPub(ctx, domain.ArticleCursor{}, feedSize)
+	}
+	if err != nil {
+		return seox.Feed{}, err
+	}
+	feed.Entries = slice.Map(arts, func(idx int, src domain.Article) seox.Entry {
+		return h.toEntry(src)
+	})
+	// 按照更新时间倒序，第一篇就是最近更新的
+	feed.Updated = time.Now()
+	if len(arts) > 0 {
+		feed.Updated = arts[0].Utime
+	}
+	return feed, nil
+}
+
+func (h *FeedHandler) toEntry(art domain.Article) seox.Entry {
+	return seox.Entry{
+		Url:       h.site.ArticleURL(art),
+		Title:     art.Title,
+		Author:    art.Author.Name,
+		Summary:   art.Abstract(),
+		Published: art.Ctime,
+		Updated:   art.Utime,
+	}
+}
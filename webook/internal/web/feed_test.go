@@ -0,0 +1,62 @@
+package web
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"webook/internal/domain"
+	svcmocks "webook/internal/service/mocks"
+	"webook/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFeedHandler_Sitemap(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc := svcmocks.NewMockArticleService(ctrl)
+	// 两次请求只查一次数据库
+	svc.EXPECT().ListPub(gomock.Any(), domain.ArticleCursor{}, sitemapBatch).
+		Return([]domain.Article{{Id: 1, Title: "hello", Utime: time.Now()}}, nil)
+	hdl := NewFeedHandler(svc, SiteConfig{BaseURL: "https://webook.com"}, logger.NewNopLogger())
+	server := gin.Default()
+	hdl.RegisterRoutes(server)
+
+	var bodies []string
+	for i := 0; i < 2; i++ {
+		req, err := http.NewRequest(http.MethodGet, "/sitemap.xml", nil)
+		require.NoError(t, err)
+		recorder := httptest.NewRecorder()
+		server.ServeHTTP(recorder, req)
+		require.Equal(t, http.StatusOK, recorder.Code)
+		bodies = append(bodies, recorder.Body.String())
+	}
+	assert.Contains(t, bodies[0], "https://webook.com/articles/1")
+	assert.Equal(t, bodies[0], bodies[1])
+}
+
+func TestFeedHandler_AuthorRSS(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	svc := svcmocks.NewMockArticleService(ctrl)
+	// 不存在的作者和没有文章的作者一样，是空的订阅源
+	svc.EXPECT().ListPubByAuthor(gomock.Any(), int64(404), domain.ArticleCursor{}, feedSize).
+		Return(nil, nil)
+	hdl := NewFeedHandler(svc, SiteConfig{Name: "webook", BaseURL: "https://webook.com"},
+		logger.NewNopLogger())
+	server := gin.Default()
+	hdl.RegisterRoutes(server)
+
+	req, err := http.NewRequest(http.MethodGet, "/feed/authors/404/rss", nil)
+	require.NoError(t, err)
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "<title>webook</title>")
+}
@@ -0,0 +1,30 @@
+package web
+
+import (
+	"net/http"
+	ijwt "webook/internal/web/jwt"
+
+	"github.com/gin-gonic/gin"
+)
+
+// JWKSHandler 公开校验短 token 的公钥，
+// 别的服务拿着它就可以校验用户身份，不需要持有私钥
+type JWKSHandler struct {
+	keys *ijwt.KeySet
+}
+
+func NewJWKSHandler(keys *ijwt.KeySet) *JWKSHandler {
+	return &JWKSHandler{
+		keys: keys,
+	}
+}
+
+func (h *JWKSHandler) RegisterRoutes(server *gin.Engine) {
+	server.GET("/.well-known/jwks.json", h.JWKS)
+}
+
+func (h *JWKSHandler) JWKS(ctx *gin.Context) {
+	// 轮换的时候新 key 要先发布出来，所以缓存时间不能太长
+	ctx.Header("Cache-Control", "public, max-age=300")
+	ctx.JSON(http.StatusOK, h.keys.JWKS())
+}
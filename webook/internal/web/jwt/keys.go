@@ -0,0 +1,312 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	ErrUnknownKid     = errors.New("未知的 kid")
+	ErrKeyRetired     = errors.New("key 已经过了轮换窗口")
+	ErrUnsupportedKey = errors.New("不支持的密钥类型")
+)
+
+// RefreshKey 长 token 只会在 webook 内部校验，所以依旧使用对称加密
+type RefreshKey []byte
+
+// SigningKey 一把用来签名或者校验短 token 的非对称密钥
+type SigningKey struct {
+	Kid    string
+	Method jwt.SigningMethod
+	// Private 只有当前用来签名的 key 才需要私钥
+	Private crypto.Signer
+	Public  crypto.PublicKey
+	// RetireAt 轮换下来的旧 key 在这个时间之前依旧可以用来校验，
+	// 零值表示一直有效
+	RetireAt time.Time
+}
+
+// KeySet 当前签名用的 key 加上还在轮换窗口里面的旧 key
+type KeySet struct {
+	current SigningKey
+	keys    map[string]SigningKey
+}
+
+// NewKeySet current 用来签名，olds 只用来校验
+func NewKeySet(current SigningKey, olds ...SigningKey) (*KeySet, error) {
+	if current.Private == nil {
+		return nil, fmt.Errorf("签名的 key %s 缺少私钥", current.Kid)
+	}
+	ks := NewVerifyKeySet(olds...)
+	ks.current = current
+	ks.keys[current.Kid] = current
+	return ks, nil
+}
+
+// NewVerifyKeySet 只能校验不能签名的 KeySet，给只需要校验 token 的服务使用
+func NewVerifyKeySet(keys ...SigningKey) *KeySet {
+	ks := &KeySet{keys: make(map[string]SigningKey, len(keys)+1)}
+	for _, k := range keys {
+		ks.keys[k.Kid] = k
+	}
+	return ks
+}
+
+// Sign 使用当前的 key 签名，并且在 header 里面带上 kid
+func (ks *KeySet) Sign(claims jwt.Claims) (string, error) {
+	if ks.current.Private == nil {
+		return "", errors.New("这个 KeySet 只能用来校验")
+	}
+	token := jwt.NewWithClaims(ks.current.Method, claims)
+	token.Header["kid"] = ks.current.Kid
+	return token.SignedString(ks.current.Private)
+}
+
+// Keyfunc 根据 token 的 kid 找到对应的公钥
+func (ks *KeySet) Keyfunc(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+	key, ok := ks.keys[kid]
+	if !ok {
+		return nil, ErrUnknownKid
+	}
+	if !key.RetireAt.IsZero() && time.Now().After(key.RetireAt) {
+		return nil, ErrKeyRetired
+	}
+	// 防止有人用别的算法来伪造，例如拿公钥当 HMAC 的密钥
+	if token.Method.Alg() != key.Method.Alg() {
+		return nil, fmt.Errorf("kid %s 不支持算法 %s", kid, token.Method.Alg())
+	}
+	return key.Public, nil
+}
+
+// JWKS 公开所有还能用来校验的公钥
+func (ks *KeySet) JWKS() JWKS {
+	now := time.Now()
+	res := JWKS{Keys: make([]JWK, 0, len(ks.keys))}
+	for _, key := range ks.keys {
+		if !key.RetireAt.IsZero() && now.After(key.RetireAt) {
+			continue
+		}
+		jwk, err := toJWK(key)
+		if err != nil {
+			continue
+		}
+		res.Keys = append(res.Keys, jwk)
+	}
+	return res
+}
+
+// JWKS 即 JSON Web Key Set，参考 RFC 7517
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	// RSA
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// Ed25519
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// ParseJWKS 从 /.well-known/jwks.json 的内容构造一个只能校验的 KeySet
+func ParseJWKS(data []byte) (*KeySet, error) {
+	var jwks JWKS
+	err := json.Unmarshal(data, &jwks)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]SigningKey, 0, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		key, err := fromJWK(jwk)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return NewVerifyKeySet(keys...), nil
+}
+
+// RemoteKeySet 从 webook 的 JWKS 地址拉取公钥，给别的服务校验短 token 使用。
+// 第一次用到的时候才拉取，遇到不认识的 kid 说明 webook 轮换了 key，会重新拉取
+type RemoteKeySet struct {
+	url    string
+	client *http.Client
+	// minInterval 两次拉取之间至少间隔这么久，避免被伪造的 kid 打爆
+	minInterval time.Duration
+
+	mu        sync.Mutex
+	keys      *KeySet
+	fetchedAt time.Time
+}
+
+func NewRemoteKeySet(url string) *RemoteKeySet {
+	return &RemoteKeySet{
+		url:         url,
+		client:      http.DefaultClient,
+		minInterval: time.Minute,
+	}
+}
+
+func (r *RemoteKeySet) Keyfunc(token *jwt.Token) (any, error) {
+	ks, err := r.get(false)
+	if err != nil {
+		return nil, err
+	}
+	key, err := ks.Keyfunc(token)
+	if err != ErrUnknownKid {
+		return key, err
+	}
+	ks, err = r.get(true)
+	if err != nil {
+		return nil, err
+	}
+	return ks.Keyfunc(token)
+}
+
+func (r *RemoteKeySet) get(refresh bool) (*KeySet, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.keys != nil && (!refresh || time.Since(r.fetchedAt) < r.minInterval) {
+		return r.keys, nil
+	}
+	resp, err := r.client.Get(r.url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("拉取 JWKS 失败，状态码 %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	ks, err := ParseJWKS(data)
+	if err != nil {
+		return nil, err
+	}
+	r.keys = ks
+	r.fetchedAt = time.Now()
+	return ks, nil
+}
+
+// ParsePrivateKeyPEM 支持 PKCS8 格式的 RSA 和 Ed25519 私钥，以及 PKCS1 格式的 RSA 私钥
+func ParsePrivateKeyPEM(data []byte) (crypto.Signer, jwt.SigningMethod, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, nil, errors.New("非法的 PEM 数据")
+	}
+	if block.Type == "RSA PRIVATE KEY" {
+		key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, nil, err
+		}
+		return key, jwt.SigningMethodRS256, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return k, jwt.SigningMethodRS256, nil
+	case ed25519.PrivateKey:
+		return k, jwt.SigningMethodEdDSA, nil
+	default:
+		return nil, nil, ErrUnsupportedKey
+	}
+}
+
+// ParsePublicKeyPEM 解析 PKIX 格式的公钥
+func ParsePublicKeyPEM(data []byte) (crypto.PublicKey, jwt.SigningMethod, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, nil, errors.New("非法的 PEM 数据")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		return k, jwt.SigningMethodRS256, nil
+	case ed25519.PublicKey:
+		return k, jwt.SigningMethodEdDSA, nil
+	default:
+		return nil, nil, ErrUnsupportedKey
+	}
+}
+
+func toJWK(key SigningKey) (JWK, error) {
+	jwk := JWK{Kid: key.Kid, Alg: key.Method.Alg(), Use: "sig"}
+	switch pub := key.Public.(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+	default:
+		return JWK{}, ErrUnsupportedKey
+	}
+	return jwk, nil
+}
+
+func fromJWK(jwk JWK) (SigningKey, error) {
+	switch jwk.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		if err != nil {
+			return SigningKey{}, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+		if err != nil {
+			return SigningKey{}, err
+		}
+		return SigningKey{
+			Kid:    jwk.Kid,
+			Method: jwt.SigningMethodRS256,
+			Public: &rsa.PublicKey{
+				N: new(big.Int).SetBytes(n),
+				E: int(new(big.Int).SetBytes(e).Int64()),
+			},
+		}, nil
+	case "OKP":
+		if jwk.Crv != "Ed25519" {
+			return SigningKey{}, ErrUnsupportedKey
+		}
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if err != nil {
+			return SigningKey{}, err
+		}
+		return SigningKey{
+			Kid:    jwk.Kid,
+			Method: jwt.SigningMethodEdDSA,
+			Public: ed25519.PublicKey(x),
+		}, nil
+	default:
+		return SigningKey{}, ErrUnsupportedKey
+	}
+}
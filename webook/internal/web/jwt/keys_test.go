@@ -0,0 +1,156 @@
+package jwt
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeySet_Rotation(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	edPub, edPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	oldKey := SigningKey{
+		Kid:     "old",
+		Method:  jwt.SigningMethodRS256,
+		Private: rsaKey,
+		Public:  &rsaKey.PublicKey,
+	}
+	newKey := SigningKey{
+		Kid:     "new",
+		Method:  jwt.SigningMethodEdDSA,
+		Private: edPriv,
+		Public:  edPub,
+	}
+
+	before, err := NewKeySet(oldKey)
+	require.NoError(t, err)
+	oldToken, err := before.Sign(UserClaims{Uid: 123})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name    string
+		retire  time.Time
+		token   func(t *testing.T, ks *KeySet) string
+		wantErr bool
+	}{
+		{
+			name: "新 key 签发",
+			token: func(t *testing.T, ks *KeySet) string {
+				res, err := ks.Sign(UserClaims{Uid: 123})
+				require.NoError(t, err)
+				return res
+			},
+		},
+		{
+			name:   "旧 key 在轮换窗口内",
+			retire: time.Now().Add(time.Hour),
+			token: func(t *testing.T, ks *KeySet) string {
+				return oldToken
+			},
+		},
+		{
+			name:   "旧 key 过了轮换窗口",
+			retire: time.Now().Add(-time.Hour),
+			token: func(t *testing.T, ks *KeySet) string {
+				return oldToken
+			},
+			wantErr: true,
+		},
+		{
+			name: "拿公钥当 HMAC 密钥伪造",
+			token: func(t *testing.T, ks *KeySet) string {
+				token := jwt.NewWithClaims(jwt.SigningMethodHS256, UserClaims{Uid: 123})
+				token.Header["kid"] = "new"
+				res, err := token.SignedString([]byte(edPub))
+				require.NoError(t, err)
+				return res
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			old := oldKey
+			old.Private = nil
+			old.RetireAt = tc.retire
+			ks, err := NewKeySet(newKey, old)
+			require.NoError(t, err)
+
+			// 只拿着 JWKS 的服务也要得到同样的结果
+			data, err := json.Marshal(ks.JWKS())
+			require.NoError(t, err)
+			remote, err := ParseJWKS(data)
+			require.NoError(t, err)
+
+			tokenStr := tc.token(t, ks)
+			for _, verifier := range []*KeySet{ks, remote} {
+				var uc UserClaims
+				_, err = jwt.ParseWithClaims(tokenStr, &uc, verifier.Keyfunc)
+				if tc.wantErr {
+					assert.Error(t, err)
+					continue
+				}
+				require.NoError(t, err)
+				assert.Equal(t, int64(123), uc.Uid)
+			}
+		})
+	}
+}
+
+func TestRemoteKeySet_Keyfunc(t *testing.T) {
+	newKeySet := func(kid string) *KeySet {
+		pub, priv, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		ks, err := NewKeySet(SigningKey{
+			Kid:     kid,
+			Method:  jwt.SigningMethodEdDSA,
+			Private: priv,
+			Public:  pub,
+		})
+		require.NoError(t, err)
+		return ks
+	}
+	current := newKeySet("v1")
+	cnt := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cnt++
+		_ = json.NewEncoder(w).Encode(current.JWKS())
+	}))
+	defer server.Close()
+
+	remote := NewRemoteKeySet(server.URL)
+	remote.minInterval = 0
+	verify := func(ks *KeySet) error {
+		tokenStr, err := ks.Sign(UserClaims{Uid: 123, Roles: []string{"admin"}})
+		require.NoError(t, err)
+		var uc UserClaims
+		_, err = jwt.ParseWithClaims(tokenStr, &uc, remote.Keyfunc)
+		if err == nil {
+			assert.Equal(t, []string{"admin"}, uc.Roles)
+		}
+		return err
+	}
+
+	assert.NoError(t, verify(current))
+	assert.NoError(t, verify(current))
+	assert.Equal(t, 1, cnt)
+
+	// webook 轮换了 key，遇到新的 kid 会重新拉取
+	current = newKeySet("v2")
+	assert.NoError(t, verify(current))
+	assert.Equal(t, 2, cnt)
+
+	// 不是 webook 签发的 token，拉取之后依旧不认识
+	assert.Error(t, verify(newKeySet("fake")))
+}
@@ -0,0 +1,38 @@
+-- 一个 token family 当前唯一有效的 refresh token id
+local key = KEYS[1]
+-- 刚刚被换掉的上一个 refresh token 的 id，只保留很短的时间
+local prevKey = KEYS[2]
+-- 前端带上来的 refresh token 的 id
+local presented = ARGV[1]
+-- 轮换之后新的 refresh token 的 id
+local next = ARGV[2]
+-- 过期时间，单位秒
+local ttl = tonumber(ARGV[3])
+-- 宽限期，单位秒
+local grace = tonumber(ARGV[4])
+
+-- 返回值的第一个元素是结果，第二个元素是应该发给前端的 refresh token 的 id
+local current = redis.call("get", key)
+if current == false then
+    if presented == "" then
+        -- 升级之前签发的老 token，没有 id，直接开启一个新的 family
+        redis.call("set", key, next, "EX", ttl)
+        return {0, next}
+    end
+    -- family 已经过期或者被吊销了
+    return {-2, ""}
+end
+
+if current ~= presented then
+    if presented ~= "" and redis.call("get", prevKey) == presented then
+        -- 宽限期内用上一个 token 来刷新，多半是两个标签页同时刷新，
+        -- 或者上一次刷新的响应丢了，把当前有效的 token 再发一次
+        return {0, current}
+    end
+    -- 已经被轮换掉的 token 又被拿出来用了
+    return {-1, ""}
+end
+
+redis.call("set", key, next, "EX", ttl)
+redis.call("set", prevKey, presented, "EX", grace)
+return {0, next}
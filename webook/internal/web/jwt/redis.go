@@ -1,10 +1,15 @@
 package jwt
 
 import (
+	_ "embed"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
+	"webook/pkg/logger"
+	"webook/pkg/netx"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
@@ -12,23 +17,81 @@ import (
 	"github.com/redis/go-redis/v9"
 )
 
+var (
+	//go:embed lua/rotate_refresh.lua
+	luaRotateRefresh string
+
+	ErrSessionNotFound     = errors.New("会话不存在")
+	ErrRefreshTokenReused  = errors.New("refresh token 被重复使用")
+	ErrRefreshTokenRevoked = errors.New("refresh token 已经失效")
+	ErrPreAuthTokenInvalid = errors.New("pre-auth token 不存在或者已经过期")
+)
+
 type RedisJWTHandler struct {
+	// keys 短 token 使用非对称加密，别的服务只需要公钥就可以校验
+	keys *KeySet
+	// signingMethod 和 rcKey 用于长 token
 	signingMethod jwt.SigningMethod
+	rcKey         RefreshKey
 	client        redis.Cmdable
+	locator       netx.Locator
+	l             logger.LoggerV1
 
 	rcExpiration time.Duration
+	// rcGracePeriod 换掉的 refresh token 在这段时间内还能再用一次，
+	// 拿到的是当前有效的 token，而不会被当成重复使用
+	rcGracePeriod time.Duration
+	// preAuthExpiration 留给用户输入两步验证码的时间
+	preAuthExpiration time.Duration
 }
 
-func NewRedisJWTHandler(client redis.Cmdable) Handler {
+func NewRedisJWTHandler(client redis.Cmdable, l logger.LoggerV1,
+	keys *KeySet, rcKey RefreshKey) Handler {
 	return &RedisJWTHandler{
+		keys:          keys,
 		signingMethod: jwt.SigningMethodHS256,
+		rcKey:         rcKey,
 		client:        client,
+		locator:       netx.NewLocalLocator(),
+		l:             l,
 		rcExpiration:  time.Hour * 24 * 7,
+		rcGracePeriod: time.Second * 30,
+		// 五分钟之内要输入两步验证码
+		preAuthExpiration: time.Minute * 5,
+	}
+}
+
+// ParseAccessToken 只接受当前 KeySet 里面的 kid 签发的短 token
+func (rh *RedisJWTHandler) ParseAccessToken(tokenStr string) (UserClaims, error) {
+	var uc UserClaims
+	token, err := jwt.ParseWithClaims(tokenStr, &uc, rh.keys.Keyfunc)
+	if err != nil {
+		return UserClaims{}, err
+	}
+	if token == nil || !token.Valid {
+		return UserClaims{}, errors.New("无效 Token")
+	}
+	return uc, nil
+}
+
+func (rh *RedisJWTHandler) ParseRefreshToken(tokenStr string) (RefreshClaims, error) {
+	var rc RefreshClaims
+	token, err := jwt.ParseWithClaims(tokenStr, &rc, func(token *jwt.Token) (interface{}, error) {
+		return []byte(rh.rcKey), nil
+	}, jwt.WithValidMethods([]string{rh.signingMethod.Alg()}))
+	if err != nil {
+		return RefreshClaims{}, err
+	}
+	if token == nil || !token.Valid {
+		return RefreshClaims{}, errors.New("无效 Token")
 	}
+	return rc, nil
 }
 
+// CheckSession 无论是自己退出登录，还是在别的设备上被踢掉，
+// 都会在 users:ssid:<ssid> 上留下标记
 func (rh *RedisJWTHandler) CheckSession(ctx *gin.Context, ssid string) error {
-	cnt, err := rh.client.Exists(ctx, fmt.Sprintf("users:ssid:%s", ssid)).Result()
+	cnt, err := rh.client.Exists(ctx, rh.ssidKey(ssid)).Result()
 	if err != nil {
 		return err
 	}
@@ -52,27 +115,36 @@ func (rh *RedisJWTHandler) ExtractToken(ctx *gin.Context) string {
 	return segs[1]
 }
 
-func (rh *RedisJWTHandler) SetLoginToken(ctx *gin.Context, uid int64) error {
+func (rh *RedisJWTHandler) SetLoginToken(ctx *gin.Context, uid int64, roles []string) error {
 	ssid := uuid.New().String()
-	err := rh.setRefreshToken(ctx, uid, ssid)
+	// 一次登录就是一个新的 token family，family 用 ssid 来标识
+	tokenId := uuid.New().String()
+	err := rh.client.Set(ctx, rh.familyKey(ssid), tokenId, rh.rcExpiration).Err()
 	if err != nil {
 		return err
 	}
-	err = rh.SetJWTToken(ctx, uid, ssid)
-	return err
+	err = rh.setRefreshToken(ctx, uid, ssid, tokenId)
+	if err != nil {
+		return err
+	}
+	err = rh.SetJWTToken(ctx, uid, ssid, roles)
+	if err != nil {
+		return err
+	}
+	return rh.addSession(ctx, uid, ssid)
 }
 
-func (rh *RedisJWTHandler) SetJWTToken(ctx *gin.Context, uid int64, ssid string) error {
+func (rh *RedisJWTHandler) SetJWTToken(ctx *gin.Context, uid int64, ssid string, roles []string) error {
 	uc := UserClaims{
 		Uid:       uid,
 		Ssid:      ssid,
 		UserAgent: ctx.GetHeader("User-Agent"),
+		Roles:     roles,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute * 30)),
 		},
 	}
-	token := jwt.NewWithClaims(rh.signingMethod, uc)
-	tokenStr, err := token.SignedString(JWTKey)
+	tokenStr, err := rh.keys.Sign(uc)
 	if err != nil {
 		return err
 	}
@@ -80,18 +152,65 @@ func (rh *RedisJWTHandler) SetJWTToken(ctx *gin.Context, uid int64, ssid string)
 	return nil
 }
 
+// RotateRefreshToken 每次刷新都换发一个新的 refresh token，旧的随即作废。
+// 刚换掉的 token 在 rcGracePeriod 之内再出现，返回当前有效的 token；
+// 超过宽限期，或者更早的 token 又出现了，说明 token 很可能被偷了，
+// 这个时候分不清谁是真正的用户，只能把整个 family 连同 ssid 一起吊销
+func (rh *RedisJWTHandler) RotateRefreshToken(ctx *gin.Context, rc RefreshClaims) error {
+	next := uuid.New().String()
+	res, err := rh.client.Eval(ctx, luaRotateRefresh,
+		[]string{rh.familyKey(rc.Ssid), rh.prevFamilyKey(rc.Ssid)},
+		rc.ID, next, int64(rh.rcExpiration.Seconds()),
+		int64(rh.rcGracePeriod.Seconds())).Slice()
+	if err != nil {
+		return err
+	}
+	if len(res) != 2 {
+		return fmt.Errorf("轮换 refresh token 返回值不对 %v", res)
+	}
+	code, _ := res[0].(int64)
+	tokenId, _ := res[1].(string)
+	switch code {
+	case -1:
+		rh.l.Error("安全事件：检测到 refresh token 重复使用，吊销整个会话",
+			logger.Int64("uid", rc.Uid),
+			logger.String("ssid", rc.Ssid),
+			logger.String("jti", rc.ID),
+			logger.String("ip", ctx.ClientIP()),
+			logger.String("userAgent", ctx.GetHeader("User-Agent")))
+		err = rh.revoke(ctx, rc.Uid, rc.Ssid)
+		if err != nil {
+			return err
+		}
+		return ErrRefreshTokenReused
+	case -2:
+		return ErrRefreshTokenRevoked
+	}
+	err = rh.setRefreshToken(ctx, rc.Uid, rc.Ssid, tokenId)
+	if err != nil {
+		return err
+	}
+	// 刷新了长 token，会话记录也要跟着续期
+	pipe := rh.client.TxPipeline()
+	pipe.Expire(ctx, rh.sessionKey(rc.Uid), rh.rcExpiration)
+	pipe.Expire(ctx, rh.activeKey(rc.Uid), rh.rcExpiration)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
 func (rh *RedisJWTHandler) setRefreshToken(ctx *gin.Context,
-	uid int64, ssid string) error {
+	uid int64, ssid string, tokenId string) error {
 	rc := RefreshClaims{
 		Uid:  uid,
 		Ssid: ssid,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID: tokenId,
 			// 设置为七天过期
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(rh.rcExpiration)),
 		},
 	}
 	refreshToken := jwt.NewWithClaims(rh.signingMethod, rc)
-	refreshTokenStr, err := refreshToken.SignedString(RCJWTKey)
+	refreshTokenStr, err := refreshToken.SignedString([]byte(rh.rcKey))
 	if err != nil {
 		return err
 	}
@@ -103,13 +222,184 @@ func (rh *RedisJWTHandler) ClearToken(ctx *gin.Context) error {
 	ctx.Header("x-jwt-token", "")
 	ctx.Header("x-refresh-token", "")
 	uc := ctx.MustGet("user").(UserClaims)
-	return rh.client.Set(ctx, fmt.Sprintf("users:ssid:%s", uc.Ssid),
-		"", rh.rcExpiration).Err()
+	return rh.revoke(ctx, uc.Uid, uc.Ssid)
+}
+
+func (rh *RedisJWTHandler) TouchSession(ctx *gin.Context, uid int64, ssid string) error {
+	// XX 保证只更新已经登记过的会话，被踢掉的会话不会因此复活
+	return rh.client.ZAddXX(ctx, rh.activeKey(uid), redis.Z{
+		Score:  float64(time.Now().UnixMilli()),
+		Member: ssid,
+	}).Err()
+}
+
+func (rh *RedisJWTHandler) ListSessions(ctx *gin.Context, uid int64) ([]Session, error) {
+	vals, err := rh.client.HGetAll(ctx, rh.sessionKey(uid)).Result()
+	if err != nil {
+		return nil, err
+	}
+	actives, err := rh.client.ZRangeWithScores(ctx, rh.activeKey(uid), 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+	lastActive := make(map[string]int64, len(actives))
+	for _, z := range actives {
+		lastActive[z.Member.(string)] = int64(z.Score)
+	}
+
+	now := time.Now()
+	res := make([]Session, 0, len(vals))
+	var expired []string
+	var expiredMembers []any
+	for ssid, val := range vals {
+		var sess Session
+		err = json.Unmarshal([]byte(val), &sess)
+		if err != nil {
+			return nil, err
+		}
+		if ms, ok := lastActive[ssid]; ok {
+			sess.LastActive = time.UnixMilli(ms)
+		}
+		// 长 token 最晚也是在最近一次活跃的时候签发的，
+		// 超过 rcExpiration 不活跃，说明长 token 已经过期，顺手清理掉
+		if sess.LastActive.Add(rh.rcExpiration).Before(now) {
+			expired = append(expired, ssid)
+			expiredMembers = append(expiredMembers, ssid)
+			continue
+		}
+		res = append(res, sess)
+	}
+	if len(expired) > 0 {
+		pipe := rh.client.TxPipeline()
+		pipe.HDel(ctx, rh.sessionKey(uid), expired...)
+		pipe.ZRem(ctx, rh.activeKey(uid), expiredMembers...)
+		_, _ = pipe.Exec(ctx)
+	}
+	// 最近活跃的排在前面
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].LastActive.After(res[j].LastActive)
+	})
+	return res, nil
 }
 
-var JWTKey = []byte("WiXLWadWG44Rr2qP6VUDLod0dzAnRI45")
-var RCJWTKey = []byte("WiXLWadWG44Rr2qP6VUDLod0dzAnRI34")
+func (rh *RedisJWTHandler) RevokeSession(ctx *gin.Context, uid int64, ssid string) error {
+	ok, err := rh.client.HExists(ctx, rh.sessionKey(uid), ssid).Result()
+	if err != nil {
+		return err
+	}
+	if !ok {
+		// 不是自己的会话，或者早就失效了
+		return ErrSessionNotFound
+	}
+	return rh.revoke(ctx, uid, ssid)
+}
+
+func (rh *RedisJWTHandler) RevokeOtherSessions(ctx *gin.Context, uid int64, ssid string) error {
+	ssids, err := rh.client.HKeys(ctx, rh.sessionKey(uid)).Result()
+	if err != nil {
+		return err
+	}
+	others := make([]string, 0, len(ssids))
+	for _, s := range ssids {
+		if s != ssid {
+			others = append(others, s)
+		}
+	}
+	return rh.revoke(ctx, uid, others...)
+}
+
+func (rh *RedisJWTHandler) addSession(ctx *gin.Context, uid int64, ssid string) error {
+	now := time.Now()
+	ip := ctx.ClientIP()
+	val, err := json.Marshal(Session{
+		Ssid:       ssid,
+		UserAgent:  ctx.GetHeader("User-Agent"),
+		IP:         ip,
+		Location:   rh.locator.Locate(ctx, ip),
+		Ctime:      now,
+		LastActive: now,
+	})
+	if err != nil {
+		return err
+	}
+	pipe := rh.client.TxPipeline()
+	pipe.HSet(ctx, rh.sessionKey(uid), ssid, val)
+	pipe.ZAdd(ctx, rh.activeKey(uid), redis.Z{
+		Score:  float64(now.UnixMilli()),
+		Member: ssid,
+	})
+	// 每次有新登录就把过期时间往后推，保证不短于最新的长 token
+	pipe.Expire(ctx, rh.sessionKey(uid), rh.rcExpiration)
+	pipe.Expire(ctx, rh.activeKey(uid), rh.rcExpiration)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// revoke 标记 ssid 失效，并且从用户的会话列表里面移除
+func (rh *RedisJWTHandler) revoke(ctx *gin.Context, uid int64, ssids ...string) error {
+	if len(ssids) == 0 {
+		return nil
+	}
+	pipe := rh.client.TxPipeline()
+	members := make([]any, 0, len(ssids))
+	for _, ssid := range ssids {
+		pipe.Set(ctx, rh.ssidKey(ssid), "", rh.rcExpiration)
+		pipe.Del(ctx, rh.familyKey(ssid), rh.prevFamilyKey(ssid))
+		members = append(members, ssid)
+	}
+	pipe.HDel(ctx, rh.sessionKey(uid), ssids...)
+	pipe.ZRem(ctx, rh.activeKey(uid), members...)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (rh *RedisJWTHandler) SetPreAuthToken(ctx *gin.Context, uid int64) (string, error) {
+	token := uuid.New().String()
+	err := rh.client.Set(ctx, rh.preAuthKey(token), uid, rh.preAuthExpiration).Err()
+	return token, err
+}
+
+func (rh *RedisJWTHandler) CheckPreAuthToken(ctx *gin.Context, token string) (int64, error) {
+	if token == "" {
+		return 0, ErrPreAuthTokenInvalid
+	}
+	uid, err := rh.client.Get(ctx, rh.preAuthKey(token)).Int64()
+	if err == redis.Nil {
+		return 0, ErrPreAuthTokenInvalid
+	}
+	return uid, err
+}
+
+func (rh *RedisJWTHandler) ClearPreAuthToken(ctx *gin.Context, token string) error {
+	return rh.client.Del(ctx, rh.preAuthKey(token)).Err()
+}
+
+func (rh *RedisJWTHandler) preAuthKey(token string) string {
+	return fmt.Sprintf("users:pre_auth:%s", token)
+}
+
+func (rh *RedisJWTHandler) ssidKey(ssid string) string {
+	return fmt.Sprintf("users:ssid:%s", ssid)
+}
+
+func (rh *RedisJWTHandler) familyKey(ssid string) string {
+	return fmt.Sprintf("users:refresh:family:%s", ssid)
+}
+
+func (rh *RedisJWTHandler) prevFamilyKey(ssid string) string {
+	return fmt.Sprintf("users:refresh:family:%s:prev", ssid)
+}
+
+func (rh *RedisJWTHandler) sessionKey(uid int64) string {
+	return fmt.Sprintf("users:sessions:%d", uid)
+}
+
+func (rh *RedisJWTHandler) activeKey(uid int64) string {
+	return fmt.Sprintf("users:sessions:active:%d", uid)
+}
 
+// RefreshClaims 同一次登录换发出来的 refresh token 属于同一个 family，
+// family 就是 Ssid，RegisteredClaims.ID 是 token 自身的 id
 type RefreshClaims struct {
 	jwt.RegisteredClaims
 	Uid  int64
@@ -121,4 +411,6 @@ type UserClaims struct {
 	Uid       int64
 	Ssid      string
 	UserAgent string
+	// Roles 签发的时候用户拥有的角色，刷新短 token 的时候会重新查询
+	Roles []string
 }
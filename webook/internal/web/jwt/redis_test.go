@@ -0,0 +1,403 @@
+package jwt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"webook/internal/repository/cache/redismocks"
+	"webook/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRedisJWTHandler_RotateRefreshToken(t *testing.T) {
+	keys := []string{"users:refresh:family:ssid-1", "users:refresh:family:ssid-1:prev"}
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) redis.Cmdable
+
+		// wantJti 为空说明不应该发新的 refresh token
+		wantJti func(jti string) bool
+		wantErr error
+	}{
+		{
+			name: "轮换成功，会话记录跟着续期",
+			mock: func(ctrl *gomock.Controller) redis.Cmdable {
+				client := redismocks.NewMockCmdable(ctrl)
+				client.EXPECT().Eval(gomock.Any(), luaRotateRefresh, keys,
+					"jti-1", gomock.Any(), int64(604800), int64(30)).
+					DoAndReturn(func(_ context.Context, _ string, _ []string, args ...any) *redis.Cmd {
+						return redis.NewCmdResult([]any{int64(0), args[1]}, nil)
+					})
+				pipe := redismocks.NewMockPipeliner(ctrl)
+				client.EXPECT().TxPipeline().Return(pipe)
+				pipe.EXPECT().Expire(gomock.Any(), "users:sessions:123", time.Hour*24*7).
+					Return(redis.NewBoolResult(true, nil))
+				pipe.EXPECT().Expire(gomock.Any(), "users:sessions:active:123", time.Hour*24*7).
+					Return(redis.NewBoolResult(true, nil))
+				pipe.EXPECT().Exec(gomock.Any()).Return(nil, nil)
+				return client
+			},
+			wantJti: func(jti string) bool {
+				return jti != "" && jti != "jti-1"
+			},
+		},
+		{
+			name: "宽限期内用上一个 token 刷新，拿到当前有效的 token",
+			mock: func(ctrl *gomock.Controller) redis.Cmdable {
+				client := redismocks.NewMockCmdable(ctrl)
+				client.EXPECT().Eval(gomock.Any(), luaRotateRefresh, keys,
+					"jti-1", gomock.Any(), int64(604800), int64(30)).
+					Return(redis.NewCmdResult([]any{int64(0), "jti-2"}, nil))
+				pipe := redismocks.NewMockPipeliner(ctrl)
+				client.EXPECT().TxPipeline().Return(pipe)
+				pipe.EXPECT().Expire(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(2).Return(redis.NewBoolResult(true, nil))
+				pipe.EXPECT().Exec(gomock.Any()).Return(nil, nil)
+				return client
+			},
+			wantJti: func(jti string) bool {
+				return jti == "jti-2"
+			},
+		},
+		{
+			name: "重复使用，吊销整个会话",
+			mock: func(ctrl *gomock.Controller) redis.Cmdable {
+				client := redismocks.NewMockCmdable(ctrl)
+				client.EXPECT().Eval(gomock.Any(), luaRotateRefresh, keys,
+					"jti-1", gomock.Any(), int64(604800), int64(30)).
+					Return(redis.NewCmdResult([]any{int64(-1), ""}, nil))
+				expectRevoke(ctrl, client, "ssid-1")
+				return client
+			},
+			wantErr: ErrRefreshTokenReused,
+		},
+		{
+			name: "family 已经过期或者被吊销",
+			mock: func(ctrl *gomock.Controller) redis.Cmdable {
+				client := redismocks.NewMockCmdable(ctrl)
+				client.EXPECT().Eval(gomock.Any(), luaRotateRefresh, keys,
+					"jti-1", gomock.Any(), int64(604800), int64(30)).
+					Return(redis.NewCmdResult([]any{int64(-2), ""}, nil))
+				return client
+			},
+			wantErr: ErrRefreshTokenRevoked,
+		},
+		{
+			name: "redis 返回 error",
+			mock: func(ctrl *gomock.Controller) redis.Cmdable {
+				client := redismocks.NewMockCmdable(ctrl)
+				client.EXPECT().Eval(gomock.Any(), luaRotateRefresh, keys,
+					"jti-1", gomock.Any(), int64(604800), int64(30)).
+					Return(redis.NewCmdResult(nil, errors.New("redis错误")))
+				return client
+			},
+			wantErr: errors.New("redis错误"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			rh := newTestHandler(tc.mock(ctrl))
+			ctx := newTestContext()
+			rc := RefreshClaims{Uid: 123, Ssid: "ssid-1"}
+			rc.ID = "jti-1"
+			err := rh.RotateRefreshToken(ctx, rc)
+			assert.Equal(t, tc.wantErr, err)
+
+			tokenStr := ctx.Writer.Header().Get("x-refresh-token")
+			if tc.wantJti == nil {
+				assert.Empty(t, tokenStr)
+				return
+			}
+			next, err := rh.ParseRefreshToken(tokenStr)
+			require.NoError(t, err)
+			assert.True(t, tc.wantJti(next.ID))
+			assert.Equal(t, "ssid-1", next.Ssid)
+		})
+	}
+}
+
+func TestRedisJWTHandler_TouchSession(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) redis.Cmdable
+
+		wantErr error
+	}{
+		{
+			name: "只更新已经登记过的会话",
+			mock: func(ctrl *gomock.Controller) redis.Cmdable {
+				client := redismocks.NewMockCmdable(ctrl)
+				client.EXPECT().ZAddXX(gomock.Any(), "users:sessions:active:123", gomock.Any()).
+					Return(redis.NewIntResult(0, nil))
+				return client
+			},
+		},
+		{
+			name: "redis 返回 error",
+			mock: func(ctrl *gomock.Controller) redis.Cmdable {
+				client := redismocks.NewMockCmdable(ctrl)
+				client.EXPECT().ZAddXX(gomock.Any(), "users:sessions:active:123", gomock.Any()).
+					Return(redis.NewIntResult(0, errors.New("redis错误")))
+				return client
+			},
+			wantErr: errors.New("redis错误"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			rh := newTestHandler(tc.mock(ctrl))
+			err := rh.TouchSession(newTestContext(), 123, "ssid-1")
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+func TestRedisJWTHandler_ListSessions(t *testing.T) {
+	now := time.Now()
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) redis.Cmdable
+
+		wantSsids []string
+		wantErr   error
+	}{
+		{
+			name: "最近活跃的在前面，过期的顺手清理掉",
+			mock: func(ctrl *gomock.Controller) redis.Cmdable {
+				client := redismocks.NewMockCmdable(ctrl)
+				client.EXPECT().HGetAll(gomock.Any(), "users:sessions:123").
+					Return(redis.NewMapStringStringResult(map[string]string{
+						// 登录的时候记的时间很早，但是最近活跃过
+						"ssid-1": sessionVal(t, "ssid-1", now.Add(-time.Hour*24*10)),
+						"ssid-2": sessionVal(t, "ssid-2", now.Add(-time.Hour)),
+						"ssid-3": sessionVal(t, "ssid-3", now.Add(-time.Hour*24*8)),
+					}, nil))
+				client.EXPECT().ZRangeWithScores(gomock.Any(), "users:sessions:active:123", int64(0), int64(-1)).
+					Return(redis.NewZSliceCmdResult([]redis.Z{
+						{Member: "ssid-1", Score: float64(now.Add(-time.Minute).UnixMilli())},
+						{Member: "ssid-2", Score: float64(now.Add(-time.Hour).UnixMilli())},
+						{Member: "ssid-3", Score: float64(now.Add(-time.Hour * 24 * 8).UnixMilli())},
+					}, nil))
+				pipe := redismocks.NewMockPipeliner(ctrl)
+				client.EXPECT().TxPipeline().Return(pipe)
+				pipe.EXPECT().HDel(gomock.Any(), "users:sessions:123", "ssid-3").
+					Return(redis.NewIntResult(1, nil))
+				pipe.EXPECT().ZRem(gomock.Any(), "users:sessions:active:123", "ssid-3").
+					Return(redis.NewIntResult(1, nil))
+				pipe.EXPECT().Exec(gomock.Any()).Return(nil, nil)
+				return client
+			},
+			wantSsids: []string{"ssid-1", "ssid-2"},
+		},
+		{
+			name: "没有登录的会话",
+			mock: func(ctrl *gomock.Controller) redis.Cmdable {
+				client := redismocks.NewMockCmdable(ctrl)
+				client.EXPECT().HGetAll(gomock.Any(), "users:sessions:123").
+					Return(redis.NewMapStringStringResult(map[string]string{}, nil))
+				client.EXPECT().ZRangeWithScores(gomock.Any(), "users:sessions:active:123", int64(0), int64(-1)).
+					Return(redis.NewZSliceCmdResult(nil, nil))
+				return client
+			},
+			wantSsids: []string{},
+		},
+		{
+			name: "redis 返回 error",
+			mock: func(ctrl *gomock.Controller) redis.Cmdable {
+				client := redismocks.NewMockCmdable(ctrl)
+				client.EXPECT().HGetAll(gomock.Any(), "users:sessions:123").
+					Return(redis.NewMapStringStringResult(nil, errors.New("redis错误")))
+				return client
+			},
+			wantErr: errors.New("redis错误"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			rh := newTestHandler(tc.mock(ctrl))
+			sessions, err := rh.ListSessions(newTestContext(), 123)
+			assert.Equal(t, tc.wantErr, err)
+			if err != nil {
+				return
+			}
+			ssids := make([]string, 0, len(sessions))
+			for _, sess := range sessions {
+				ssids = append(ssids, sess.Ssid)
+			}
+			assert.Equal(t, tc.wantSsids, ssids)
+		})
+	}
+}
+
+func TestRedisJWTHandler_RevokeSession(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) redis.Cmdable
+
+		wantErr error
+	}{
+		{
+			name: "踢掉自己的会话",
+			mock: func(ctrl *gomock.Controller) redis.Cmdable {
+				client := redismocks.NewMockCmdable(ctrl)
+				client.EXPECT().HExists(gomock.Any(), "users:sessions:123", "ssid-1").
+					Return(redis.NewBoolResult(true, nil))
+				expectRevoke(ctrl, client, "ssid-1")
+				return client
+			},
+		},
+		{
+			name: "不是自己的会话",
+			mock: func(ctrl *gomock.Controller) redis.Cmdable {
+				client := redismocks.NewMockCmdable(ctrl)
+				client.EXPECT().HExists(gomock.Any(), "users:sessions:123", "ssid-1").
+					Return(redis.NewBoolResult(false, nil))
+				return client
+			},
+			wantErr: ErrSessionNotFound,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			rh := newTestHandler(tc.mock(ctrl))
+			err := rh.RevokeSession(newTestContext(), 123, "ssid-1")
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+func TestRedisJWTHandler_RevokeOtherSessions(t *testing.T) {
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) redis.Cmdable
+
+		wantErr error
+	}{
+		{
+			name: "踢掉别的设备，保留当前的",
+			mock: func(ctrl *gomock.Controller) redis.Cmdable {
+				client := redismocks.NewMockCmdable(ctrl)
+				client.EXPECT().HKeys(gomock.Any(), "users:sessions:123").
+					Return(redis.NewStringSliceResult([]string{"ssid-1", "ssid-2", "ssid-3"}, nil))
+				expectRevoke(ctrl, client, "ssid-1", "ssid-3")
+				return client
+			},
+		},
+		{
+			name: "只有当前设备，不用访问 redis",
+			mock: func(ctrl *gomock.Controller) redis.Cmdable {
+				client := redismocks.NewMockCmdable(ctrl)
+				client.EXPECT().HKeys(gomock.Any(), "users:sessions:123").
+					Return(redis.NewStringSliceResult([]string{"ssid-2"}, nil))
+				return client
+			},
+		},
+		{
+			name: "redis 返回 error",
+			mock: func(ctrl *gomock.Controller) redis.Cmdable {
+				client := redismocks.NewMockCmdable(ctrl)
+				client.EXPECT().HKeys(gomock.Any(), "users:sessions:123").
+					Return(redis.NewStringSliceResult(nil, errors.New("redis错误")))
+				return client
+			},
+			wantErr: errors.New("redis错误"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			rh := newTestHandler(tc.mock(ctrl))
+			err := rh.RevokeOtherSessions(newTestContext(), 123, "ssid-2")
+			assert.Equal(t, tc.wantErr, err)
+		})
+	}
+}
+
+func TestRedisJWTHandler_addSession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	client := redismocks.NewMockCmdable(ctrl)
+	pipe := redismocks.NewMockPipeliner(ctrl)
+	client.EXPECT().TxPipeline().Return(pipe)
+	pipe.EXPECT().HSet(gomock.Any(), "users:sessions:123", "ssid-1", gomock.Any()).
+		DoAndReturn(func(_ any, _ string, vals ...any) *redis.IntCmd {
+			var sess Session
+			require.NoError(t, json.Unmarshal(vals[1].([]byte), &sess))
+			assert.Equal(t, "ssid-1", sess.Ssid)
+			assert.Equal(t, "test-agent", sess.UserAgent)
+			return redis.NewIntResult(1, nil)
+		})
+	pipe.EXPECT().ZAdd(gomock.Any(), "users:sessions:active:123", gomock.Any()).
+		Return(redis.NewIntResult(1, nil))
+	// 两个登记用的 key 都要跟着长 token 一起过期
+	pipe.EXPECT().Expire(gomock.Any(), "users:sessions:123", time.Hour*24*7).
+		Return(redis.NewBoolResult(true, nil))
+	pipe.EXPECT().Expire(gomock.Any(), "users:sessions:active:123", time.Hour*24*7).
+		Return(redis.NewBoolResult(true, nil))
+	pipe.EXPECT().Exec(gomock.Any()).Return(nil, nil)
+
+	rh := newTestHandler(client)
+	err := rh.addSession(newTestContext(), 123, "ssid-1")
+	assert.NoError(t, err)
+}
+
+// expectRevoke ssid 打上失效标记，删掉 family，并且从会话列表里面移除
+func expectRevoke(ctrl *gomock.Controller, client *redismocks.MockCmdable, ssids ...string) {
+	pipe := redismocks.NewMockPipeliner(ctrl)
+	client.EXPECT().TxPipeline().Return(pipe)
+	members := make([]any, 0, len(ssids))
+	for _, ssid := range ssids {
+		pipe.EXPECT().Set(gomock.Any(), "users:ssid:"+ssid, "", time.Hour*24*7).
+			Return(redis.NewStatusResult("OK", nil))
+		pipe.EXPECT().Del(gomock.Any(), "users:refresh:family:"+ssid,
+			"users:refresh:family:"+ssid+":prev").
+			Return(redis.NewIntResult(1, nil))
+		members = append(members, ssid)
+	}
+	pipe.EXPECT().HDel(gomock.Any(), "users:sessions:123", ssids).
+		Return(redis.NewIntResult(int64(len(ssids)), nil))
+	pipe.EXPECT().ZRem(gomock.Any(), "users:sessions:active:123", members...).
+		Return(redis.NewIntResult(int64(len(ssids)), nil))
+	pipe.EXPECT().Exec(gomock.Any()).Return(nil, nil)
+}
+
+func sessionVal(t *testing.T, ssid string, lastActive time.Time) string {
+	val, err := json.Marshal(Session{
+		Ssid:       ssid,
+		Ctime:      lastActive,
+		LastActive: lastActive,
+	})
+	require.NoError(t, err)
+	return string(val)
+}
+
+func newTestHandler(client redis.Cmdable) *RedisJWTHandler {
+	return NewRedisJWTHandler(client, logger.NewNopLogger(), nil,
+		RefreshKey("refresh key")).(*RedisJWTHandler)
+}
+
+func newTestContext() *gin.Context {
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+	ctx.Request.Header.Set("User-Agent", "test-agent")
+	return ctx
+}
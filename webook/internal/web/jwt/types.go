@@ -1,11 +1,51 @@
 package jwt
 
-import "github.com/gin-gonic/gin"
+import (
+	"time"
 
+	"github.com/gin-gonic/gin"
+)
+
+//go:generate mockgen -source=./types.go -package=jwtmocks -destination=./mocks/handler.mock.go Handler
 type Handler interface {
 	ClearToken(ctx *gin.Context) error
 	ExtractToken(ctx *gin.Context) string
-	SetLoginToken(ctx *gin.Context, uid int64) error
-	SetJWTToken(ctx *gin.Context, uid int64, ssid string) error
+	// SetLoginToken roles 会放进短 token 里面，权限校验的时候不需要再查用户
+	SetLoginToken(ctx *gin.Context, uid int64, roles []string) error
+	SetJWTToken(ctx *gin.Context, uid int64, ssid string, roles []string) error
 	CheckSession(ctx *gin.Context, ssid string) error
+	// ParseAccessToken 校验并解析短 token
+	ParseAccessToken(tokenStr string) (UserClaims, error)
+	// ParseRefreshToken 校验并解析长 token
+	ParseRefreshToken(tokenStr string) (RefreshClaims, error)
+	// RotateRefreshToken 换发 refresh token，并检测旧 token 是否被重复使用
+	RotateRefreshToken(ctx *gin.Context, rc RefreshClaims) error
+
+	// TouchSession 刷新登录会话的最近活跃时间
+	TouchSession(ctx *gin.Context, uid int64, ssid string) error
+	// ListSessions 列出用户所有设备上的登录会话
+	ListSessions(ctx *gin.Context, uid int64) ([]Session, error)
+	// RevokeSession 踢掉用户某一个设备上的登录
+	RevokeSession(ctx *gin.Context, uid int64, ssid string) error
+	// RevokeOtherSessions 踢掉除了 ssid 之外的所有登录
+	RevokeOtherSessions(ctx *gin.Context, uid int64, ssid string) error
+
+	// SetPreAuthToken 开启了两步验证的用户，第一步通过之后拿到的是一个短期的 pre-auth token，
+	// 而不是真正的登录态
+	SetPreAuthToken(ctx *gin.Context, uid int64) (string, error)
+	// CheckPreAuthToken 校验 pre-auth token，返回对应的用户 ID
+	CheckPreAuthToken(ctx *gin.Context, token string) (int64, error)
+	// ClearPreAuthToken 两步验证通过之后作废 pre-auth token
+	ClearPreAuthToken(ctx *gin.Context, token string) error
+}
+
+// Session 一次登录对应的会话，也就是一台设备
+type Session struct {
+	Ssid      string
+	UserAgent string
+	IP        string
+	Location  string
+	Ctime     time.Time
+	// LastActive 最近一次活跃的时间
+	LastActive time.Time
 }
@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./types.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./types.go -package=guardmocks -destination=./mocks/guard.mock.go Guard
+//
+
+// Package guardmocks is a generated GoMock package.
+package guardmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	loginguard "webook/internal/web/loginguard"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGuard is a mock of Guard interface.
+type MockGuard struct {
+	ctrl     *gomock.Controller
+	recorder *MockGuardMockRecorder
+}
+
+// MockGuardMockRecorder is the mock recorder for MockGuard.
+type MockGuardMockRecorder struct {
+	mock *MockGuard
+}
+
+// NewMockGuard creates a new mock instance.
+func NewMockGuard(ctrl *gomock.Controller) *MockGuard {
+	mock := &MockGuard{ctrl: ctrl}
+	mock.recorder = &MockGuardMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGuard) EXPECT() *MockGuardMockRecorder {
+	return m.recorder
+}
+
+// Check mocks base method.
+func (m *MockGuard) Check(ctx context.Context, biz, account, ip string) (loginguard.State, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", ctx, biz, account, ip)
+	ret0, _ := ret[0].(loginguard.State)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockGuardMockRecorder) Check(ctx, biz, account, ip any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockGuard)(nil).Check), ctx, biz, account, ip)
+}
+
+// Fail mocks base method.
+func (m *MockGuard) Fail(ctx context.Context, biz, account, ip string) (loginguard.State, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fail", ctx, biz, account, ip)
+	ret0, _ := ret[0].(loginguard.State)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Fail indicates an expected call of Fail.
+func (mr *MockGuardMockRecorder) Fail(ctx, biz, account, ip any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fail", reflect.TypeOf((*MockGuard)(nil).Fail), ctx, biz, account, ip)
+}
+
+// Reset mocks base method.
+func (m *MockGuard) Reset(ctx context.Context, biz, account string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reset", ctx, biz, account)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reset indicates an expected call of Reset.
+func (mr *MockGuardMockRecorder) Reset(ctx, biz, account any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockGuard)(nil).Reset), ctx, biz, account)
+}
@@ -0,0 +1,128 @@
+package loginguard
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"webook/pkg/limiter"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// lockCntExpiration 锁定次数的统计周期，一天之内锁定得越多，锁得越久
+const lockCntExpiration = 24 * time.Hour
+
+type RedisGuard struct {
+	cmd redis.Cmdable
+	cfg Config
+	// 三个限流器只在失败的时候调用，相当于失败次数的计数器
+	captchaLimiter limiter.Limiter
+	accountLimiter limiter.Limiter
+	ipLimiter      limiter.Limiter
+}
+
+func NewRedisGuard(cmd redis.Cmdable, cfg Config) Guard {
+	// 限流器是在窗口内已经有 rate 次之后才限流，也就是第 rate+1 次才返回 true，
+	// 所以这里要减一，第 threshold 次失败的时候就触发
+	return &RedisGuard{
+		cmd:            cmd,
+		cfg:            cfg,
+		captchaLimiter: limiter.NewRedisSlidingWindowLimiter(cmd, cfg.Window, max(cfg.CaptchaThreshold-1, 0)),
+		accountLimiter: limiter.NewRedisSlidingWindowLimiter(cmd, cfg.Window, max(cfg.AccountThreshold-1, 0)),
+		ipLimiter:      limiter.NewRedisSlidingWindowLimiter(cmd, cfg.Window, max(cfg.IPThreshold-1, 0)),
+	}
+}
+
+func (g *RedisGuard) Check(ctx context.Context, biz string, account string, ip string) (State, error) {
+	pipe := g.cmd.Pipeline()
+	accountTTL := pipe.PTTL(ctx, g.lockKey(biz, "account", account))
+	ipTTL := pipe.PTTL(ctx, g.lockKey(biz, "ip", ip))
+	captcha := pipe.Exists(ctx, g.captchaKey(biz, account))
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		return State{}, err
+	}
+	var state State
+	// 不存在的 key 返回的是负数
+	state.RetryAfter = max(accountTTL.Val(), ipTTL.Val(), 0)
+	state.Locked = state.RetryAfter > 0
+	state.CaptchaRequired = captcha.Val() > 0
+	return state, nil
+}
+
+func (g *RedisGuard) Fail(ctx context.Context, biz string, account string, ip string) (State, error) {
+	limited, err := g.accountLimiter.Limit(ctx, g.failKey(biz, "account", account))
+	if err != nil {
+		return State{}, err
+	}
+	if limited {
+		err = g.lock(ctx, biz, "account", account)
+		if err != nil {
+			return State{}, err
+		}
+	}
+	limited, err = g.ipLimiter.Limit(ctx, g.failKey(biz, "ip", ip))
+	if err != nil {
+		return State{}, err
+	}
+	if limited {
+		err = g.lock(ctx, biz, "ip", ip)
+		if err != nil {
+			return State{}, err
+		}
+	}
+	limited, err = g.captchaLimiter.Limit(ctx, g.failKey(biz, "captcha", account))
+	if err != nil {
+		return State{}, err
+	}
+	if limited {
+		err = g.cmd.Set(ctx, g.captchaKey(biz, account), "", g.cfg.Window).Err()
+		if err != nil {
+			return State{}, err
+		}
+	}
+	return g.Check(ctx, biz, account, ip)
+}
+
+func (g *RedisGuard) Reset(ctx context.Context, biz string, account string) error {
+	return g.cmd.Del(ctx,
+		g.failKey(biz, "account", account),
+		g.failKey(biz, "captcha", account),
+		g.captchaKey(biz, account),
+		g.lockCntKey(biz, "account", account)).Err()
+}
+
+// lock 渐进式的锁定，同一个对象一天之内每多锁一次，锁定时长翻倍
+func (g *RedisGuard) lock(ctx context.Context, biz string, typ string, subject string) error {
+	cntKey := g.lockCntKey(biz, typ, subject)
+	cnt, err := g.cmd.Incr(ctx, cntKey).Result()
+	if err != nil {
+		return err
+	}
+	dur := g.cfg.LockDuration
+	for i := int64(1); i < cnt && dur < g.cfg.MaxLockDuration; i++ {
+		dur *= 2
+	}
+	dur = min(dur, g.cfg.MaxLockDuration)
+	pipe := g.cmd.TxPipeline()
+	pipe.Expire(ctx, cntKey, lockCntExpiration)
+	pipe.Set(ctx, g.lockKey(biz, typ, subject), "", dur)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+func (g *RedisGuard) failKey(biz string, typ string, subject string) string {
+	return fmt.Sprintf("login_guard:fail:%s:%s:%s", biz, typ, subject)
+}
+
+func (g *RedisGuard) lockKey(biz string, typ string, subject string) string {
+	return fmt.Sprintf("login_guard:lock:%s:%s:%s", biz, typ, subject)
+}
+
+func (g *RedisGuard) lockCntKey(biz string, typ string, subject string) string {
+	return fmt.Sprintf("login_guard:lock_cnt:%s:%s:%s", biz, typ, subject)
+}
+
+func (g *RedisGuard) captchaKey(biz string, account string) string {
+	return fmt.Sprintf("login_guard:captcha:%s:%s", biz, account)
+}
@@ -0,0 +1,111 @@
+package loginguard
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedisGuard_Fail(t *testing.T) {
+	g := NewRedisGuard(newFakeRedis(), Config{
+		Window:           time.Minute,
+		CaptchaThreshold: 3,
+		AccountThreshold: 5,
+		IPThreshold:      50,
+		LockDuration:     time.Minute,
+		MaxLockDuration:  time.Hour,
+	})
+	ctx := context.Background()
+	for i := 1; i <= 5; i++ {
+		state, err := g.Fail(ctx, "login", "a@qq.com", "127.0.0.1")
+		require.NoError(t, err)
+		// 第 3 次失败之后要验证码，第 5 次失败之后锁定
+		assert.Equal(t, i >= 3, state.CaptchaRequired, "第 %d 次失败", i)
+		assert.Equal(t, i >= 5, state.Locked, "第 %d 次失败", i)
+	}
+}
+
+// fakeRedis 用 hook 拦截所有命令，只实现了 RedisGuard 用到的那些，
+// 滑动窗口的 lua 脚本按照 slide_window.lua 的语义模拟
+type fakeRedis struct {
+	zsets   map[string]int
+	strings map[string]time.Duration
+	ints    map[string]int64
+}
+
+func newFakeRedis() redis.Cmdable {
+	client := redis.NewClient(&redis.Options{Addr: "fake:6379"})
+	client.AddHook(&fakeRedis{
+		zsets:   map[string]int{},
+		strings: map[string]time.Duration{},
+		ints:    map[string]int64{},
+	})
+	return client
+}
+
+func (f *fakeRedis) DialHook(next redis.DialHook) redis.DialHook {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		return nil, fmt.Errorf("不应该建立连接")
+	}
+}
+
+func (f *fakeRedis) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		f.process(cmd)
+		return nil
+	}
+}
+
+func (f *fakeRedis) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		for _, cmd := range cmds {
+			f.process(cmd)
+		}
+		return nil
+	}
+}
+
+func (f *fakeRedis) process(cmd redis.Cmder) {
+	args := cmd.Args()
+	switch strings.ToLower(cmd.Name()) {
+	case "eval":
+		key := args[3].(string)
+		rate := args[5].(int)
+		c := cmd.(*redis.Cmd)
+		if f.zsets[key] >= rate {
+			c.SetVal("true")
+			return
+		}
+		f.zsets[key]++
+		c.SetVal("false")
+	case "incr":
+		key := args[1].(string)
+		f.ints[key]++
+		cmd.(*redis.IntCmd).SetVal(f.ints[key])
+	case "set":
+		f.strings[args[1].(string)] = time.Minute
+		cmd.(*redis.StatusCmd).SetVal("OK")
+	case "pttl":
+		dur, ok := f.strings[args[1].(string)]
+		if !ok {
+			dur = -2 * time.Millisecond
+		}
+		cmd.(*redis.DurationCmd).SetVal(dur)
+	case "exists":
+		_, ok := f.strings[args[1].(string)]
+		if ok {
+			cmd.(*redis.IntCmd).SetVal(1)
+		} else {
+			cmd.(*redis.IntCmd).SetVal(0)
+		}
+	case "expire":
+		cmd.(*redis.BoolCmd).SetVal(true)
+	}
+}
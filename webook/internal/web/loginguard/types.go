@@ -0,0 +1,42 @@
+package loginguard
+
+import (
+	"context"
+	"time"
+)
+
+// Guard 防止暴力破解登录。登录之前调用 Check，失败了调用 Fail，成功了调用 Reset
+//
+//go:generate mockgen -source=./types.go -package=guardmocks -destination=./mocks/guard.mock.go Guard
+type Guard interface {
+	// Check 判断账号或者 IP 是否被锁定，以及是否需要验证码
+	Check(ctx context.Context, biz string, account string, ip string) (State, error)
+	// Fail 记录一次失败，失败次数超过阈值就会锁定
+	Fail(ctx context.Context, biz string, account string, ip string) (State, error)
+	// Reset 登录成功之后清理账号的失败记录，IP 的记录不清理
+	Reset(ctx context.Context, biz string, account string) error
+}
+
+type State struct {
+	Locked bool
+	// RetryAfter 还要多久才会解锁
+	RetryAfter time.Duration
+	// CaptchaRequired 下一次登录需要带上验证码
+	CaptchaRequired bool
+}
+
+type Config struct {
+	// Window 统计失败次数的窗口
+	Window time.Duration
+	// CaptchaThreshold 账号在窗口内失败多少次之后需要验证码
+	CaptchaThreshold int
+	// AccountThreshold 账号在窗口内失败多少次之后锁定
+	AccountThreshold int
+	// IPThreshold 同一个 IP 在窗口内失败多少次之后锁定，
+	// 要比账号的阈值大，因为公司、学校这种出口 IP 后面有很多人
+	IPThreshold int
+	// LockDuration 第一次锁定的时长，之后每锁定一次翻倍
+	LockDuration time.Duration
+	// MaxLockDuration 锁定时长的上限
+	MaxLockDuration time.Duration
+}
@@ -0,0 +1,170 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"strconv"
+	"time"
+	"webook/internal/domain"
+	"webook/internal/service"
+	"webook/internal/web/jwt"
+	"webook/pkg/ginx"
+	"webook/pkg/logger"
+	"webook/pkg/objstore"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MediaHandler 上传图片和视频，文章里面通过 ID 引用
+type MediaHandler struct {
+	svc service.MediaService
+	cfg service.MediaConfig
+	l   logger.LoggerV1
+}
+
+func NewMediaHandler(svc service.MediaService, cfg service.MediaConfig, l logger.LoggerV1) *MediaHandler {
+	return &MediaHandler{
+		svc: svc,
+		cfg: cfg,
+		l:   l,
+	}
+}
+
+func (h *MediaHandler) RegisterRoutes(server *gin.Engine) {
+	mg := server.Group("/media")
+	mg.POST("/upload", ginx.WrapClaims(h.Upload))
+	mg.GET("/detail/:id", ginx.WrapClaims(h.Detail))
+	// 本地存储的时候由这里读文件，S3 一般直接走 CDN
+	mg.GET("/files/*key", h.File)
+}
+
+// Upload 表单字段 file
+func (h *MediaHandler) Upload(ctx *gin.Context, uc jwt.UserClaims) (ginx.Result, error) {
+	// 留一点给表单的其它部分，真正的大小限制在 service 里面
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, h.cfg.MaxSize+1<<20)
+	fh, err := ctx.FormFile("file")
+	if err != nil {
+		var mbe *http.MaxBytesError
+		if errors.As(err, &mbe) {
+			return ginx.Result{
+				Code: 4,
+				Msg:  service.ErrMediaTooLarge.Error(),
+			}, nil
+		}
+		return ginx.Result{
+			Code: 4,
+			Msg:  "参数错误",
+		}, nil
+	}
+	f, err := fh.Open()
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+	defer f.Close()
+	m, err := h.svc.Upload(ctx, uc.Uid, f)
+	switch err {
+	case nil:
+		return ginx.Result{
+			Data: h.toVo(m),
+		}, nil
+	case service.ErrMediaTooLarge, service.ErrMediaTypeNotAllowed, service.ErrMediaInvalidImage:
+		return ginx.Result{
+			Code: 4,
+			Msg:  err.Error(),
+		}, nil
+	default:
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, fmt.Errorf("上传文件失败 %w", err)
+	}
+}
+
+func (h *MediaHandler) Detail(ctx *gin.Context, uc jwt.UserClaims) (ginx.Result, error) {
+	idstr := ctx.Param("id")
+	id, err := strconv.ParseInt(idstr, 10, 64)
+	if err != nil {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "参数错误",
+		}, fmt.Errorf("文件的 ID %s 不正确, %w", idstr, err)
+	}
+	m, err := h.svc.GetById(ctx, id)
+	switch {
+	case err == nil && m.Uid == uc.Uid:
+		return ginx.Result{
+			Data: h.toVo(m),
+		}, nil
+	case err == nil, errors.Is(err, service.ErrMediaNotFound):
+		// 别人的文件也当作不存在
+		return ginx.Result{
+			Code: 4,
+			Msg:  "文件不存在",
+		}, nil
+	default:
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, err
+	}
+}
+
+// File 直接返回文件内容，所以不能用 ginx.Wrap 系列
+func (h *MediaHandler) File(ctx *gin.Context) {
+	key := ctx.Param("key")[1:]
+	r, err := h.svc.Open(ctx, key)
+	if err == objstore.ErrObjectNotFound {
+		ctx.Status(http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		h.l.Error("读取文件失败", logger.String("key", key), logger.Error(err))
+		ctx.Status(http.StatusInternalServerError)
+		return
+	}
+	defer r.Close()
+	ct := mime.TypeByExtension(path.Ext(key))
+	if ct == "" {
+		ct = "application/octet-stream"
+	}
+	// key 里面有 UUID，内容不会变
+	ctx.Header("Cache-Control", "public, max-age=31536000, immutable")
+	ctx.Header("X-Content-Type-Options", "nosniff")
+	ctx.Header("Content-Type", ct)
+	ctx.Status(http.StatusOK)
+	_, err = io.Copy(ctx.Writer, r)
+	if err != nil {
+		h.l.Warn("返回文件失败", logger.String("key", key), logger.Error(err))
+	}
+}
+
+func (h *MediaHandler) toVo(m domain.Media) MediaVo {
+	return MediaVo{
+		Id:          m.Id,
+		Url:         m.Url,
+		ThumbUrl:    m.ThumbUrl,
+		ContentType: m.ContentType,
+		Size:        m.Size,
+		Width:       m.Width,
+		Height:      m.Height,
+		Ctime:       m.Ctime.Format(time.DateTime),
+	}
+}
+
+type MediaVo struct {
+	Id          int64  `json:"id"`
+	Url         string `json:"url"`
+	ThumbUrl    string `json:"thumbUrl,omitempty"`
+	ContentType string `json:"contentType"`
+	Size        int64  `json:"size"`
+	Width       int    `json:"width,omitempty"`
+	Height      int    `json:"height,omitempty"`
+	Ctime       string `json:"ctime"`
+}
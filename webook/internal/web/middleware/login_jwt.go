@@ -5,7 +5,6 @@ import (
 	ijwt "webook/internal/web/jwt"
 
 	"github.com/gin-gonic/gin"
-	"github.com/golang-jwt/jwt/v5"
 )
 
 type LoginJWTMiddlewareBuilder struct {
@@ -21,23 +20,28 @@ func NewLoginJWTMiddlewareBuilder(hdl ijwt.Handler) *LoginJWTMiddlewareBuilder {
 func (lmb *LoginJWTMiddlewareBuilder) CheckLogin() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		path := ctx.Request.URL.Path
+		// 带参数的路由用匹配上的路由模板判断
+		fullPath := ctx.FullPath()
 		if (path == "/users/signup") || (path == "/users/login") ||
 			(path == "/users/login_sms/code/send") || (path == "/users/login_sms") ||
-			(path == "/oauth2/wechat/authurl") || (path == "/oauth2/wechat/callback") {
+			(path == "/users/login/2fa") ||
+			(path == "/oauth2/wechat/authurl") || (path == "/oauth2/wechat/callback") ||
+			(path == "/oauth2/providers") ||
+			(fullPath == "/oauth2/:provider/authurl") || (fullPath == "/oauth2/:provider/callback") ||
+			(path == "/users/password/forgot") || (path == "/users/password/reset") ||
+			(path == "/users/email/verify") ||
+			(path == "/.well-known/jwks.json") ||
+			// 站点地图和订阅源是给爬虫和阅读器的
+			(path == "/sitemap.xml") || (path == "/feed/rss") || (path == "/feed/atom") ||
+			(fullPath == "/feed/authors/:uid/rss") || (fullPath == "/feed/authors/:uid/atom") ||
+			// 文章里面的图片，读者不一定登录了
+			(fullPath == "/media/files/*key") {
 			return
 		}
 		tokenStr := lmb.ExtractToken(ctx)
-		var uc ijwt.UserClaims
-		token, err := jwt.ParseWithClaims(tokenStr, &uc, func(token *jwt.Token) (interface{}, error) {
-			return ijwt.JWTKey, nil
-		})
+		uc, err := lmb.ParseAccessToken(tokenStr)
 		if err != nil {
-			// token 有问题
-			ctx.AbortWithStatus(http.StatusUnauthorized)
-			return
-		}
-		if token == nil || !token.Valid {
-			// token 成功解析，但是非法或是过期了
+			// token 有问题，非法或是过期了
 			ctx.AbortWithStatus(http.StatusUnauthorized)
 			return
 		}
@@ -47,6 +51,8 @@ func (lmb *LoginJWTMiddlewareBuilder) CheckLogin() gin.HandlerFunc {
 			ctx.AbortWithStatus(http.StatusUnauthorized)
 			return
 		}
+		// 记录一下活跃时间，失败了也不影响正常的请求
+		_ = lmb.TouchSession(ctx, uc.Uid, uc.Ssid)
 
 		ctx.Set("user", uc)
 	}
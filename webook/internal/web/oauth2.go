@@ -0,0 +1,155 @@
+package web
+
+import (
+	"net/http"
+	"webook/internal/service"
+	"webook/internal/service/oauth2"
+	ijwt "webook/internal/web/jwt"
+	"webook/pkg/ginx"
+
+	"github.com/gin-gonic/gin"
+	uuid "github.com/lithammer/shortuuid/v4"
+	"go.uber.org/zap"
+)
+
+// OAuth2Handler 通用的第三方登录，GitHub、Google 之类的都走这里，
+// 路径里面的 provider 就是配置里面的名字
+type OAuth2Handler struct {
+	ijwt.Handler
+	registry *oauth2.Registry
+	userSvc  service.UserService
+	state    oauth2State
+}
+
+func NewOAuth2Handler(registry *oauth2.Registry,
+	hdl ijwt.Handler,
+	userSvc service.UserService) *OAuth2Handler {
+	return &OAuth2Handler{
+		registry: registry,
+		userSvc:  userSvc,
+		state:    newOAuth2State(),
+		Handler:  hdl,
+	}
+}
+
+func (o *OAuth2Handler) RegisterRoutes(server *gin.Engine) {
+	server.GET("/oauth2/providers", o.Providers)
+	g := server.Group("/oauth2/:provider")
+	g.GET("/authurl", o.OAuth2URL)
+	g.GET("/bind_authurl", ginx.WrapClaims(o.BindOAuth2URL))
+	g.Any("/callback", o.Callback)
+}
+
+// Providers 返回启用了的第三方，前端用来展示登录按钮
+func (o *OAuth2Handler) Providers(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, ginx.Result{
+		Data: o.registry.Names(),
+	})
+}
+
+func (o *OAuth2Handler) OAuth2URL(ctx *gin.Context) {
+	res, err := o.authURL(ctx, 0)
+	if err != nil {
+		zap.L().Error("构造跳转URL失败", zap.Error(err))
+	}
+	ctx.JSON(http.StatusOK, res)
+}
+
+func (o *OAuth2Handler) BindOAuth2URL(ctx *gin.Context, uc ijwt.UserClaims) (ginx.Result, error) {
+	return o.authURL(ctx, uc.Uid)
+}
+
+func (o *OAuth2Handler) authURL(ctx *gin.Context, bindUid int64) (ginx.Result, error) {
+	p, err := o.registry.Get(ctx.Param("provider"))
+	if err != nil {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "不支持的第三方登录",
+		}, nil
+	}
+	verifier, err := oauth2.GenerateCodeVerifier()
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "服务器异常",
+		}, err
+	}
+	state := uuid.New()
+	url, err := p.AuthURL(ctx, state, oauth2.CodeChallenge(verifier))
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "构造跳转URL失败",
+		}, err
+	}
+	err = o.state.set(ctx, "/oauth2/"+p.Name()+"/callback", StateClaims{
+		State:        state,
+		BindUid:      bindUid,
+		Provider:     p.Name(),
+		CodeVerifier: verifier,
+	})
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "服务器异常",
+		}, err
+	}
+	return ginx.Result{
+		Data: url,
+	}, nil
+}
+
+func (o *OAuth2Handler) Callback(ctx *gin.Context) {
+	p, err := o.registry.Get(ctx.Param("provider"))
+	if err != nil {
+		ctx.JSON(http.StatusOK, ginx.Result{
+			Code: 4,
+			Msg:  "不支持的第三方登录",
+		})
+		return
+	}
+	sc, err := o.state.verify(ctx)
+	if err != nil || sc.Provider != p.Name() {
+		ctx.JSON(http.StatusOK, ginx.Result{
+			Code: 4,
+			Msg:  "非法请求",
+		})
+		return
+	}
+
+	code := ctx.Query("code")
+	identity, err := p.VerifyCode(ctx, code, sc.CodeVerifier)
+	if err != nil {
+		zap.L().Warn("校验授权码失败", zap.String("provider", p.Name()), zap.Error(err))
+		ctx.JSON(http.StatusOK, ginx.Result{
+			Code: 4,
+			Msg:  "授权码有误",
+		})
+		return
+	}
+
+	if sc.BindUid > 0 {
+		res, err := bindResult(o.userSvc.BindIdentity(ctx, sc.BindUid, identity))
+		if err != nil {
+			zap.L().Error("绑定第三方账号失败", zap.String("provider", p.Name()), zap.Error(err))
+		}
+		ctx.JSON(http.StatusOK, res)
+		return
+	}
+
+	user, err := o.userSvc.FindOrCreateByIdentity(ctx, identity)
+	if err != nil {
+		zap.L().Error("第三方登录查找或者创建用户失败",
+			zap.String("provider", p.Name()), zap.Error(err))
+		ctx.JSON(http.StatusOK, ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		})
+		return
+	}
+	res, err := setLoginTokenOrPreAuth(ctx, o.Handler, user)
+	if err != nil {
+		zap.L().Error("设置登录态失败", zap.Error(err))
+	}
+	ctx.JSON(http.StatusOK, res)
+}
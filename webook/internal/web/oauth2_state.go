@@ -0,0 +1,68 @@
+package web
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// oauth2State 第三方登录防 CSRF 用的 state，签名之后放在 cookie 里面，
+// 回调的时候和 query 里面的 state 比较
+type oauth2State struct {
+	key        []byte
+	cookieName string
+}
+
+func newOAuth2State() oauth2State {
+	return oauth2State{
+		key:        []byte("WiXLWadWG44Rr2qP6VUDLod0dzAnRI46"),
+		cookieName: "jwt-state",
+	}
+}
+
+func (s oauth2State) set(ctx *gin.Context, callbackPath string, claims StateClaims) error {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	tokenStr, err := token.SignedString(s.key)
+	if err != nil {
+		return err
+	}
+	ctx.SetCookie(s.cookieName, tokenStr,
+		600,
+		// 限制在只能在这里生效。
+		callbackPath,
+		// 这边把 HTTPS 协议禁止了。不过在生产环境中要开启。
+		"", false, true)
+	return nil
+}
+
+func (s oauth2State) verify(ctx *gin.Context) (StateClaims, error) {
+	state := ctx.Query("state")
+	ck, err := ctx.Cookie(s.cookieName)
+	if err != nil {
+		// 基本上，如果进来这里，就可以认为是有人在搞鬼。
+		return StateClaims{}, fmt.Errorf("无法获得 cookie %w", err)
+	}
+	var sc StateClaims
+	_, err = jwt.ParseWithClaims(ck, &sc, func(token *jwt.Token) (interface{}, error) {
+		return s.key, nil
+	})
+	if err != nil {
+		return StateClaims{}, fmt.Errorf("解析 token 失败 %w", err)
+	}
+	if sc.State != state {
+		return StateClaims{}, fmt.Errorf("state 不匹配")
+	}
+	return sc, nil
+}
+
+type StateClaims struct {
+	jwt.RegisteredClaims
+	State string
+	// BindUid 不为 0 的时候表示这次授权是用来绑定第三方账号的
+	BindUid int64
+	// Provider 发起授权的第三方，避免拿 A 的 state 去回调 B
+	Provider string
+	// CodeVerifier PKCE 的 code_verifier，换 token 的时候要带上
+	CodeVerifier string
+}
@@ -0,0 +1,205 @@
+package web
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"webook/internal/domain"
+	"webook/internal/service"
+	svcmocks "webook/internal/service/mocks"
+	"webook/internal/service/oauth2"
+	oauth2mocks "webook/internal/service/oauth2/mocks"
+	ijwt "webook/internal/web/jwt"
+	jwtmocks "webook/internal/web/jwt/mocks"
+	"webook/pkg/ginx"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestOAuth2Handler_Callback(t *testing.T) {
+	identity := domain.ExternalIdentity{
+		Provider: "github",
+		Subject:  "10086",
+	}
+	testCases := []struct {
+		name string
+
+		mock func(ctrl *gomock.Controller) (oauth2.Provider, service.UserService, ijwt.Handler)
+		// state 为空的时候不带 cookie
+		state    StateClaims
+		provider string
+
+		wantResult ginx.Result
+	}{
+		{
+			name: "登录成功",
+			mock: func(ctrl *gomock.Controller) (oauth2.Provider, service.UserService, ijwt.Handler) {
+				p := newMockGithubProvider(ctrl)
+				p.EXPECT().VerifyCode(gomock.Any(), "abc", "verifier").Return(identity, nil)
+				userSvc := svcmocks.NewMockUserService(ctrl)
+				userSvc.EXPECT().FindOrCreateByIdentity(gomock.Any(), identity).
+					Return(domain.User{Id: 123}, nil)
+				hdl := jwtmocks.NewMockHandler(ctrl)
+				hdl.EXPECT().SetLoginToken(gomock.Any(), int64(123), gomock.Any()).Return(nil)
+				return p, userSvc, hdl
+			},
+			state:    StateClaims{State: "state", Provider: "github", CodeVerifier: "verifier"},
+			provider: "github",
+			wantResult: ginx.Result{
+				Msg: "登录成功",
+			},
+		},
+		{
+			name: "绑定第三方账号",
+			mock: func(ctrl *gomock.Controller) (oauth2.Provider, service.UserService, ijwt.Handler) {
+				p := newMockGithubProvider(ctrl)
+				p.EXPECT().VerifyCode(gomock.Any(), "abc", "verifier").Return(identity, nil)
+				userSvc := svcmocks.NewMockUserService(ctrl)
+				userSvc.EXPECT().BindIdentity(gomock.Any(), int64(123), identity).Return(nil)
+				return p, userSvc, jwtmocks.NewMockHandler(ctrl)
+			},
+			state: StateClaims{State: "state", Provider: "github",
+				CodeVerifier: "verifier", BindUid: 123},
+			provider: "github",
+			wantResult: ginx.Result{
+				Msg: "绑定成功",
+			},
+		},
+		{
+			name: "不支持的第三方",
+			mock: func(ctrl *gomock.Controller) (oauth2.Provider, service.UserService, ijwt.Handler) {
+				return newMockGithubProvider(ctrl), svcmocks.NewMockUserService(ctrl), jwtmocks.NewMockHandler(ctrl)
+			},
+			state:    StateClaims{State: "state", Provider: "github", CodeVerifier: "verifier"},
+			provider: "gitlab",
+			wantResult: ginx.Result{
+				Code: 4,
+				Msg:  "不支持的第三方登录",
+			},
+		},
+		{
+			name: "没有 state cookie",
+			mock: func(ctrl *gomock.Controller) (oauth2.Provider, service.UserService, ijwt.Handler) {
+				return newMockGithubProvider(ctrl), svcmocks.NewMockUserService(ctrl), jwtmocks.NewMockHandler(ctrl)
+			},
+			provider: "github",
+			wantResult: ginx.Result{
+				Code: 4,
+				Msg:  "非法请求",
+			},
+		},
+		{
+			name: "state 是别的第三方的",
+			mock: func(ctrl *gomock.Controller) (oauth2.Provider, service.UserService, ijwt.Handler) {
+				return newMockGithubProvider(ctrl), svcmocks.NewMockUserService(ctrl), jwtmocks.NewMockHandler(ctrl)
+			},
+			state:    StateClaims{State: "state", Provider: "google", CodeVerifier: "verifier"},
+			provider: "github",
+			wantResult: ginx.Result{
+				Code: 4,
+				Msg:  "非法请求",
+			},
+		},
+		{
+			name: "授权码有误",
+			mock: func(ctrl *gomock.Controller) (oauth2.Provider, service.UserService, ijwt.Handler) {
+				p := newMockGithubProvider(ctrl)
+				p.EXPECT().VerifyCode(gomock.Any(), "abc", "verifier").
+					Return(domain.ExternalIdentity{}, errors.New("bad_verification_code"))
+				return p, svcmocks.NewMockUserService(ctrl), jwtmocks.NewMockHandler(ctrl)
+			},
+			state:    StateClaims{State: "state", Provider: "github", CodeVerifier: "verifier"},
+			provider: "github",
+			wantResult: ginx.Result{
+				Code: 4,
+				Msg:  "授权码有误",
+			},
+		},
+		{
+			name: "查找或者创建用户失败",
+			mock: func(ctrl *gomock.Controller) (oauth2.Provider, service.UserService, ijwt.Handler) {
+				p := newMockGithubProvider(ctrl)
+				p.EXPECT().VerifyCode(gomock.Any(), "abc", "verifier").Return(identity, nil)
+				userSvc := svcmocks.NewMockUserService(ctrl)
+				userSvc.EXPECT().FindOrCreateByIdentity(gomock.Any(), identity).
+					Return(domain.User{}, errors.New("db错误"))
+				return p, userSvc, jwtmocks.NewMockHandler(ctrl)
+			},
+			state:    StateClaims{State: "state", Provider: "github", CodeVerifier: "verifier"},
+			provider: "github",
+			wantResult: ginx.Result{
+				Code: 5,
+				Msg:  "系统错误",
+			},
+		},
+		{
+			name: "开启了两步验证",
+			mock: func(ctrl *gomock.Controller) (oauth2.Provider, service.UserService, ijwt.Handler) {
+				p := newMockGithubProvider(ctrl)
+				p.EXPECT().VerifyCode(gomock.Any(), "abc", "verifier").Return(identity, nil)
+				userSvc := svcmocks.NewMockUserService(ctrl)
+				userSvc.EXPECT().FindOrCreateByIdentity(gomock.Any(), identity).
+					Return(domain.User{Id: 123, TwoFactor: domain.TwoFactor{Enabled: true}}, nil)
+				hdl := jwtmocks.NewMockHandler(ctrl)
+				hdl.EXPECT().SetPreAuthToken(gomock.Any(), int64(123)).Return("pre-auth", nil)
+				return p, userSvc, hdl
+			},
+			state:    StateClaims{State: "state", Provider: "github", CodeVerifier: "verifier"},
+			provider: "github",
+			wantResult: ginx.Result{
+				Msg: "请输入两步验证码",
+				Data: map[string]any{
+					"required":     true,
+					"preAuthToken": "pre-auth",
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			p, userSvc, jwtHdl := tc.mock(ctrl)
+			hdl := NewOAuth2Handler(oauth2.NewRegistry(p), jwtHdl, userSvc)
+			server := gin.Default()
+			hdl.RegisterRoutes(server)
+
+			req, err := http.NewRequest(http.MethodGet,
+				"/oauth2/"+tc.provider+"/callback?code=abc&state=state", nil)
+			require.NoError(t, err)
+			if tc.state.State != "" {
+				req.AddCookie(oauth2StateCookie(t, tc.state))
+			}
+			recorder := httptest.NewRecorder()
+			server.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			var res ginx.Result
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantResult, res)
+		})
+	}
+}
+
+func newMockGithubProvider(ctrl *gomock.Controller) *oauth2mocks.MockProvider {
+	p := oauth2mocks.NewMockProvider(ctrl)
+	p.EXPECT().Name().Return("github").AnyTimes()
+	return p
+}
+
+// oauth2StateCookie 模拟跳转到第三方之前种下的 state cookie
+func oauth2StateCookie(t *testing.T, sc StateClaims) *http.Cookie {
+	s := newOAuth2State()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, sc).SignedString(s.key)
+	require.NoError(t, err)
+	return &http.Cookie{Name: s.cookieName, Value: token}
+}
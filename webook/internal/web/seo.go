@@ -0,0 +1,102 @@
+package web
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+	"webook/internal/domain"
+)
+
+// SiteConfig 站点的信息，生成规范链接、分享卡片和订阅源的时候要用
+type SiteConfig struct {
+	Name        string
+	Description string
+	// BaseURL 前端的地址，例如 https://webook.com，不带最后的 /
+	BaseURL string
+	// TwitterSite 站点的 Twitter 账号，带上 @
+	TwitterSite string
+}
+
+// ArticleURL 文章的规范地址，slug 只是为了好看，前端只认 ID
+func (c SiteConfig) ArticleURL(art domain.Article) string {
+	res := c.BaseURL + "/articles/" + strconv.FormatInt(art.Id, 10)
+	if slug := art.Slug(); slug != "" {
+		res += "/" + url.PathEscape(slug)
+	}
+	return res
+}
+
+// AbsURL 本地存储的文件是相对路径，分享卡片里面要换成完整的地址
+func (c SiteConfig) AbsURL(path string) string {
+	if strings.HasPrefix(path, "/") {
+		return c.BaseURL + path
+	}
+	return path
+}
+
+// SeoVo 前端渲染 <head> 要用的信息
+type SeoVo struct {
+	CanonicalUrl string        `json:"canonicalUrl"`
+	Description  string        `json:"description"`
+	OpenGraph    OpenGraphVo   `json:"openGraph"`
+	Twitter      TwitterCardVo `json:"twitter"`
+}
+
+// OpenGraphVo 对应 og: 和 article: 开头的 meta
+type OpenGraphVo struct {
+	Type          string `json:"type"`
+	Title         string `json:"title"`
+	Description   string `json:"description"`
+	Url           string `json:"url"`
+	Image         string `json:"image,omitempty"`
+	SiteName      string `json:"siteName"`
+	PublishedTime string `json:"publishedTime"`
+	ModifiedTime  string `json:"modifiedTime"`
+	Author        string `json:"author,omitempty"`
+}
+
+// TwitterCardVo 对应 twitter: 开头的 meta
+type TwitterCardVo struct {
+	Card        string `json:"card"`
+	Site        string `json:"site,omitempty"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Image       string `json:"image,omitempty"`
+}
+
+func (c SiteConfig) toSeoVo(art domain.Article) *SeoVo {
+	canonical := c.ArticleURL(art)
+	desc := art.Abstract()
+	var image string
+	if art.Cover.Url != "" {
+		image = c.AbsURL(art.Cover.Url)
+	}
+	// 有封面就用大图卡片
+	card := "summary"
+	if image != "" {
+		card = "summary_large_image"
+	}
+	return &SeoVo{
+		CanonicalUrl: canonical,
+		Description:  desc,
+		OpenGraph: OpenGraphVo{
+			Type:          "article",
+			Title:         art.Title,
+			Description:   desc,
+			Url:           canonical,
+			Image:         image,
+			SiteName:      c.Name,
+			PublishedTime: art.Ctime.Format(time.RFC3339),
+			ModifiedTime:  art.Utime.Format(time.RFC3339),
+			Author:        art.Author.Name,
+		},
+		Twitter: TwitterCardVo{
+			Card:        card,
+			Site:        c.TwitterSite,
+			Title:       art.Title,
+			Description: desc,
+			Image:       image,
+		},
+	}
+}
@@ -6,7 +6,8 @@ type Handler interface {
 	RegisterRoutes(server *gin.Engine)
 }
 
-type Page struct {
-	Limit  int
-	Offset int
+// CursorPage 游标分页，Cursor 是上一页返回的，第一页不用传
+type CursorPage struct {
+	Cursor string `json:"cursor"`
+	Limit  int    `json:"limit"`
 }
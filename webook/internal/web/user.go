@@ -2,19 +2,24 @@ package web
 
 import (
 	"errors"
+	"fmt"
+	"math"
 	"net/http"
+	"strconv"
 	"time"
 	"unicode/utf8"
 	"webook/internal/domain"
 	"webook/internal/errs"
 	"webook/internal/service"
+	"webook/internal/service/captcha"
 	ijwt "webook/internal/web/jwt"
+	"webook/internal/web/loginguard"
 	"webook/pkg/ginx"
 
 	regexp "github.com/dlclark/regexp2"
+	"github.com/ecodeclub/ekit/slice"
 	"github.com/gin-contrib/sessions"
 	"github.com/gin-gonic/gin"
-	"github.com/golang-jwt/jwt/v5"
 	"go.uber.org/zap"
 )
 
@@ -23,6 +28,12 @@ const (
 	passwordRegexPattern = `^(?=.*[A-Za-z])(?=.*\d)(?=.*[$@$!%*#?&])[A-Za-z\d$@$!%*#?&]{8,}$`
 	UserIdKey            = "userId"
 	bizLogin             = "login"
+	bizBindPhone         = "bind_phone"
+	// 防暴力破解按照登录方式分开统计
+	guardBizEmail = "login_email"
+	guardBizSMS   = "login_sms"
+	// 两步验证按照用户 ID 统计
+	guardBizTwoFactor = "login_2fa"
 )
 
 type UserHandler struct {
@@ -31,15 +42,22 @@ type UserHandler struct {
 	passwordRegexExp *regexp.Regexp
 	userService      service.UserService
 	codeService      service.CodeService
+	twoFactorService service.TwoFactorService
+	captchaService   captcha.Service
+	guard            loginguard.Guard
 }
 
 func NewUserHandler(userService service.UserService, codeService service.CodeService,
-	hdl ijwt.Handler) *UserHandler {
+	twoFactorService service.TwoFactorService, captchaService captcha.Service,
+	guard loginguard.Guard, hdl ijwt.Handler) *UserHandler {
 	return &UserHandler{
 		emailRegexExp:    regexp.MustCompile(emailRegexPattern, regexp.None),
 		passwordRegexExp: regexp.MustCompile(passwordRegexPattern, regexp.None),
 		userService:      userService,
 		codeService:      codeService,
+		twoFactorService: twoFactorService,
+		captchaService:   captchaService,
+		guard:            guard,
 		Handler:          hdl,
 	}
 }
@@ -47,7 +65,7 @@ func NewUserHandler(userService service.UserService, codeService service.CodeSer
 func (uh *UserHandler) RegisterRoutes(server *gin.Engine) {
 	ug := server.Group("/users")
 	ug.POST("/signup", ginx.WrapReq[SignUpReq](uh.SignUp))
-	ug.POST("/login", uh.Login)
+	ug.POST("/login", ginx.WrapReq[LoginReq](uh.Login))
 	ug.POST("/logout", uh.Logout)
 	ug.POST("/edit", uh.Edit)
 	ug.GET("/profile", uh.Profile)
@@ -56,6 +74,30 @@ func (uh *UserHandler) RegisterRoutes(server *gin.Engine) {
 	// 手机验证码相关功能
 	ug.POST("/login_sms/code/send", uh.SendSMSLoginCode)
 	ug.POST("/login_sms", uh.LoginSMS)
+
+	// 两步验证
+	ug.POST("/login/2fa", ginx.WrapReq[LoginTwoFactorReq](uh.LoginTwoFactor))
+	ug.POST("/2fa/enroll", ginx.WrapClaims(uh.EnrollTwoFactor))
+	ug.POST("/2fa/confirm", ginx.WrapClaimsAndReq[TwoFactorCodeReq](uh.ConfirmTwoFactor))
+	ug.POST("/2fa/disable", ginx.WrapClaimsAndReq[TwoFactorCodeReq](uh.DisableTwoFactor))
+
+	// 绑定、解绑登录方式以及合并账号
+	ug.POST("/bind/phone/code/send", ginx.WrapClaimsAndReq[SendBindPhoneCodeReq](uh.SendBindPhoneCode))
+	ug.POST("/bind/phone", ginx.WrapClaimsAndReq[BindPhoneReq](uh.BindPhone))
+	ug.POST("/bind/email", ginx.WrapClaimsAndReq[BindEmailReq](uh.BindEmail))
+	ug.POST("/unbind", ginx.WrapClaimsAndReq[UnbindReq](uh.Unbind))
+	ug.POST("/merge", ginx.WrapClaimsAndReq[MergeReq](uh.Merge))
+
+	// 邮箱验证和找回密码
+	ug.POST("/email/verify/send", ginx.WrapClaims(uh.SendEmailVerification))
+	ug.POST("/email/verify", ginx.WrapReq[VerifyEmailReq](uh.VerifyEmail))
+	ug.POST("/password/forgot", ginx.WrapReq[ForgotPasswordReq](uh.ForgotPassword))
+	ug.POST("/password/reset", ginx.WrapReq[ResetPasswordReq](uh.ResetPassword))
+
+	// 多设备登录管理
+	ug.GET("/sessions", ginx.WrapClaims(uh.Sessions))
+	ug.POST("/sessions/revoke", ginx.WrapClaimsAndReq[RevokeSessionReq](uh.LogoutSession))
+	ug.POST("/sessions/revoke_others", ginx.WrapClaims(uh.LogoutOtherSessions))
 }
 
 func (uh *UserHandler) SendSMSLoginCode(ctx *gin.Context) {
@@ -96,15 +138,25 @@ func (uh *UserHandler) SendSMSLoginCode(ctx *gin.Context) {
 
 func (uh *UserHandler) LoginSMS(ctx *gin.Context) {
 	type Req struct {
-		Phone string `json:"phone"`
-		Code  string `json:"code"`
+		Phone   string `json:"phone"`
+		Code    string `json:"code"`
+		Captcha string `json:"captcha"`
 	}
 	var req Req
 	if err := ctx.Bind(&req); err != nil {
 		return
 	}
 
-	ok, err := uh.codeService.Verify(ctx, bizLogin, req.Phone, req.Code)
+	res, ok, err := uh.checkGuard(ctx, guardBizSMS, req.Phone, req.Captcha)
+	if !ok {
+		if err != nil {
+			zap.L().Error("登录防护检查失败", zap.Error(err))
+		}
+		ctx.JSON(http.StatusOK, res)
+		return
+	}
+
+	ok, err = uh.codeService.Verify(ctx, bizLogin, req.Phone, req.Code)
 	if err != nil {
 		ctx.JSON(http.StatusOK, ginx.Result{
 			Code: 5,
@@ -118,10 +170,14 @@ func (uh *UserHandler) LoginSMS(ctx *gin.Context) {
 		return
 	}
 	if !ok {
-		ctx.JSON(http.StatusOK, ginx.Result{
+		res, err = uh.loginFailed(ctx, guardBizSMS, req.Phone, ginx.Result{
 			Code: 4,
 			Msg:  "验证码不对，请重新输入",
 		})
+		if err != nil {
+			zap.L().Error("记录登录失败失败", zap.Error(err))
+		}
+		ctx.JSON(http.StatusOK, res)
 		return
 	}
 	user, err := uh.userService.FindOrCreate(ctx, req.Phone)
@@ -132,14 +188,15 @@ func (uh *UserHandler) LoginSMS(ctx *gin.Context) {
 		})
 		return
 	}
-	err = uh.SetLoginToken(ctx, user.Id)
+	err = uh.guard.Reset(ctx, guardBizSMS, req.Phone)
 	if err != nil {
-		ctx.String(http.StatusOK, "系统错误")
-		return
+		zap.L().Error("清理登录失败记录失败", zap.Error(err))
 	}
-	ctx.JSON(http.StatusOK, ginx.Result{
-		Msg: "登陆成功",
-	})
+	res, err = setLoginTokenOrPreAuth(ctx, uh.Handler, user)
+	if err != nil {
+		zap.L().Error("设置登录态失败", zap.Error(err))
+	}
+	ctx.JSON(http.StatusOK, res)
 }
 
 type SignUpReq struct {
@@ -207,29 +264,214 @@ func (uh *UserHandler) SignUp(ctx *gin.Context, req SignUpReq) (ginx.Result, err
 	}
 }
 
-func (uh *UserHandler) Login(ctx *gin.Context) {
-	type LoginReq struct {
-		Email    string `json:"email"`
-		Password string `json:"password"`
-	}
-	var req LoginReq
-	if err := ctx.Bind(&req); err != nil {
-		ctx.String(http.StatusOK, "系统错误")
-		return
+type LoginReq struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+	// Captcha 失败次数多了之后需要带上验证码的票据
+	Captcha string `json:"captcha"`
+}
+
+func (uh *UserHandler) Login(ctx *gin.Context, req LoginReq) (ginx.Result, error) {
+	res, ok, err := uh.checkGuard(ctx, guardBizEmail, req.Email, req.Captcha)
+	if !ok {
+		return res, err
 	}
 	user, err := uh.userService.Login(ctx, req.Email, req.Password)
 	switch err {
 	case nil:
-		err := uh.SetLoginToken(ctx, user.Id)
-		if err != nil {
-			ctx.String(http.StatusOK, "系统错误")
-			return
-		}
-		ctx.String(http.StatusOK, "登录成功")
 	case service.ErrInvalidUserOrPassword:
-		ctx.String(http.StatusOK, "用户名或者密码不对")
+		return uh.loginFailed(ctx, guardBizEmail, req.Email, ginx.Result{
+			Code: errs.UserInvalidOrPassword,
+			Msg:  "用户名或者密码不对",
+		})
+	case service.ErrEmailNotVerified:
+		return ginx.Result{
+			Code: errs.UserEmailNotVerified,
+			Msg:  "请先验证邮箱",
+		}, nil
 	default:
-		ctx.String(http.StatusOK, "系统错误")
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, err
+	}
+	err = uh.guard.Reset(ctx, guardBizEmail, req.Email)
+	if err != nil {
+		// 清理失败不影响这一次登录
+		zap.L().Error("清理登录失败记录失败", zap.Error(err))
+	}
+	return setLoginTokenOrPreAuth(ctx, uh.Handler, user)
+}
+
+type LoginTwoFactorReq struct {
+	PreAuthToken string `json:"preAuthToken"`
+	// Code 验证器 App 上的验证码，或者恢复码
+	Code    string `json:"code"`
+	Captcha string `json:"captcha"`
+}
+
+// LoginTwoFactor 两步登录的第二步，校验通过之后才真正登录
+func (uh *UserHandler) LoginTwoFactor(ctx *gin.Context, req LoginTwoFactorReq) (ginx.Result, error) {
+	uid, err := uh.CheckPreAuthToken(ctx, req.PreAuthToken)
+	switch err {
+	case nil:
+	case ijwt.ErrPreAuthTokenInvalid:
+		return ginx.Result{
+			Code: errs.UserInvalidToken,
+			Msg:  "登录已经过期，请重新登录",
+		}, nil
+	default:
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, err
+	}
+	// 验证码只有 6 位，同样要防暴力破解
+	account := strconv.FormatInt(uid, 10)
+	res, ok, err := uh.checkGuard(ctx, guardBizTwoFactor, account, req.Captcha)
+	if !ok {
+		return res, err
+	}
+	err = uh.twoFactorService.Verify(ctx, uid, req.Code)
+	switch err {
+	case nil:
+	case service.ErrInvalidTwoFactorCode:
+		return uh.loginFailed(ctx, guardBizTwoFactor, account, ginx.Result{
+			Code: errs.UserInvalidTwoFactorCode,
+			Msg:  "两步验证码不对",
+		})
+	default:
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, err
+	}
+	err = uh.ClearPreAuthToken(ctx, req.PreAuthToken)
+	if err != nil {
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, err
+	}
+	err = uh.guard.Reset(ctx, guardBizTwoFactor, account)
+	if err != nil {
+		zap.L().Error("清理登录失败记录失败", zap.Error(err))
+	}
+	user, err := uh.userService.GetProfile(ctx, uid)
+	if err != nil {
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, err
+	}
+	err = uh.SetLoginToken(ctx, uid, user.Roles)
+	if err != nil {
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, err
+	}
+	return ginx.Result{
+		Msg: "登录成功",
+	}, nil
+}
+
+// setLoginTokenOrPreAuth 不管用什么方式登录，开启了两步验证的用户都只能先拿到 pre-auth token
+func setLoginTokenOrPreAuth(ctx *gin.Context, hdl ijwt.Handler, user domain.User) (ginx.Result, error) {
+	if user.TwoFactor.Enabled {
+		token, err := hdl.SetPreAuthToken(ctx, user.Id)
+		if err != nil {
+			return ginx.Result{
+				Code: errs.UserInternalServerError,
+				Msg:  "系统错误",
+			}, err
+		}
+		return ginx.Result{
+			Msg: "请输入两步验证码",
+			Data: TwoFactorVo{
+				Required:     true,
+				PreAuthToken: token,
+			},
+		}, nil
+	}
+	err := hdl.SetLoginToken(ctx, user.Id, user.Roles)
+	if err != nil {
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, err
+	}
+	return ginx.Result{
+		Msg: "登录成功",
+	}, nil
+}
+
+// checkGuard 登录之前检查账号和 IP 有没有被锁定、需不需要验证码，
+// ok 为 false 的时候直接把 res 返回给前端
+func (uh *UserHandler) checkGuard(ctx *gin.Context, biz string,
+	account string, captcha string) (res ginx.Result, ok bool, err error) {
+	state, err := uh.guard.Check(ctx, biz, account, ctx.ClientIP())
+	if err != nil {
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, false, err
+	}
+	if state.Locked {
+		return uh.lockedResult(state), false, nil
+	}
+	if !state.CaptchaRequired {
+		return ginx.Result{}, true, nil
+	}
+	if captcha == "" {
+		return ginx.Result{
+			Code:            errs.UserCaptchaRequired,
+			Msg:             "请输入验证码",
+			CaptchaRequired: true,
+		}, false, nil
+	}
+	passed, err := uh.captchaService.Verify(ctx, captcha, ctx.ClientIP())
+	if err != nil {
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, false, err
+	}
+	if !passed {
+		return ginx.Result{
+			Code:            errs.UserCaptchaRequired,
+			Msg:             "验证码不对",
+			CaptchaRequired: true,
+		}, false, nil
+	}
+	return ginx.Result{}, true, nil
+}
+
+// loginFailed 记录一次失败，并且告诉前端下一次要不要带验证码
+func (uh *UserHandler) loginFailed(ctx *gin.Context, biz string,
+	account string, res ginx.Result) (ginx.Result, error) {
+	state, err := uh.guard.Fail(ctx, biz, account, ctx.ClientIP())
+	if err != nil {
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, err
+	}
+	if state.Locked {
+		return uh.lockedResult(state), nil
+	}
+	res.CaptchaRequired = state.CaptchaRequired
+	return res, nil
+}
+
+func (uh *UserHandler) lockedResult(state loginguard.State) ginx.Result {
+	// 可能有人在暴力破解，少数可以接受，频繁出现就要关注了
+	zap.L().Warn("登录失败次数太多，已锁定")
+	minutes := int(math.Ceil(state.RetryAfter.Minutes()))
+	return ginx.Result{
+		Code:            errs.UserLocked,
+		Msg:             fmt.Sprintf("失败次数太多，请 %d 分钟之后再试", minutes),
+		CaptchaRequired: state.CaptchaRequired,
 	}
 }
 
@@ -307,27 +549,35 @@ func (uh *UserHandler) Profile(ctx *gin.Context) {
 func (uh *UserHandler) RefreshToken(ctx *gin.Context) {
 	// 假定前端带上了 refresh_toekn
 	tokenStr := uh.ExtractToken(ctx)
-	var rc ijwt.RefreshClaims
-	token, err := jwt.ParseWithClaims(tokenStr, &rc, func(token *jwt.Token) (interface{}, error) {
-		return ijwt.RCJWTKey, nil
-	})
+	rc, err := uh.ParseRefreshToken(tokenStr)
 	// 这边要保持和登录校验一直的逻辑，即返回 401 响应
 	if err != nil {
 		ctx.AbortWithStatus(http.StatusUnauthorized)
 		return
 	}
-	if token == nil || !token.Valid {
+
+	err = uh.CheckSession(ctx, rc.Ssid)
+	if err != nil {
 		ctx.AbortWithStatus(http.StatusUnauthorized)
 		return
 	}
 
-	err = uh.CheckSession(ctx, rc.Ssid)
-	if err != nil {
+	// 角色可能已经被管理员改过了，每次刷新都重新查询。
+	// 所有的校验都要放在轮换之前，轮换之后再失败，前端手里只剩下被换掉的旧 token
+	user, err := uh.userService.GetProfile(ctx, rc.Uid)
+	// 已经注销的用户不能再续期
+	if err != nil || user.Deleted {
 		ctx.AbortWithStatus(http.StatusUnauthorized)
 		return
 	}
 
-	err = uh.SetJWTToken(ctx, rc.Uid, rc.Ssid)
+	// 每次刷新都要换一个新的 refresh token
+	err = uh.RotateRefreshToken(ctx, rc)
+	if err != nil {
+		ctx.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	err = uh.SetJWTToken(ctx, rc.Uid, rc.Ssid, user.Roles)
 	if err != nil {
 		ctx.AbortWithStatus(http.StatusUnauthorized)
 		return
@@ -337,6 +587,542 @@ func (uh *UserHandler) RefreshToken(ctx *gin.Context) {
 	})
 }
 
+// SendEmailVerification 重新发送邮箱验证邮件
+func (uh *UserHandler) SendEmailVerification(ctx *gin.Context, uc ijwt.UserClaims) (ginx.Result, error) {
+	err := uh.userService.SendEmailVerification(ctx, uc.Uid)
+	switch err {
+	case nil:
+		return ginx.Result{
+			Msg: "发送成功",
+		}, nil
+	case service.ErrEmailAlreadyVerified:
+		return ginx.Result{
+			Code: errs.UserInvalidInput,
+			Msg:  "邮箱已经验证过了",
+		}, nil
+	case service.ErrUserTokenSendTooMany:
+		return ginx.Result{
+			Code: errs.UserSendTooMany,
+			Msg:  "邮件发送太频繁，请稍后再试",
+		}, nil
+	default:
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, err
+	}
+}
+
+type VerifyEmailReq struct {
+	Token string `json:"token"`
+}
+
+func (uh *UserHandler) VerifyEmail(ctx *gin.Context, req VerifyEmailReq) (ginx.Result, error) {
+	err := uh.userService.VerifyEmail(ctx, req.Token)
+	switch err {
+	case nil:
+		return ginx.Result{
+			Msg: "验证成功",
+		}, nil
+	case service.ErrInvalidUserToken:
+		return ginx.Result{
+			Code: errs.UserInvalidToken,
+			Msg:  "链接无效或者已经过期",
+		}, nil
+	default:
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, err
+	}
+}
+
+type ForgotPasswordReq struct {
+	Email string `json:"email"`
+}
+
+func (uh *UserHandler) ForgotPassword(ctx *gin.Context, req ForgotPasswordReq) (ginx.Result, error) {
+	isEmail, err := uh.emailRegexExp.MatchString(req.Email)
+	if err != nil {
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, err
+	}
+	if !isEmail {
+		return ginx.Result{
+			Code: errs.UserInvalidInput,
+			Msg:  "邮箱输入错误",
+		}, nil
+	}
+	err = uh.userService.ForgotPassword(ctx, req.Email)
+	switch err {
+	case nil:
+		// 不管邮箱有没有注册过，都返回一样的结果
+		return ginx.Result{
+			Msg: "如果邮箱已经注册，你会收到一封重置密码的邮件",
+		}, nil
+	case service.ErrUserTokenSendTooMany:
+		return ginx.Result{
+			Code: errs.UserSendTooMany,
+			Msg:  "邮件发送太频繁，请稍后再试",
+		}, nil
+	default:
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, err
+	}
+}
+
+type ResetPasswordReq struct {
+	Token           string `json:"token"`
+	Password        string `json:"password"`
+	ConfirmPassword string `json:"confirmPassword"`
+}
+
+func (uh *UserHandler) ResetPassword(ctx *gin.Context, req ResetPasswordReq) (ginx.Result, error) {
+	if req.Password != req.ConfirmPassword {
+		return ginx.Result{
+			Code: errs.UserInvalidInput,
+			Msg:  "两次输入密码不对",
+		}, nil
+	}
+	isPassword, err := uh.passwordRegexExp.MatchString(req.Password)
+	if err != nil {
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, err
+	}
+	if !isPassword {
+		return ginx.Result{
+			Code: errs.UserInvalidInput,
+			Msg:  "密码必须包含数字、特殊字符，并且长度不能小于 8 位",
+		}, nil
+	}
+	uid, err := uh.userService.ResetPassword(ctx, req.Token, req.Password)
+	switch err {
+	case nil:
+	case service.ErrInvalidUserToken:
+		return ginx.Result{
+			Code: errs.UserInvalidToken,
+			Msg:  "链接无效或者已经过期",
+		}, nil
+	default:
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, err
+	}
+	// 密码已经改了，之前所有设备上的登录都要失效
+	err = uh.RevokeOtherSessions(ctx, uid, "")
+	if err != nil {
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, err
+	}
+	return ginx.Result{
+		Msg: "密码重置成功，请重新登录",
+	}, nil
+}
+
+type SendBindPhoneCodeReq struct {
+	Phone string `json:"phone"`
+}
+
+// SendBindPhoneCode 绑定手机号或者用手机号证明账号归属之前，先发验证码
+func (uh *UserHandler) SendBindPhoneCode(ctx *gin.Context, req SendBindPhoneCodeReq, uc ijwt.UserClaims) (ginx.Result, error) {
+	if req.Phone == "" {
+		return ginx.Result{
+			Code: errs.UserInvalidInput,
+			Msg:  "请输入手机号",
+		}, nil
+	}
+	err := uh.codeService.Send(ctx, bizBindPhone, req.Phone)
+	switch err {
+	case nil:
+		return ginx.Result{
+			Msg: "发送成功",
+		}, nil
+	case service.ErrCodeSendTooMany:
+		return ginx.Result{
+			Code: errs.UserSendTooMany,
+			Msg:  "短信发送太频繁，请稍后再试",
+		}, nil
+	default:
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, err
+	}
+}
+
+type BindPhoneReq struct {
+	Phone string `json:"phone"`
+	Code  string `json:"code"`
+}
+
+func (uh *UserHandler) BindPhone(ctx *gin.Context, req BindPhoneReq, uc ijwt.UserClaims) (ginx.Result, error) {
+	res, ok, err := uh.verifyBindPhoneCode(ctx, req.Phone, req.Code)
+	if !ok {
+		return res, err
+	}
+	err = uh.userService.BindPhone(ctx, uc.Uid, req.Phone)
+	return bindResult(err)
+}
+
+type BindEmailReq struct {
+	Email           string `json:"email"`
+	Password        string `json:"password"`
+	ConfirmPassword string `json:"confirmPassword"`
+}
+
+func (uh *UserHandler) BindEmail(ctx *gin.Context, req BindEmailReq, uc ijwt.UserClaims) (ginx.Result, error) {
+	isEmail, err := uh.emailRegexExp.MatchString(req.Email)
+	if err != nil {
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, err
+	}
+	if !isEmail {
+		return ginx.Result{
+			Code: errs.UserInvalidInput,
+			Msg:  "邮箱输入错误",
+		}, nil
+	}
+	if req.Password != req.ConfirmPassword {
+		return ginx.Result{
+			Code: errs.UserInvalidInput,
+			Msg:  "两次输入密码不对",
+		}, nil
+	}
+	isPassword, err := uh.passwordRegexExp.MatchString(req.Password)
+	if err != nil {
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, err
+	}
+	if !isPassword {
+		return ginx.Result{
+			Code: errs.UserInvalidInput,
+			Msg:  "密码必须包含数字、特殊字符，并且长度不能小于 8 位",
+		}, nil
+	}
+	err = uh.userService.BindEmail(ctx, uc.Uid, req.Email, req.Password)
+	return bindResult(err)
+}
+
+type UnbindReq struct {
+	// Type phone、email 或者 wechat
+	Type string `json:"type"`
+}
+
+func (uh *UserHandler) Unbind(ctx *gin.Context, req UnbindReq, uc ijwt.UserClaims) (ginx.Result, error) {
+	typ := domain.IdentityType(req.Type)
+	switch typ {
+	case domain.IdentityPhone, domain.IdentityEmail, domain.IdentityWechat:
+	default:
+		return ginx.Result{
+			Code: errs.UserInvalidInput,
+			Msg:  "未知的登录方式",
+		}, nil
+	}
+	err := uh.userService.Unbind(ctx, uc.Uid, typ)
+	switch err {
+	case nil:
+		return ginx.Result{
+			Msg: "OK",
+		}, nil
+	case service.ErrLastIdentity:
+		return ginx.Result{
+			Code: errs.UserLastIdentity,
+			Msg:  "至少要保留一种登录方式",
+		}, nil
+	default:
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, err
+	}
+}
+
+type MergeReq struct {
+	// Type 用什么方式证明另外一个账号也是自己的，phone 或者 email
+	Type     string `json:"type"`
+	Phone    string `json:"phone"`
+	Code     string `json:"code"`
+	Email    string `json:"email"`
+	Password string `json:"password"`
+	Captcha  string `json:"captcha"`
+}
+
+// Merge 把另外一个账号合并到当前账号上，另外一个账号会被删除
+func (uh *UserHandler) Merge(ctx *gin.Context, req MergeReq, uc ijwt.UserClaims) (ginx.Result, error) {
+	var (
+		source domain.User
+		res    ginx.Result
+		ok     bool
+		err    error
+	)
+	switch domain.IdentityType(req.Type) {
+	case domain.IdentityPhone:
+		res, ok, err = uh.verifyBindPhoneCode(ctx, req.Phone, req.Code)
+		if !ok {
+			return res, err
+		}
+		source, err = uh.userService.FindByPhone(ctx, req.Phone)
+		if err == service.ErrUserNotFound {
+			return ginx.Result{
+				Code: errs.UserInvalidInput,
+				Msg:  "这个手机号没有注册过",
+			}, nil
+		}
+	case domain.IdentityEmail:
+		// 等价于登录一次，同样要防暴力破解
+		res, ok, err = uh.checkGuard(ctx, guardBizEmail, req.Email, req.Captcha)
+		if !ok {
+			return res, err
+		}
+		source, err = uh.userService.Login(ctx, req.Email, req.Password)
+		if err == service.ErrInvalidUserOrPassword {
+			return uh.loginFailed(ctx, guardBizEmail, req.Email, ginx.Result{
+				Code: errs.UserInvalidOrPassword,
+				Msg:  "用户名或者密码不对",
+			})
+		}
+		if err == service.ErrEmailNotVerified {
+			return ginx.Result{
+				Code: errs.UserEmailNotVerified,
+				Msg:  "请先验证邮箱",
+			}, nil
+		}
+	default:
+		return ginx.Result{
+			Code: errs.UserInvalidInput,
+			Msg:  "未知的登录方式",
+		}, nil
+	}
+	if err != nil {
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, err
+	}
+	if source.TwoFactor.Enabled {
+		// 只验证了一个因素，不足以证明账号归属
+		return ginx.Result{
+			Code: errs.UserInvalidInput,
+			Msg:  "请先关闭另外一个账号的两步验证",
+		}, nil
+	}
+	err = uh.userService.Merge(ctx, uc.Uid, source.Id)
+	switch err {
+	case nil:
+	case service.ErrMergeSelf:
+		return ginx.Result{
+			Code: errs.UserInvalidInput,
+			Msg:  "不能和自己合并",
+		}, nil
+	case service.ErrIdentityConflict:
+		return ginx.Result{
+			Code: errs.UserIdentityConflict,
+			Msg:  "两个账号绑定了同一种登录方式，请先解绑",
+		}, nil
+	default:
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, err
+	}
+	// 被合并的账号已经没了，它的登录态也要清掉
+	err = uh.RevokeOtherSessions(ctx, source.Id, "")
+	if err != nil {
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, err
+	}
+	return ginx.Result{
+		Msg: "OK",
+	}, nil
+}
+
+func (uh *UserHandler) verifyBindPhoneCode(ctx *gin.Context, phone string, code string) (ginx.Result, bool, error) {
+	ok, err := uh.codeService.Verify(ctx, bizBindPhone, phone, code)
+	if err != nil {
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, false, err
+	}
+	if !ok {
+		return ginx.Result{
+			Code: errs.UserInvalidInput,
+			Msg:  "验证码不对，请重新输入",
+		}, false, nil
+	}
+	return ginx.Result{}, true, nil
+}
+
+// EnrollTwoFactor 开始绑定验证器 App
+func (uh *UserHandler) EnrollTwoFactor(ctx *gin.Context, uc ijwt.UserClaims) (ginx.Result, error) {
+	secret, uri, err := uh.twoFactorService.Enroll(ctx, uc.Uid)
+	switch err {
+	case nil:
+		return ginx.Result{
+			Data: TwoFactorEnrollVo{
+				Secret: secret,
+				URI:    uri,
+			},
+		}, nil
+	case service.ErrTwoFactorAlreadyEnabled:
+		return ginx.Result{
+			Code: errs.UserInvalidInput,
+			Msg:  "已经开启了两步验证",
+		}, nil
+	default:
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, err
+	}
+}
+
+type TwoFactorCodeReq struct {
+	Code string `json:"code"`
+}
+
+// ConfirmTwoFactor 输入验证器 App 上的验证码完成绑定，返回恢复码
+func (uh *UserHandler) ConfirmTwoFactor(ctx *gin.Context, req TwoFactorCodeReq, uc ijwt.UserClaims) (ginx.Result, error) {
+	codes, err := uh.twoFactorService.Confirm(ctx, uc.Uid, req.Code)
+	switch err {
+	case nil:
+		return ginx.Result{
+			Msg:  "请妥善保存恢复码，它们只会显示这一次",
+			Data: codes,
+		}, nil
+	case service.ErrInvalidTwoFactorCode:
+		return ginx.Result{
+			Code: errs.UserInvalidTwoFactorCode,
+			Msg:  "两步验证码不对",
+		}, nil
+	case service.ErrTwoFactorAlreadyEnabled, service.ErrTwoFactorNotEnrolled:
+		return ginx.Result{
+			Code: errs.UserInvalidInput,
+			Msg:  err.Error(),
+		}, nil
+	default:
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, err
+	}
+}
+
+// DisableTwoFactor 关闭两步验证
+func (uh *UserHandler) DisableTwoFactor(ctx *gin.Context, req TwoFactorCodeReq, uc ijwt.UserClaims) (ginx.Result, error) {
+	err := uh.twoFactorService.Disable(ctx, uc.Uid, req.Code)
+	switch err {
+	case nil:
+		return ginx.Result{
+			Msg: "OK",
+		}, nil
+	case service.ErrInvalidTwoFactorCode:
+		return ginx.Result{
+			Code: errs.UserInvalidTwoFactorCode,
+			Msg:  "两步验证码不对",
+		}, nil
+	case service.ErrTwoFactorNotEnabled:
+		return ginx.Result{
+			Code: errs.UserInvalidInput,
+			Msg:  "没有开启两步验证",
+		}, nil
+	default:
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, err
+	}
+}
+
+// Sessions 列出当前用户所有登录的设备
+func (uh *UserHandler) Sessions(ctx *gin.Context, uc ijwt.UserClaims) (ginx.Result, error) {
+	sessions, err := uh.ListSessions(ctx, uc.Uid)
+	if err != nil {
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, err
+	}
+	return ginx.Result{
+		Data: slice.Map(sessions, func(idx int, src ijwt.Session) SessionVo {
+			return SessionVo{
+				Ssid:       src.Ssid,
+				UserAgent:  src.UserAgent,
+				IP:         src.IP,
+				Location:   src.Location,
+				Ctime:      src.Ctime.Format(time.DateTime),
+				LastActive: src.LastActive.Format(time.DateTime),
+				Current:    src.Ssid == uc.Ssid,
+			}
+		}),
+	}, nil
+}
+
+type RevokeSessionReq struct {
+	Ssid string `json:"ssid"`
+}
+
+// LogoutSession 踢掉某一台设备上的登录
+func (uh *UserHandler) LogoutSession(ctx *gin.Context, req RevokeSessionReq, uc ijwt.UserClaims) (ginx.Result, error) {
+	if req.Ssid == "" {
+		return ginx.Result{
+			Code: errs.UserInvalidInput,
+			Msg:  "请指定要退出的设备",
+		}, nil
+	}
+	err := uh.RevokeSession(ctx, uc.Uid, req.Ssid)
+	switch err {
+	case nil:
+		if req.Ssid == uc.Ssid {
+			// 踢掉的是自己，相当于退出登录
+			ctx.Header("x-jwt-token", "")
+			ctx.Header("x-refresh-token", "")
+		}
+		return ginx.Result{
+			Msg: "OK",
+		}, nil
+	case ijwt.ErrSessionNotFound:
+		return ginx.Result{
+			Code: errs.UserInvalidInput,
+			Msg:  "设备不存在或已退出",
+		}, nil
+	default:
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, err
+	}
+}
+
+// LogoutOtherSessions 只保留当前设备的登录
+func (uh *UserHandler) LogoutOtherSessions(ctx *gin.Context, uc ijwt.UserClaims) (ginx.Result, error) {
+	err := uh.RevokeOtherSessions(ctx, uc.Uid, uc.Ssid)
+	if err != nil {
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, err
+	}
+	return ginx.Result{
+		Msg: "OK",
+	}, nil
+}
+
 func (uh *UserHandler) checkIfBirthdayIsValid(birthday string) (bool, error) {
 	// 以特定格式解析日期
 	parsedDate, err := time.Parse(time.DateOnly, birthday)
@@ -361,3 +1147,51 @@ type UserInfo struct {
 	Birthday string
 	AboutMe  string
 }
+
+type SessionVo struct {
+	Ssid       string `json:"ssid"`
+	UserAgent  string `json:"userAgent"`
+	IP         string `json:"ip"`
+	Location   string `json:"location"`
+	Ctime      string `json:"ctime"`
+	LastActive string `json:"lastActive"`
+	// Current 是否是发起请求的这台设备
+	Current bool `json:"current"`
+}
+
+type TwoFactorVo struct {
+	// Required 为 true 说明还要再调用 /users/login/2fa
+	Required     bool   `json:"required"`
+	PreAuthToken string `json:"preAuthToken"`
+}
+
+type TwoFactorEnrollVo struct {
+	Secret string `json:"secret"`
+	// URI otpauth:// 链接，前端转成二维码
+	URI string `json:"uri"`
+}
+
+// bindResult 绑定手机号、邮箱、微信的结果都是一样的处理
+func bindResult(err error) (ginx.Result, error) {
+	switch err {
+	case nil:
+		return ginx.Result{
+			Msg: "绑定成功",
+		}, nil
+	case service.ErrIdentityConflict:
+		return ginx.Result{
+			Code: errs.UserIdentityConflict,
+			Msg:  "已经绑定了别的账号，可以尝试合并账号",
+		}, nil
+	case service.ErrIdentityAlreadyBound:
+		return ginx.Result{
+			Code: errs.UserInvalidInput,
+			Msg:  "已经绑定过了，请先解绑",
+		}, nil
+	default:
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, err
+	}
+}
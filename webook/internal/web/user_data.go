@@ -0,0 +1,233 @@
+package web
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+	"webook/internal/errs"
+	"webook/internal/service"
+	ijwt "webook/internal/web/jwt"
+	"webook/internal/web/loginguard"
+	"webook/pkg/ginx"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// UserDataHandler 导出个人数据和注销账号
+type UserDataHandler struct {
+	ijwt.Handler
+	svc service.UserDataService
+	// guard 注销之前要校验密码，同样要防暴力破解
+	guard loginguard.Guard
+}
+
+func NewUserDataHandler(svc service.UserDataService, hdl ijwt.Handler,
+	guard loginguard.Guard) *UserDataHandler {
+	return &UserDataHandler{
+		Handler: hdl,
+		svc:     svc,
+		guard:   guard,
+	}
+}
+
+// guardBizDeletion 注销账号时的身份校验，按照用户 ID 统计失败次数
+const guardBizDeletion = "user_deletion"
+
+func (h *UserDataHandler) RegisterRoutes(server *gin.Engine) {
+	ug := server.Group("/users")
+	ug.POST("/export", ginx.WrapClaims(h.RequestExport))
+	ug.GET("/export", ginx.WrapClaims(h.ExportStatus))
+	ug.GET("/export/download", h.Download)
+	ug.POST("/delete", ginx.WrapClaimsAndReq[UserDeletionReq](h.RequestDeletion))
+	ug.POST("/delete/cancel", ginx.WrapClaims(h.CancelDeletion))
+}
+
+func (h *UserDataHandler) RequestExport(ctx *gin.Context, uc ijwt.UserClaims) (ginx.Result, error) {
+	err := h.svc.RequestExport(ctx, uc.Uid)
+	switch err {
+	case nil:
+		return ginx.Result{
+			Msg: "正在导出，完成之后可以下载",
+		}, nil
+	case service.ErrUserExportInProgress:
+		return ginx.Result{
+			Code: errs.UserExportInProgress,
+			Msg:  "正在导出，请稍后再试",
+		}, nil
+	default:
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, err
+	}
+}
+
+func (h *UserDataHandler) ExportStatus(ctx *gin.Context, uc ijwt.UserClaims) (ginx.Result, error) {
+	export, err := h.svc.GetExport(ctx, uc.Uid)
+	if err != nil {
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, err
+	}
+	vo := UserExportVo{
+		Status: export.Status.ToUint8(),
+	}
+	if !export.Ctime.IsZero() {
+		vo.Ctime = export.Ctime.Format(time.DateTime)
+		vo.Utime = export.Utime.Format(time.DateTime)
+	}
+	return ginx.Result{
+		Data: vo,
+	}, nil
+}
+
+// Download 直接返回压缩包，所以不能用 ginx.WrapClaims
+func (h *UserDataHandler) Download(ctx *gin.Context) {
+	uc := ctx.MustGet("user").(ijwt.UserClaims)
+	export, r, err := h.svc.OpenExport(ctx, uc.Uid)
+	if err == service.ErrUserExportNotReady {
+		ctx.JSON(http.StatusOK, ginx.Result{
+			Code: errs.UserExportNotReady,
+			Msg:  service.ErrUserExportNotReady.Error(),
+		})
+		return
+	}
+	if err != nil {
+		zap.L().Error("读取导出数据失败", zap.Int64("uid", uc.Uid), zap.Error(err))
+		ctx.JSON(http.StatusOK, ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		})
+		return
+	}
+	defer r.Close()
+	ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="webook-%d-%s.zip"`,
+		uc.Uid, export.Utime.Format("20060102")))
+	ctx.Header("Content-Type", "application/zip")
+	ctx.Header("Cache-Control", "no-store")
+	ctx.Status(http.StatusOK)
+	_, err = io.Copy(ctx.Writer, r)
+	if err != nil {
+		zap.L().Warn("返回导出数据失败", zap.Int64("uid", uc.Uid), zap.Error(err))
+	}
+}
+
+type UserDeletionReq struct {
+	// Password 设置了密码的账号必填
+	Password string `json:"password"`
+	// Code 开启了两步验证的账号必填，也可以用恢复码
+	Code string `json:"code"`
+}
+
+func (h *UserDataHandler) RequestDeletion(ctx *gin.Context, req UserDeletionReq, uc ijwt.UserClaims) (ginx.Result, error) {
+	account := strconv.FormatInt(uc.Uid, 10)
+	state, err := h.guard.Check(ctx, guardBizDeletion, account, ctx.ClientIP())
+	if err != nil {
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, err
+	}
+	if state.Locked {
+		return h.lockedResult(state), nil
+	}
+	deleteAt, err := h.svc.RequestDeletion(ctx, uc.Uid, req.Password, req.Code)
+	switch err {
+	case nil:
+	case service.ErrUserAlreadyDeleted:
+		return ginx.Result{
+			Code: errs.UserInvalidInput,
+			Msg:  "用户已经注销",
+		}, nil
+	case service.ErrUserReauthUnavailable:
+		return ginx.Result{
+			Code: errs.UserInvalidInput,
+			Msg:  "请先设置密码或者开启两步验证",
+		}, nil
+	case service.ErrInvalidUserOrPassword:
+		return h.reauthFailed(ctx, account, ginx.Result{
+			Code: errs.UserInvalidOrPassword,
+			Msg:  "密码不对",
+		})
+	case service.ErrInvalidTwoFactorCode:
+		return h.reauthFailed(ctx, account, ginx.Result{
+			Code: errs.UserInvalidTwoFactorCode,
+			Msg:  "验证码不对",
+		})
+	default:
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, err
+	}
+	err = h.guard.Reset(ctx, guardBizDeletion, account)
+	if err != nil {
+		// 不影响注销本身
+		zap.L().Warn("清理失败记录失败", zap.Int64("uid", uc.Uid), zap.Error(err))
+	}
+	// 只保留当前设备，冷静期内还可以登录撤销注销
+	err = h.RevokeOtherSessions(ctx, uc.Uid, uc.Ssid)
+	if err != nil {
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, err
+	}
+	return ginx.Result{
+		Msg: "申请注销成功",
+		Data: UserDeletionVo{
+			DeleteAt: deleteAt.Format(time.DateTime),
+		},
+	}, nil
+}
+
+func (h *UserDataHandler) reauthFailed(ctx *gin.Context, account string, res ginx.Result) (ginx.Result, error) {
+	state, err := h.guard.Fail(ctx, guardBizDeletion, account, ctx.ClientIP())
+	if err != nil {
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, err
+	}
+	if state.Locked {
+		return h.lockedResult(state), nil
+	}
+	return res, nil
+}
+
+func (h *UserDataHandler) lockedResult(state loginguard.State) ginx.Result {
+	minutes := int(math.Ceil(state.RetryAfter.Minutes()))
+	return ginx.Result{
+		Code: errs.UserLocked,
+		Msg:  fmt.Sprintf("失败次数太多，请 %d 分钟之后再试", minutes),
+	}
+}
+
+func (h *UserDataHandler) CancelDeletion(ctx *gin.Context, uc ijwt.UserClaims) (ginx.Result, error) {
+	err := h.svc.CancelDeletion(ctx, uc.Uid)
+	if err != nil {
+		return ginx.Result{
+			Code: errs.UserInternalServerError,
+			Msg:  "系统错误",
+		}, err
+	}
+	return ginx.Result{
+		Msg: "已撤销注销",
+	}, nil
+}
+
+type UserExportVo struct {
+	// Status 0-没有导出 1-导出中 2-可以下载 3-导出失败
+	Status uint8  `json:"status"`
+	Ctime  string `json:"ctime,omitempty"`
+	Utime  string `json:"utime,omitempty"`
+}
+
+type UserDeletionVo struct {
+	DeleteAt string `json:"deleteAt"`
+}
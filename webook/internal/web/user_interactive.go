@@ -0,0 +1,141 @@
+package web
+
+import (
+	"fmt"
+	"time"
+	intrv1 "webook/api/proto/gen/intr/v1"
+	"webook/internal/service"
+	"webook/internal/web/jwt"
+	"webook/pkg/ginx"
+	"webook/pkg/logger"
+
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/gin-gonic/gin"
+)
+
+// UserInteractiveHandler 个人主页上的我的点赞和我的收藏
+type UserInteractiveHandler struct {
+	is intrv1.InteractiveServiceClient
+	as service.ArticleService
+	l  logger.LoggerV1
+}
+
+func NewUserInteractiveHandler(is intrv1.InteractiveServiceClient,
+	as service.ArticleService, l logger.LoggerV1) *UserInteractiveHandler {
+	return &UserInteractiveHandler{
+		is: is,
+		as: as,
+		l:  l,
+	}
+}
+
+func (h *UserInteractiveHandler) RegisterRoutes(server *gin.Engine) {
+	ug := server.Group("/users/profile")
+	ug.POST("/likes", ginx.WrapClaimsAndReq[UserInteractiveReq](h.Likes))
+	ug.POST("/collections", ginx.WrapClaimsAndReq[UserInteractiveReq](h.Collections))
+}
+
+type UserInteractiveReq struct {
+	Offset int `json:"offset"`
+	Limit  int `json:"limit"`
+}
+
+// UserInteractiveVo Title 只有文章才有，文章撤回了也是空的
+type UserInteractiveVo struct {
+	Biz   string `json:"biz"`
+	BizId int64  `json:"bizId"`
+	Title string `json:"title"`
+	// Cid 收藏夹 ID，只有收藏才有
+	Cid   int64  `json:"cid,omitempty"`
+	Ctime string `json:"ctime"`
+}
+
+func (h *UserInteractiveHandler) Likes(ctx *gin.Context, req UserInteractiveReq, uc jwt.UserClaims) (ginx.Result, error) {
+	if req.Offset < 0 || req.Limit <= 0 || req.Limit > 100 {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "分页参数错误",
+		}, nil
+	}
+	resp, err := h.is.ListUserLikes(ctx, &intrv1.ListUserLikesRequest{
+		Uid:    uc.Uid,
+		Offset: int32(req.Offset),
+		Limit:  int32(req.Limit),
+	})
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, fmt.Errorf("查询 %d 的点赞失败 %w", uc.Uid, err)
+	}
+	res := slice.Map(resp.GetLikes(), func(idx int, src *intrv1.UserLike) UserInteractiveVo {
+		return UserInteractiveVo{
+			Biz:   src.GetBiz(),
+			BizId: src.GetBizId(),
+			Ctime: time.UnixMilli(src.GetCtime()).Format(time.DateTime),
+		}
+	})
+	h.fillTitles(ctx, res)
+	return ginx.Result{
+		Data: res,
+	}, nil
+}
+
+func (h *UserInteractiveHandler) Collections(ctx *gin.Context, req UserInteractiveReq, uc jwt.UserClaims) (ginx.Result, error) {
+	if req.Offset < 0 || req.Limit <= 0 || req.Limit > 100 {
+		return ginx.Result{
+			Code: 4,
+			Msg:  "分页参数错误",
+		}, nil
+	}
+	resp, err := h.is.ListUserCollections(ctx, &intrv1.ListUserCollectionsRequest{
+		Uid:    uc.Uid,
+		Offset: int32(req.Offset),
+		Limit:  int32(req.Limit),
+	})
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "系统错误",
+		}, fmt.Errorf("查询 %d 的收藏失败 %w", uc.Uid, err)
+	}
+	res := slice.Map(resp.GetCollections(), func(idx int, src *intrv1.UserCollection) UserInteractiveVo {
+		return UserInteractiveVo{
+			Biz:   src.GetBiz(),
+			BizId: src.GetBizId(),
+			Cid:   src.GetCid(),
+			Ctime: time.UnixMilli(src.GetCtime()).Format(time.DateTime),
+		}
+	})
+	h.fillTitles(ctx, res)
+	return ginx.Result{
+		Data: res,
+	}, nil
+}
+
+// fillTitles 标题只是展示用的，查不到也不影响列表本身
+func (h *UserInteractiveHandler) fillTitles(ctx *gin.Context, vos []UserInteractiveVo) {
+	var ids []int64
+	for _, vo := range vos {
+		if vo.Biz == "article" {
+			ids = append(ids, vo.BizId)
+		}
+	}
+	if len(ids) == 0 {
+		return
+	}
+	arts, err := h.as.GetPubByIds(ctx, ids)
+	if err != nil {
+		h.l.Error("查询文章标题失败", logger.Error(err))
+		return
+	}
+	titles := make(map[int64]string, len(arts))
+	for _, art := range arts {
+		titles[art.Id] = art.Title
+	}
+	for i := range vos {
+		if vos[i].Biz == "article" {
+			vos[i].Title = titles[vos[i].BizId]
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package web
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	intrdomain "webook/interactive/domain"
+	intrsvc "webook/interactive/service"
+	intrsvcmocks "webook/interactive/service/mocks"
+	"webook/internal/client"
+	"webook/internal/domain"
+	"webook/internal/service"
+	svcmocks "webook/internal/service/mocks"
+	ijwt "webook/internal/web/jwt"
+	"webook/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUserInteractiveHandler(t *testing.T) {
+	initBizCodeCounter()
+	ctime := time.UnixMilli(1709251200000)
+	wantCtime := ctime.Format(time.DateTime)
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (intrsvc.InteractiveService, service.ArticleService)
+		path string
+		body string
+
+		wantCode int
+		wantMsg  string
+		wantData []UserInteractiveVo
+	}{
+		{
+			name: "我的点赞，带上文章标题",
+			mock: func(ctrl *gomock.Controller) (intrsvc.InteractiveService, service.ArticleService) {
+				is := intrsvcmocks.NewMockInteractiveService(ctrl)
+				is.EXPECT().ListUserLikes(gomock.Any(), int64(123), 0, 10).
+					Return([]intrdomain.UserLike{
+						{Biz: "article", BizId: 1, Ctime: ctime},
+						{Biz: "article", BizId: 2, Ctime: ctime},
+					}, nil)
+				as := svcmocks.NewMockArticleService(ctrl)
+				// 2 已经撤回了，查不到
+				as.EXPECT().GetPubByIds(gomock.Any(), []int64{1, 2}).
+					Return([]domain.Article{{Id: 1, Title: "我的标题"}}, nil)
+				return is, as
+			},
+			path: "/users/profile/likes",
+			body: `{"offset":0,"limit":10}`,
+			wantData: []UserInteractiveVo{
+				{Biz: "article", BizId: 1, Title: "我的标题", Ctime: wantCtime},
+				{Biz: "article", BizId: 2, Ctime: wantCtime},
+			},
+		},
+		{
+			name: "查询标题失败，照样返回点赞",
+			mock: func(ctrl *gomock.Controller) (intrsvc.InteractiveService, service.ArticleService) {
+				is := intrsvcmocks.NewMockInteractiveService(ctrl)
+				is.EXPECT().ListUserLikes(gomock.Any(), int64(123), 0, 10).
+					Return([]intrdomain.UserLike{
+						{Biz: "article", BizId: 1, Ctime: ctime},
+					}, nil)
+				as := svcmocks.NewMockArticleService(ctrl)
+				as.EXPECT().GetPubByIds(gomock.Any(), []int64{1}).
+					Return(nil, errors.New("mock db 错误"))
+				return is, as
+			},
+			path: "/users/profile/likes",
+			body: `{"offset":0,"limit":10}`,
+			wantData: []UserInteractiveVo{
+				{Biz: "article", BizId: 1, Ctime: wantCtime},
+			},
+		},
+		{
+			name: "查询点赞失败",
+			mock: func(ctrl *gomock.Controller) (intrsvc.InteractiveService, service.ArticleService) {
+				is := intrsvcmocks.NewMockInteractiveService(ctrl)
+				is.EXPECT().ListUserLikes(gomock.Any(), int64(123), 0, 10).
+					Return(nil, errors.New("mock db 错误"))
+				return is, svcmocks.NewMockArticleService(ctrl)
+			},
+			path:     "/users/profile/likes",
+			body:     `{"offset":0,"limit":10}`,
+			wantCode: 5,
+			wantMsg:  "系统错误",
+		},
+		{
+			name: "点赞分页参数错误",
+			mock: func(ctrl *gomock.Controller) (intrsvc.InteractiveService, service.ArticleService) {
+				return intrsvcmocks.NewMockInteractiveService(ctrl), svcmocks.NewMockArticleService(ctrl)
+			},
+			path:     "/users/profile/likes",
+			body:     `{"offset":0,"limit":0}`,
+			wantCode: 4,
+			wantMsg:  "分页参数错误",
+		},
+		{
+			name: "我的收藏，带上收藏夹",
+			mock: func(ctrl *gomock.Controller) (intrsvc.InteractiveService, service.ArticleService) {
+				is := intrsvcmocks.NewMockInteractiveService(ctrl)
+				is.EXPECT().ListUserCollections(gomock.Any(), int64(123), 20, 10).
+					Return([]intrdomain.UserCollection{
+						{Biz: "article", BizId: 1, Cid: 3, Ctime: ctime},
+					}, nil)
+				as := svcmocks.NewMockArticleService(ctrl)
+				as.EXPECT().GetPubByIds(gomock.Any(), []int64{1}).
+					Return([]domain.Article{{Id: 1, Title: "我的标题"}}, nil)
+				return is, as
+			},
+			path: "/users/profile/collections",
+			body: `{"offset":20,"limit":10}`,
+			wantData: []UserInteractiveVo{
+				{Biz: "article", BizId: 1, Title: "我的标题", Cid: 3, Ctime: wantCtime},
+			},
+		},
+		{
+			name: "收藏分页参数错误",
+			mock: func(ctrl *gomock.Controller) (intrsvc.InteractiveService, service.ArticleService) {
+				return intrsvcmocks.NewMockInteractiveService(ctrl), svcmocks.NewMockArticleService(ctrl)
+			},
+			path:     "/users/profile/collections",
+			body:     `{"offset":-1,"limit":10}`,
+			wantCode: 4,
+			wantMsg:  "分页参数错误",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			is, as := tc.mock(ctrl)
+			hdl := NewUserInteractiveHandler(client.NewLocalInteractiveServiceAdapter(is, nil),
+				as, logger.NewNopLogger())
+			server := gin.Default()
+			server.Use(func(ctx *gin.Context) {
+				ctx.Set("user", ijwt.UserClaims{
+					Uid: 123,
+				})
+			})
+			hdl.RegisterRoutes(server)
+
+			req, err := http.NewRequest(http.MethodPost, tc.path, bytes.NewBufferString(tc.body))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			recorder := httptest.NewRecorder()
+			server.ServeHTTP(recorder, req)
+			require.Equal(t, http.StatusOK, recorder.Code)
+
+			var res struct {
+				Code int                 `json:"code"`
+				Msg  string              `json:"msg"`
+				Data []UserInteractiveVo `json:"data"`
+			}
+			err = json.NewDecoder(recorder.Body).Decode(&res)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantCode, res.Code)
+			assert.Equal(t, tc.wantMsg, res.Msg)
+			assert.Equal(t, tc.wantData, res.Data)
+		})
+	}
+}
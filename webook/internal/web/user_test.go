@@ -2,13 +2,21 @@ package web
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 	"webook/internal/domain"
+	"webook/internal/errs"
 	"webook/internal/service"
 	svcmocks "webook/internal/service/mocks"
+	ijwt "webook/internal/web/jwt"
+	jwtmocks "webook/internal/web/jwt/mocks"
+	"webook/internal/web/loginguard"
+	guardmocks "webook/internal/web/loginguard/mocks"
+	"webook/pkg/ginx"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -196,7 +204,7 @@ func TestUserHandler_SignUp(t *testing.T) {
 
 			// 构造 handler
 			userSvc, codeSvc := tc.mock(ctrl)
-			hdl := NewUserHandler(userSvc, codeSvc, nil)
+			hdl := NewUserHandler(userSvc, codeSvc, nil, nil, nil, nil)
 
 			// 准备服务器，注册路由
 			server := gin.Default()
@@ -216,6 +224,115 @@ func TestUserHandler_SignUp(t *testing.T) {
 	}
 }
 
+func TestUserHandler_Login(t *testing.T) {
+	initBizCodeCounter()
+	testCases := []struct {
+		name string
+
+		mock func(ctrl *gomock.Controller) (service.UserService, loginguard.Guard)
+
+		body string
+
+		wantResult ginx.Result
+	}{
+		{
+			name: "账号被锁定",
+			mock: func(ctrl *gomock.Controller) (service.UserService, loginguard.Guard) {
+				userSvc := svcmocks.NewMockUserService(ctrl)
+				guard := guardmocks.NewMockGuard(ctrl)
+				guard.EXPECT().Check(gomock.Any(), guardBizEmail, "123@qq.com", gomock.Any()).
+					Return(loginguard.State{Locked: true, RetryAfter: 90 * time.Second}, nil)
+				return userSvc, guard
+			},
+			body: `{"email": "123@qq.com", "password": "hello#world123"}`,
+			wantResult: ginx.Result{
+				Code: errs.UserLocked,
+				Msg:  "失败次数太多，请 2 分钟之后再试",
+			},
+		},
+		{
+			name: "需要验证码但是没有带",
+			mock: func(ctrl *gomock.Controller) (service.UserService, loginguard.Guard) {
+				userSvc := svcmocks.NewMockUserService(ctrl)
+				guard := guardmocks.NewMockGuard(ctrl)
+				guard.EXPECT().Check(gomock.Any(), guardBizEmail, "123@qq.com", gomock.Any()).
+					Return(loginguard.State{CaptchaRequired: true}, nil)
+				return userSvc, guard
+			},
+			body: `{"email": "123@qq.com", "password": "hello#world123"}`,
+			wantResult: ginx.Result{
+				Code:            errs.UserCaptchaRequired,
+				Msg:             "请输入验证码",
+				CaptchaRequired: true,
+			},
+		},
+		{
+			name: "密码错误，之后需要验证码",
+			mock: func(ctrl *gomock.Controller) (service.UserService, loginguard.Guard) {
+				userSvc := svcmocks.NewMockUserService(ctrl)
+				userSvc.EXPECT().Login(gomock.Any(), "123@qq.com", "hello#world123").
+					Return(domain.User{}, service.ErrInvalidUserOrPassword)
+				guard := guardmocks.NewMockGuard(ctrl)
+				guard.EXPECT().Check(gomock.Any(), guardBizEmail, "123@qq.com", gomock.Any()).
+					Return(loginguard.State{}, nil)
+				guard.EXPECT().Fail(gomock.Any(), guardBizEmail, "123@qq.com", gomock.Any()).
+					Return(loginguard.State{CaptchaRequired: true}, nil)
+				return userSvc, guard
+			},
+			body: `{"email": "123@qq.com", "password": "hello#world123"}`,
+			wantResult: ginx.Result{
+				Code:            errs.UserInvalidOrPassword,
+				Msg:             "用户名或者密码不对",
+				CaptchaRequired: true,
+			},
+		},
+		{
+			name: "密码错误次数太多被锁定",
+			mock: func(ctrl *gomock.Controller) (service.UserService, loginguard.Guard) {
+				userSvc := svcmocks.NewMockUserService(ctrl)
+				userSvc.EXPECT().Login(gomock.Any(), "123@qq.com", "hello#world123").
+					Return(domain.User{}, service.ErrInvalidUserOrPassword)
+				guard := guardmocks.NewMockGuard(ctrl)
+				guard.EXPECT().Check(gomock.Any(), guardBizEmail, "123@qq.com", gomock.Any()).
+					Return(loginguard.State{}, nil)
+				guard.EXPECT().Fail(gomock.Any(), guardBizEmail, "123@qq.com", gomock.Any()).
+					Return(loginguard.State{Locked: true, RetryAfter: time.Minute}, nil)
+				return userSvc, guard
+			},
+			body: `{"email": "123@qq.com", "password": "hello#world123"}`,
+			wantResult: ginx.Result{
+				Code: errs.UserLocked,
+				Msg:  "失败次数太多，请 1 分钟之后再试",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userSvc, guard := tc.mock(ctrl)
+			hdl := NewUserHandler(userSvc, nil, nil, nil, guard, nil)
+			server := gin.Default()
+			hdl.RegisterRoutes(server)
+
+			req, err := http.NewRequest(http.MethodPost, "/users/login",
+				bytes.NewReader([]byte(tc.body)))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			recorder := httptest.NewRecorder()
+			server.ServeHTTP(recorder, req)
+
+			assert.Equal(t, http.StatusOK, recorder.Code)
+			var res ginx.Result
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantResult, res)
+		})
+	}
+}
+
 // TestEmailPattern 用来验证我们的邮箱正则表达式对不对
 func TestEmailPattern(t *testing.T) {
 	testCases := []struct {
@@ -240,7 +357,7 @@ func TestEmailPattern(t *testing.T) {
 		},
 	}
 
-	h := NewUserHandler(nil, nil, nil)
+	h := NewUserHandler(nil, nil, nil, nil, nil, nil)
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -279,7 +396,7 @@ func TestPasswordPattern(t *testing.T) {
 		},
 	}
 
-	h := NewUserHandler(nil, nil, nil)
+	h := NewUserHandler(nil, nil, nil, nil, nil, nil)
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -289,3 +406,93 @@ func TestPasswordPattern(t *testing.T) {
 		})
 	}
 }
+
+func TestUserHandler_RefreshToken(t *testing.T) {
+	rc := ijwt.RefreshClaims{Uid: 123, Ssid: "ssid-1"}
+	testCases := []struct {
+		name string
+		mock func(ctrl *gomock.Controller) (service.UserService, ijwt.Handler)
+
+		wantCode int
+	}{
+		{
+			name: "刷新成功，带上最新的角色",
+			mock: func(ctrl *gomock.Controller) (service.UserService, ijwt.Handler) {
+				userSvc := svcmocks.NewMockUserService(ctrl)
+				userSvc.EXPECT().GetProfile(gomock.Any(), int64(123)).
+					Return(domain.User{Id: 123, Roles: []string{"admin"}}, nil)
+				hdl := jwtmocks.NewMockHandler(ctrl)
+				hdl.EXPECT().ExtractToken(gomock.Any()).Return("refresh-token")
+				hdl.EXPECT().ParseRefreshToken("refresh-token").Return(rc, nil)
+				hdl.EXPECT().CheckSession(gomock.Any(), "ssid-1").Return(nil)
+				hdl.EXPECT().RotateRefreshToken(gomock.Any(), rc).Return(nil)
+				hdl.EXPECT().SetJWTToken(gomock.Any(), int64(123), "ssid-1", []string{"admin"}).
+					Return(nil)
+				return userSvc, hdl
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name: "查询用户失败，不轮换 refresh token",
+			mock: func(ctrl *gomock.Controller) (service.UserService, ijwt.Handler) {
+				userSvc := svcmocks.NewMockUserService(ctrl)
+				userSvc.EXPECT().GetProfile(gomock.Any(), int64(123)).
+					Return(domain.User{}, errors.New("mock db 错误"))
+				hdl := jwtmocks.NewMockHandler(ctrl)
+				hdl.EXPECT().ExtractToken(gomock.Any()).Return("refresh-token")
+				hdl.EXPECT().ParseRefreshToken("refresh-token").Return(rc, nil)
+				hdl.EXPECT().CheckSession(gomock.Any(), "ssid-1").Return(nil)
+				return userSvc, hdl
+			},
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name: "已经注销的用户，不轮换 refresh token",
+			mock: func(ctrl *gomock.Controller) (service.UserService, ijwt.Handler) {
+				userSvc := svcmocks.NewMockUserService(ctrl)
+				userSvc.EXPECT().GetProfile(gomock.Any(), int64(123)).
+					Return(domain.User{Id: 123, Deleted: true}, nil)
+				hdl := jwtmocks.NewMockHandler(ctrl)
+				hdl.EXPECT().ExtractToken(gomock.Any()).Return("refresh-token")
+				hdl.EXPECT().ParseRefreshToken("refresh-token").Return(rc, nil)
+				hdl.EXPECT().CheckSession(gomock.Any(), "ssid-1").Return(nil)
+				return userSvc, hdl
+			},
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name: "refresh token 被重复使用",
+			mock: func(ctrl *gomock.Controller) (service.UserService, ijwt.Handler) {
+				userSvc := svcmocks.NewMockUserService(ctrl)
+				userSvc.EXPECT().GetProfile(gomock.Any(), int64(123)).
+					Return(domain.User{Id: 123}, nil)
+				hdl := jwtmocks.NewMockHandler(ctrl)
+				hdl.EXPECT().ExtractToken(gomock.Any()).Return("refresh-token")
+				hdl.EXPECT().ParseRefreshToken("refresh-token").Return(rc, nil)
+				hdl.EXPECT().CheckSession(gomock.Any(), "ssid-1").Return(nil)
+				hdl.EXPECT().RotateRefreshToken(gomock.Any(), rc).
+					Return(ijwt.ErrRefreshTokenReused)
+				return userSvc, hdl
+			},
+			wantCode: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			userSvc, jwtHdl := tc.mock(ctrl)
+			hdl := NewUserHandler(userSvc, nil, nil, nil, nil, jwtHdl)
+			server := gin.Default()
+			hdl.RegisterRoutes(server)
+
+			req, err := http.NewRequest(http.MethodGet, "/users/refresh_token", nil)
+			require.NoError(t, err)
+			recorder := httptest.NewRecorder()
+			server.ServeHTTP(recorder, req)
+			assert.Equal(t, tc.wantCode, recorder.Code)
+		})
+	}
+}
@@ -1,7 +1,6 @@
 package web
 
 import (
-	"fmt"
 	"net/http"
 	"webook/internal/service"
 	"webook/internal/service/oauth2/wechat"
@@ -9,33 +8,33 @@ import (
 	"webook/pkg/ginx"
 
 	"github.com/gin-gonic/gin"
-	"github.com/golang-jwt/jwt/v5"
 	uuid "github.com/lithammer/shortuuid/v4"
+	"go.uber.org/zap"
 )
 
 type OAuth2WechatHandler struct {
 	ijwt.Handler
-	wechatSvc       wechat.Service
-	userSvc         service.UserService
-	key             []byte
-	stateCookieName string
+	wechatSvc wechat.Service
+	userSvc   service.UserService
+	state     oauth2State
 }
 
 func NewOAuth2WechatHandler(service wechat.Service,
 	hdl ijwt.Handler,
 	userSvc service.UserService) *OAuth2WechatHandler {
 	return &OAuth2WechatHandler{
-		wechatSvc:       service,
-		userSvc:         userSvc,
-		key:             []byte("WiXLWadWG44Rr2qP6VUDLod0dzAnRI46"),
-		stateCookieName: "jwt-state",
-		Handler:         hdl,
+		wechatSvc: service,
+		userSvc:   userSvc,
+		state:     newOAuth2State(),
+		Handler:   hdl,
 	}
 }
 
 func (o *OAuth2WechatHandler) RegisterRoutes(server *gin.Engine) {
 	g := server.Group("/oauth2/wechat")
 	g.GET("/authurl", o.OAuth2URL)
+	// 已经登录的用户绑定微信，回调的时候根据 state 区分是登录还是绑定
+	g.GET("/bind_authurl", ginx.WrapClaims(o.BindOAuth2URL))
 	// 这边用 Any 万无一失
 	g.Any("/callback", o.Callback)
 }
@@ -50,7 +49,7 @@ func (o *OAuth2WechatHandler) OAuth2URL(ctx *gin.Context) {
 		})
 		return
 	}
-	err = o.setStateCookie(ctx, state)
+	err = o.setStateCookie(ctx, state, 0)
 	if err != nil {
 		ctx.JSON(http.StatusOK, ginx.Result{
 			Code: 5,
@@ -63,8 +62,29 @@ func (o *OAuth2WechatHandler) OAuth2URL(ctx *gin.Context) {
 	})
 }
 
+func (o *OAuth2WechatHandler) BindOAuth2URL(ctx *gin.Context, uc ijwt.UserClaims) (ginx.Result, error) {
+	state := uuid.New()
+	url, err := o.wechatSvc.AuthURL(ctx, state)
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "构造跳转URL失败",
+		}, err
+	}
+	err = o.setStateCookie(ctx, state, uc.Uid)
+	if err != nil {
+		return ginx.Result{
+			Code: 5,
+			Msg:  "服务器异常",
+		}, err
+	}
+	return ginx.Result{
+		Data: url,
+	}, nil
+}
+
 func (o *OAuth2WechatHandler) Callback(ctx *gin.Context) {
-	err := o.verifyState(ctx)
+	sc, err := o.state.verify(ctx)
 	if err != nil {
 		ctx.JSON(http.StatusOK, ginx.Result{
 			Code: 4,
@@ -83,6 +103,16 @@ func (o *OAuth2WechatHandler) Callback(ctx *gin.Context) {
 		return
 	}
 
+	if sc.BindUid > 0 {
+		// state 是我们自己签名的，里面的 uid 可以信任
+		res, err := bindResult(o.userSvc.BindWechat(ctx, sc.BindUid, wechatInfo))
+		if err != nil {
+			zap.L().Error("绑定微信失败", zap.Error(err))
+		}
+		ctx.JSON(http.StatusOK, res)
+		return
+	}
+
 	user, err := o.userSvc.FindOrCreateByWechat(ctx, wechatInfo)
 	if err != nil {
 		ctx.JSON(http.StatusOK, ginx.Result{
@@ -91,55 +121,17 @@ func (o *OAuth2WechatHandler) Callback(ctx *gin.Context) {
 		})
 		return
 	}
-	err = o.SetLoginToken(ctx, user.Id)
+	res, err := setLoginTokenOrPreAuth(ctx, o.Handler, user)
 	if err != nil {
-		ctx.String(http.StatusOK, "系统错误")
-		return
+		zap.L().Error("设置登录态失败", zap.Error(err))
 	}
-	ctx.JSON(http.StatusOK, ginx.Result{
-		Msg: "OK",
-	})
+	ctx.JSON(http.StatusOK, res)
 }
 
-func (o *OAuth2WechatHandler) verifyState(ctx *gin.Context) error {
-	state := ctx.Query("state")
-	ck, err := ctx.Cookie(o.stateCookieName)
-	if err != nil {
-		// 基本上，如果进来这里，就可以认为是有人在搞鬼。
-		return fmt.Errorf("无法获得 cookie %w", err)
-	}
-	var sc StateClaims
-	_, err = jwt.ParseWithClaims(ck, &sc, func(token *jwt.Token) (interface{}, error) {
-		return o.key, nil
+func (o *OAuth2WechatHandler) setStateCookie(ctx *gin.Context, state string, bindUid int64) error {
+	return o.state.set(ctx, "/oauth2/wechat/callback", StateClaims{
+		State:    state,
+		BindUid:  bindUid,
+		Provider: "wechat",
 	})
-	if err != nil {
-		return fmt.Errorf("解析 token 失败 %w", err)
-	}
-	if sc.State != state {
-		return fmt.Errorf("state 不匹配")
-	}
-	return nil
-}
-
-func (o *OAuth2WechatHandler) setStateCookie(ctx *gin.Context, state string) error {
-	claims := StateClaims{
-		State: state,
-	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenStr, err := token.SignedString(o.key)
-	if err != nil {
-		return err
-	}
-	ctx.SetCookie(o.stateCookieName, tokenStr,
-		600,
-		// 限制在只能在这里生效。
-		"/oauth2/wechat/callback",
-		// 这边把 HTTPS 协议禁止了。不过在生产环境中要开启。
-		"", false, true)
-	return nil
-}
-
-type StateClaims struct {
-	jwt.RegisteredClaims
-	State string
 }
@@ -0,0 +1,10 @@
+package ioc
+
+import (
+	"webook/internal/service/captcha"
+	"webook/internal/service/captcha/localcaptcha"
+)
+
+func InitCaptchaService() captcha.Service {
+	return localcaptcha.NewService()
+}
@@ -0,0 +1,11 @@
+package ioc
+
+import (
+	"webook/internal/service/email"
+	"webook/internal/service/email/localemail"
+)
+
+func InitEmailService() email.Service {
+	// 和 SMS 一样，方便以后替换成真正的邮件服务
+	return localemail.NewService()
+}
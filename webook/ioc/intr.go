@@ -40,7 +40,8 @@ func InitIntrClientV1(client *etcdv3.Client) intrv1.InteractiveServiceClient {
 	return remote
 }
 
-func InitIntrClient(svc service.InteractiveService) intrv1.InteractiveServiceClient {
+func InitIntrClient(svc service.InteractiveService,
+	csvc service.CollectionService) intrv1.InteractiveServiceClient {
 	type Config struct {
 		Addr      string `yaml:"addr"`
 		Secure    bool
@@ -61,7 +62,7 @@ func InitIntrClient(svc service.InteractiveService) intrv1.InteractiveServiceCli
 		panic(err)
 	}
 	remote := intrv1.NewInteractiveServiceClient(cc)
-	local := client.NewLocalInteractiveServiceAdapter(svc)
+	local := client.NewLocalInteractiveServiceAdapter(svc, csvc)
 	res := client.NewInteractiveClient(remote, local)
 	res.UpdateThreshold(cfg.Threshold)
 	viper.OnConfigChange(func(in fsnotify.Event) {
@@ -1,7 +1,9 @@
 package ioc
 
 import (
+	"context"
 	"time"
+	"webook/internal/domain"
 	"webook/internal/job"
 	"webook/internal/service"
 	"webook/pkg/logger"
@@ -15,7 +17,38 @@ func InitRankingJob(svc service.RankingService, client *rlock.Client, l logger.L
 	return job.NewRankingJob(svc, l, client, time.Second*30)
 }
 
-func InitJobs(l logger.LoggerV1, rjob *job.RankingJob) *cron.Cron {
+func InitUserDeletionJob(svc service.UserDataService, l logger.LoggerV1) *job.UserDeletionJob {
+	return job.NewUserDeletionJob(svc, l, time.Minute)
+}
+
+func InitArticlePublishJob(svc service.ArticleService, l logger.LoggerV1) *job.ArticlePublishJob {
+	return job.NewArticlePublishJob(svc, l, time.Second*30)
+}
+
+// InitJobScheduler 需要抢占的任务交给 Scheduler 调度
+func InitJobScheduler(svc service.CronJobService,
+	pjob *job.ArticlePublishJob,
+	l logger.LoggerV1) *job.Scheduler {
+	sch := job.NewScheduler(svc, l)
+	exec := job.NewLocalFuncExecutor()
+	exec.RegisterFunc(pjob.Name(), pjob.Exec)
+	sch.RegisterExecutor(exec)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	// 同名的任务已经存在就不会重复插入，所以每个实例都可以调用
+	err := svc.AddJob(ctx, domain.Job{
+		Name:       pjob.Name(),
+		Executor:   exec.Name(),
+		Expression: "@every 10s",
+	})
+	if err != nil {
+		panic(err)
+	}
+	return sch
+}
+
+func InitJobs(l logger.LoggerV1, rjob *job.RankingJob, djob *job.UserDeletionJob) *cron.Cron {
 	builder := job.NewCronJobBuilder(l, prometheus.SummaryOpts{
 		Namespace: "riiceball",
 		Subsystem: "webook",
@@ -34,5 +67,9 @@ func InitJobs(l logger.LoggerV1, rjob *job.RankingJob) *cron.Cron {
 	if err != nil {
 		panic(err)
 	}
+	_, err = expr.AddJob("@every 10m", builder.Build(djob))
+	if err != nil {
+		panic(err)
+	}
 	return expr
 }
@@ -0,0 +1,106 @@
+package ioc
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"os"
+	"time"
+	ijwt "webook/internal/web/jwt"
+	"webook/pkg/logger"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/spf13/viper"
+)
+
+// InitJWTKeySet 从配置里面加载短 token 的密钥。
+// 轮换的时候，新 key 作为 signingKid，旧 key 配上 retireAt，
+// 在 retireAt 之前旧 key 签发的 token 依旧有效
+func InitJWTKeySet(l logger.LoggerV1) *ijwt.KeySet {
+	type Key struct {
+		Kid string `yaml:"kid"`
+		// PrivateKey 和 PublicKey 都是 PEM 文件的路径，签名的 key 才需要私钥
+		PrivateKey string `yaml:"privateKey"`
+		PublicKey  string `yaml:"publicKey"`
+		// RetireAt RFC3339 格式
+		RetireAt string `yaml:"retireAt"`
+	}
+	type Config struct {
+		SigningKid string `yaml:"signingKid"`
+		Keys       []Key  `yaml:"keys"`
+	}
+	var cfg Config
+	err := viper.UnmarshalKey("jwt", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	if len(cfg.Keys) == 0 {
+		// 没有配置的话临时生成一把，只能用于开发环境，多实例部署的时候会互相不认
+		l.Warn("没有配置 JWT 密钥，使用临时生成的 Ed25519 密钥")
+		pub, priv, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			panic(err)
+		}
+		ks, err := ijwt.NewKeySet(ijwt.SigningKey{
+			Kid:     "dev",
+			Method:  jwt.SigningMethodEdDSA,
+			Private: priv,
+			Public:  pub,
+		})
+		if err != nil {
+			panic(err)
+		}
+		return ks
+	}
+
+	var (
+		current ijwt.SigningKey
+		olds    []ijwt.SigningKey
+	)
+	for _, k := range cfg.Keys {
+		key := ijwt.SigningKey{Kid: k.Kid}
+		if k.RetireAt != "" {
+			key.RetireAt, err = time.Parse(time.RFC3339, k.RetireAt)
+			if err != nil {
+				panic(err)
+			}
+		}
+		if k.PrivateKey != "" {
+			data, err := os.ReadFile(k.PrivateKey)
+			if err != nil {
+				panic(err)
+			}
+			key.Private, key.Method, err = ijwt.ParsePrivateKeyPEM(data)
+			if err != nil {
+				panic(err)
+			}
+			key.Public = key.Private.Public()
+		} else {
+			data, err := os.ReadFile(k.PublicKey)
+			if err != nil {
+				panic(err)
+			}
+			key.Public, key.Method, err = ijwt.ParsePublicKeyPEM(data)
+			if err != nil {
+				panic(err)
+			}
+		}
+		if k.Kid == cfg.SigningKid {
+			current = key
+		} else {
+			olds = append(olds, key)
+		}
+	}
+	ks, err := ijwt.NewKeySet(current, olds...)
+	if err != nil {
+		panic(err)
+	}
+	return ks
+}
+
+func InitRefreshKey() ijwt.RefreshKey {
+	key := viper.GetString("jwt.refreshKey")
+	if key == "" {
+		panic("没有配置 jwt.refreshKey")
+	}
+	return ijwt.RefreshKey(key)
+}
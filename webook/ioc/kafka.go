@@ -2,6 +2,7 @@ package ioc
 
 import (
 	"webook/internal/events"
+	"webook/internal/events/user"
 
 	"github.com/IBM/sarama"
 	"github.com/spf13/viper"
@@ -35,6 +36,6 @@ func InitSyncProducer(client sarama.Client) sarama.SyncProducer {
 	return res
 }
 
-func InitConsumers() []events.Consumer {
-	return []events.Consumer{}
+func InitConsumers(c1 *user.ExportEventConsumer) []events.Consumer {
+	return []events.Consumer{c1}
 }
@@ -0,0 +1,78 @@
+package ioc
+
+import (
+	"webook/internal/service"
+	"webook/pkg/objstore"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/spf13/viper"
+)
+
+func InitMediaConfig() service.MediaConfig {
+	type Config struct {
+		MaxSize    int64 `yaml:"maxSize"`
+		ThumbWidth int   `yaml:"thumbWidth"`
+	}
+	cfg := Config{
+		MaxSize:    10 << 20,
+		ThumbWidth: 320,
+	}
+	err := viper.UnmarshalKey("media", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	return service.MediaConfig{
+		MaxSize:    cfg.MaxSize,
+		ThumbWidth: cfg.ThumbWidth,
+	}
+}
+
+// InitObjectStore 根据 media.store.type 选择本地存储或者 S3
+func InitObjectStore() objstore.Store {
+	type LocalConfig struct {
+		Dir     string `yaml:"dir"`
+		BaseURL string `yaml:"baseURL"`
+	}
+	type S3Config struct {
+		Endpoint  string `yaml:"endpoint"`
+		Region    string `yaml:"region"`
+		AccessKey string `yaml:"accessKey"`
+		SecretKey string `yaml:"secretKey"`
+		UseSSL    bool   `yaml:"useSSL"`
+		Bucket    string `yaml:"bucket"`
+		BaseURL   string `yaml:"baseURL"`
+	}
+	type Config struct {
+		Type  string      `yaml:"type"`
+		Local LocalConfig `yaml:"local"`
+		S3    S3Config    `yaml:"s3"`
+	}
+	cfg := Config{
+		Type: "local",
+		Local: LocalConfig{
+			Dir:     "data/media",
+			BaseURL: "/media/files",
+		},
+	}
+	err := viper.UnmarshalKey("media.store", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	switch cfg.Type {
+	case "local":
+		return objstore.NewLocalStore(cfg.Local.Dir, cfg.Local.BaseURL)
+	case "s3":
+		client, err := minio.New(cfg.S3.Endpoint, &minio.Options{
+			Creds:  credentials.NewStaticV4(cfg.S3.AccessKey, cfg.S3.SecretKey, ""),
+			Secure: cfg.S3.UseSSL,
+			Region: cfg.S3.Region,
+		})
+		if err != nil {
+			panic(err)
+		}
+		return objstore.NewS3Store(client, cfg.S3.Bucket, cfg.S3.BaseURL)
+	default:
+		panic("不支持的对象存储 " + cfg.Type)
+	}
+}
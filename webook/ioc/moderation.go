@@ -0,0 +1,57 @@
+package ioc
+
+import (
+	"bufio"
+	"os"
+	"strings"
+	"webook/internal/service/moderation"
+	"webook/internal/service/moderation/keyword"
+	"webook/pkg/logger"
+
+	"github.com/spf13/viper"
+)
+
+// InitModerator 发表之前的自动审核。接入外部的内容分类服务的时候，
+// 实现 moderation.Moderator 然后加到 NewChain 里面就可以
+func InitModerator(l logger.LoggerV1) moderation.Moderator {
+	type Config struct {
+		Words []string `yaml:"words"`
+		// WordsFile 敏感词比较多的时候放在文件里面，一行一个
+		WordsFile string   `yaml:"wordsFile"`
+		Patterns  []string `yaml:"patterns"`
+	}
+	var cfg Config
+	err := viper.UnmarshalKey("moderation", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	words := cfg.Words
+	if cfg.WordsFile != "" {
+		words = append(words, readWords(cfg.WordsFile)...)
+	}
+	km, err := keyword.NewModerator(words, cfg.Patterns)
+	if err != nil {
+		panic(err)
+	}
+	return moderation.NewChain(l, km)
+}
+
+func readWords(path string) []string {
+	f, err := os.Open(path)
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+	var res []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		word := strings.TrimSpace(scanner.Text())
+		if word != "" {
+			res = append(res, word)
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		panic(err)
+	}
+	return res
+}
@@ -0,0 +1,46 @@
+package ioc
+
+import (
+	"fmt"
+	"webook/internal/service/oauth2"
+	"webook/internal/service/oauth2/github"
+	"webook/internal/service/oauth2/oidc"
+
+	"github.com/spf13/viper"
+)
+
+// InitOAuth2Registry 根据配置启用通用的第三方登录，微信还是走 InitWechatService
+func InitOAuth2Registry() *oauth2.Registry {
+	type Provider struct {
+		Name         string   `yaml:"name"`
+		Type         string   `yaml:"type"`
+		ClientId     string   `yaml:"clientId"`
+		ClientSecret string   `yaml:"clientSecret"`
+		RedirectURL  string   `yaml:"redirectURL"`
+		Issuer       string   `yaml:"issuer"`
+		Scopes       []string `yaml:"scopes"`
+	}
+	var providers []Provider
+	err := viper.UnmarshalKey("oauth2.providers", &providers)
+	if err != nil {
+		panic(err)
+	}
+	res := make([]oauth2.Provider, 0, len(providers))
+	for _, p := range providers {
+		cfg := oauth2.Config{
+			ClientId:     p.ClientId,
+			ClientSecret: p.ClientSecret,
+			RedirectURL:  p.RedirectURL,
+			Scopes:       p.Scopes,
+		}
+		switch p.Type {
+		case "github":
+			res = append(res, github.NewProvider(p.Name, cfg))
+		case "oidc":
+			res = append(res, oidc.NewProvider(p.Name, p.Issuer, cfg))
+		default:
+			panic(fmt.Sprintf("未知的第三方登录类型 %s", p.Type))
+		}
+	}
+	return oauth2.NewRegistry(res...)
+}
@@ -0,0 +1,32 @@
+package ioc
+
+import (
+	ijwt "webook/internal/web/jwt"
+	rbacx "webook/pkg/ginx/middleware/rbac"
+	"webook/pkg/rbac"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spf13/viper"
+)
+
+// InitRBACPolicy 角色对应的权限放在配置里面，调整权限不需要改数据库
+func InitRBACPolicy() *rbac.Policy {
+	var roles map[string][]string
+	err := viper.UnmarshalKey("rbac.roles", &roles)
+	if err != nil {
+		panic(err)
+	}
+	return rbac.NewPolicy(roles)
+}
+
+func InitRBACBuilder(policy *rbac.Policy) *rbacx.Builder {
+	return rbacx.NewBuilder(policy, func(ctx *gin.Context) ([]string, bool) {
+		// 登录校验的 middleware 会把 claims 放进去
+		val, ok := ctx.Get("user")
+		if !ok {
+			return nil, false
+		}
+		uc, ok := val.(ijwt.UserClaims)
+		return uc.Roles, ok
+	})
+}
@@ -0,0 +1,89 @@
+package ioc
+
+import (
+	"time"
+	"webook/internal/service"
+	"webook/internal/web/loginguard"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/spf13/viper"
+)
+
+func InitUserConfig() service.UserConfig {
+	type Config struct {
+		TokenKey                string        `yaml:"tokenKey"`
+		LinkPrefix              string        `yaml:"linkPrefix"`
+		RequireEmailVerified    bool          `yaml:"requireEmailVerified"`
+		VerifyEmailExpiration   time.Duration `yaml:"verifyEmailExpiration"`
+		ResetPasswordExpiration time.Duration `yaml:"resetPasswordExpiration"`
+	}
+	cfg := Config{
+		LinkPrefix:              "http://localhost:3000",
+		VerifyEmailExpiration:   24 * time.Hour,
+		ResetPasswordExpiration: 30 * time.Minute,
+	}
+	err := viper.UnmarshalKey("user", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	if cfg.TokenKey == "" {
+		panic("没有配置 user.tokenKey")
+	}
+	return service.UserConfig{
+		TokenKey:                []byte(cfg.TokenKey),
+		LinkPrefix:              cfg.LinkPrefix,
+		RequireEmailVerified:    cfg.RequireEmailVerified,
+		VerifyEmailExpiration:   cfg.VerifyEmailExpiration,
+		ResetPasswordExpiration: cfg.ResetPasswordExpiration,
+	}
+}
+
+func InitUserDataConfig() service.UserDataConfig {
+	type Config struct {
+		ExportExpiration    time.Duration `yaml:"exportExpiration"`
+		DeletionGracePeriod time.Duration `yaml:"deletionGracePeriod"`
+	}
+	cfg := Config{
+		ExportExpiration:    72 * time.Hour,
+		DeletionGracePeriod: 15 * 24 * time.Hour,
+	}
+	err := viper.UnmarshalKey("userData", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	return service.UserDataConfig{
+		ExportExpiration:    cfg.ExportExpiration,
+		DeletionGracePeriod: cfg.DeletionGracePeriod,
+	}
+}
+
+func InitLoginGuard(cmd redis.Cmdable) loginguard.Guard {
+	type Config struct {
+		Window           time.Duration `yaml:"window"`
+		CaptchaThreshold int           `yaml:"captchaThreshold"`
+		AccountThreshold int           `yaml:"accountThreshold"`
+		IPThreshold      int           `yaml:"ipThreshold"`
+		LockDuration     time.Duration `yaml:"lockDuration"`
+		MaxLockDuration  time.Duration `yaml:"maxLockDuration"`
+	}
+	cfg := Config{
+		Window:           15 * time.Minute,
+		CaptchaThreshold: 3,
+		AccountThreshold: 5,
+		IPThreshold:      50,
+		LockDuration:     time.Minute,
+		MaxLockDuration:  time.Hour,
+	}
+	err := viper.UnmarshalKey("loginGuard", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	return loginguard.NewRedisGuard(cmd, loginguard.Config{
+		Window:           cfg.Window,
+		CaptchaThreshold: cfg.CaptchaThreshold,
+		AccountThreshold: cfg.AccountThreshold,
+		IPThreshold:      cfg.IPThreshold,
+		LockDuration:     cfg.LockDuration,
+		MaxLockDuration:  cfg.MaxLockDuration,
+	})
+}
@@ -17,20 +17,64 @@ import (
 	"github.com/gin-gonic/gin"
 	prometheus2 "github.com/prometheus/client_golang/prometheus"
 	"github.com/redis/go-redis/v9"
+	"github.com/spf13/viper"
 	otelgin "go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 )
 
 func InitWebServer(mdls []gin.HandlerFunc, userHdl *web.UserHandler,
 	artHdl *web.ArticleHandler,
-	wechatHdl *web.OAuth2WechatHandler) *gin.Engine {
+	wechatHdl *web.OAuth2WechatHandler,
+	oauth2Hdl *web.OAuth2Handler,
+	adminHdl *web.AdminHandler,
+	userDataHdl *web.UserDataHandler,
+	mediaHdl *web.MediaHandler,
+	columnHdl *web.ColumnHandler,
+	reviewHdl *web.ArticleReviewHandler,
+	feedHdl *web.FeedHandler,
+	collectionHdl *web.CollectionHandler,
+	userIntrHdl *web.UserInteractiveHandler,
+	jwksHdl *web.JWKSHandler) *gin.Engine {
 	server := gin.Default()
 	server.Use(mdls...)
 	userHdl.RegisterRoutes(server)
 	artHdl.RegisterRoutes(server)
 	wechatHdl.RegisterRoutes(server)
+	oauth2Hdl.RegisterRoutes(server)
+	adminHdl.RegisterRoutes(server)
+	userDataHdl.RegisterRoutes(server)
+	mediaHdl.RegisterRoutes(server)
+	columnHdl.RegisterRoutes(server)
+	reviewHdl.RegisterRoutes(server)
+	feedHdl.RegisterRoutes(server)
+	collectionHdl.RegisterRoutes(server)
+	userIntrHdl.RegisterRoutes(server)
+	jwksHdl.RegisterRoutes(server)
 	return server
 }
 
+func InitSiteConfig() web.SiteConfig {
+	type Config struct {
+		Name        string `yaml:"name"`
+		Description string `yaml:"description"`
+		BaseURL     string `yaml:"baseURL"`
+		TwitterSite string `yaml:"twitterSite"`
+	}
+	cfg := Config{
+		Name:    "webook",
+		BaseURL: "http://localhost:3000",
+	}
+	err := viper.UnmarshalKey("site", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	return web.SiteConfig{
+		Name:        cfg.Name,
+		Description: cfg.Description,
+		BaseURL:     strings.TrimSuffix(cfg.BaseURL, "/"),
+		TwitterSite: cfg.TwitterSite,
+	}
+}
+
 func InitGinMiddlewares(redisClient redis.Cmdable,
 	hdl ijwt.Handler, l logger.LoggerV1) []gin.HandlerFunc {
 	pb := &prometheus.Builder{
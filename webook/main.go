@@ -41,6 +41,15 @@ func main() {
 		<-app.cron.Stop().Done()
 	}()
 
+	schCtx, schCancel := context.WithCancel(context.Background())
+	defer schCancel()
+	go func() {
+		err := app.scheduler.Schedule(schCtx)
+		if err != nil && schCtx.Err() == nil {
+			zap.L().Error("任务调度退出", zap.Error(err))
+		}
+	}()
+
 	server := app.server
 
 	server.GET("/hello", func(ctx *gin.Context) {
@@ -0,0 +1,147 @@
+package ahocorasick
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// Match 命中的一个模式串，Start 和 End 是在原文里面的字节下标，左闭右开
+type Match struct {
+	Pattern string
+	Start   int
+	End     int
+}
+
+// Matcher Aho-Corasick 多模式匹配，扫一遍文本就能找出所有的模式串。
+// 匹配的时候不区分大小写。构建好之后是只读的，可以并发使用
+type Matcher struct {
+	nodes    []node
+	patterns []string
+	// lens 模式串的字符数，用来算命中的起始位置
+	lens []int
+}
+
+type node struct {
+	next map[rune]int
+	fail int
+	// out 在这个节点结束的模式串，包括沿着 fail 指针能到达的
+	out []int
+}
+
+// New 空字符串会被忽略，重复的模式串只算一个
+func New(patterns []string) *Matcher {
+	m := &Matcher{
+		nodes: []node{{next: map[rune]int{}}},
+	}
+	seen := make(map[string]struct{}, len(patterns))
+	for _, p := range patterns {
+		if p == "" {
+			continue
+		}
+		key := fold(p)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		m.insert(key, len(m.patterns))
+		m.patterns = append(m.patterns, p)
+		m.lens = append(m.lens, utf8.RuneCountInString(key))
+	}
+	m.build()
+	return m
+}
+
+func fold(s string) string {
+	rs := []rune(s)
+	for i, r := range rs {
+		rs[i] = unicode.ToLower(r)
+	}
+	return string(rs)
+}
+
+func (m *Matcher) insert(p string, idx int) {
+	cur := 0
+	for _, r := range p {
+		nxt, ok := m.nodes[cur].next[r]
+		if !ok {
+			nxt = len(m.nodes)
+			m.nodes = append(m.nodes, node{next: map[rune]int{}})
+			m.nodes[cur].next[r] = nxt
+		}
+		cur = nxt
+	}
+	m.nodes[cur].out = append(m.nodes[cur].out, idx)
+}
+
+// build 按层遍历，构建 fail 指针
+func (m *Matcher) build() {
+	queue := make([]int, 0, len(m.nodes))
+	for _, child := range m.nodes[0].next {
+		queue = append(queue, child)
+	}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for r, child := range m.nodes[cur].next {
+			f := m.nodes[cur].fail
+			for f > 0 {
+				if _, ok := m.nodes[f].next[r]; ok {
+					break
+				}
+				f = m.nodes[f].fail
+			}
+			if nxt, ok := m.nodes[f].next[r]; ok && nxt != child {
+				m.nodes[child].fail = nxt
+			}
+			fail := m.nodes[child].fail
+			m.nodes[child].out = append(m.nodes[child].out, m.nodes[fail].out...)
+			queue = append(queue, child)
+		}
+	}
+}
+
+func (m *Matcher) step(cur int, r rune) int {
+	for {
+		if nxt, ok := m.nodes[cur].next[r]; ok {
+			return nxt
+		}
+		if cur == 0 {
+			return 0
+		}
+		cur = m.nodes[cur].fail
+	}
+}
+
+// FindAll 按照结束的位置返回所有命中的模式串，重叠的也会返回
+func (m *Matcher) FindAll(text string) []Match {
+	var res []Match
+	// starts 记录每个字符在原文里面的字节下标，用来算命中的起始位置
+	starts := make([]int, 0, utf8.RuneCountInString(text))
+	cur := 0
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		starts = append(starts, i)
+		i += size
+		cur = m.step(cur, unicode.ToLower(r))
+		for _, idx := range m.nodes[cur].out {
+			res = append(res, Match{
+				Pattern: m.patterns[idx],
+				Start:   starts[len(starts)-m.lens[idx]],
+				End:     i,
+			})
+		}
+	}
+	return res
+}
+
+// Contains 命中任何一个模式串就返回 true
+func (m *Matcher) Contains(text string) bool {
+	cur := 0
+	for _, r := range text {
+		cur = m.step(cur, unicode.ToLower(r))
+		if len(m.nodes[cur].out) > 0 {
+			return true
+		}
+	}
+	return false
+}
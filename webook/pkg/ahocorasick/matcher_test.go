@@ -0,0 +1,65 @@
+package ahocorasick
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatcher_FindAll(t *testing.T) {
+	testCases := []struct {
+		name     string
+		patterns []string
+		text     string
+		want     []Match
+	}{
+		{
+			name:     "没有命中",
+			patterns: []string{"he", "she"},
+			text:     "abc",
+		},
+		{
+			name:     "重叠的模式串",
+			patterns: []string{"he", "she", "his", "hers"},
+			text:     "ushers",
+			want: []Match{
+				{Pattern: "she", Start: 1, End: 4},
+				{Pattern: "he", Start: 2, End: 4},
+				{Pattern: "hers", Start: 2, End: 6},
+			},
+		},
+		{
+			name:     "中文按照字节下标",
+			patterns: []string{"赌博", "博彩"},
+			text:     "禁止赌博彩票",
+			want: []Match{
+				{Pattern: "赌博", Start: 6, End: 12},
+				{Pattern: "博彩", Start: 9, End: 15},
+			},
+		},
+		{
+			name:     "不区分大小写",
+			patterns: []string{"Spam"},
+			text:     "no SPAM here",
+			want: []Match{
+				{Pattern: "Spam", Start: 3, End: 7},
+			},
+		},
+		{
+			name:     "重复和空的模式串",
+			patterns: []string{"", "ab", "AB"},
+			text:     "ab",
+			want: []Match{
+				{Pattern: "ab", Start: 0, End: 2},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := New(tc.patterns)
+			assert.Equal(t, tc.want, m.FindAll(tc.text))
+			assert.Equal(t, len(tc.want) > 0, m.Contains(tc.text))
+		})
+	}
+}
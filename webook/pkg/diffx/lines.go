@@ -0,0 +1,58 @@
+package diffx
+
+import (
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+type Op uint8
+
+const (
+	OpEqual Op = iota
+	OpInsert
+	OpDelete
+)
+
+// Line 一行的差异，Text 不带换行符
+type Line struct {
+	Op   Op
+	Text string
+}
+
+// Lines 按行比较 a 和 b，返回把 a 变成 b 需要的操作。
+// 修改的行会拆成先删除再插入，方便前端直接渲染
+func Lines(a, b string) []Line {
+	al, bl := split(a), split(b)
+	// 关掉 autoJunk，不然长文章里面的空行会被当成垃圾行，diff 的结果很难看
+	m := difflib.NewMatcherWithJunk(al, bl, false, nil)
+	var res []Line
+	for _, oc := range m.GetOpCodes() {
+		switch oc.Tag {
+		case 'e':
+			res = appendLines(res, OpEqual, al[oc.I1:oc.I2])
+		case 'd':
+			res = appendLines(res, OpDelete, al[oc.I1:oc.I2])
+		case 'i':
+			res = appendLines(res, OpInsert, bl[oc.J1:oc.J2])
+		case 'r':
+			res = appendLines(res, OpDelete, al[oc.I1:oc.I2])
+			res = appendLines(res, OpInsert, bl[oc.J1:oc.J2])
+		}
+	}
+	return res
+}
+
+func appendLines(res []Line, op Op, lines []string) []Line {
+	for _, l := range lines {
+		res = append(res, Line{Op: op, Text: l})
+	}
+	return res
+}
+
+func split(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
@@ -0,0 +1,56 @@
+package diffx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLines(t *testing.T) {
+	testCases := []struct {
+		name string
+		a    string
+		b    string
+		want []Line
+	}{
+		{
+			name: "完全一样",
+			a:    "a\nb",
+			b:    "a\nb",
+			want: []Line{{Op: OpEqual, Text: "a"}, {Op: OpEqual, Text: "b"}},
+		},
+		{
+			name: "从空到有",
+			a:    "",
+			b:    "a\nb\n",
+			want: []Line{{Op: OpInsert, Text: "a"}, {Op: OpInsert, Text: "b"}},
+		},
+		{
+			name: "删除",
+			a:    "a\nb\nc",
+			b:    "a\nc",
+			want: []Line{
+				{Op: OpEqual, Text: "a"},
+				{Op: OpDelete, Text: "b"},
+				{Op: OpEqual, Text: "c"},
+			},
+		},
+		{
+			name: "修改拆成删除和插入",
+			a:    "a\nb\nc",
+			b:    "a\nB\nc\nd",
+			want: []Line{
+				{Op: OpEqual, Text: "a"},
+				{Op: OpDelete, Text: "b"},
+				{Op: OpInsert, Text: "B"},
+				{Op: OpEqual, Text: "c"},
+				{Op: OpInsert, Text: "d"},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, Lines(tc.a, tc.b))
+		})
+	}
+}
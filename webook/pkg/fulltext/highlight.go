@@ -0,0 +1,71 @@
+package fulltext
+
+import (
+	"html"
+	"strings"
+	"unicode/utf8"
+)
+
+// highlight 生成摘要，命中的词用 <em> 包起来。
+// 文本超过 maxLen 个字的时候从第一个命中的位置往前留一点上下文开始截取
+func highlight(text string, terms map[string]struct{}, maxLen int) string {
+	// 二元切分的词是重叠的，相邻的命中合并成一段
+	var spans [][2]int
+	for _, t := range Tokenize(text) {
+		if _, ok := terms[t.Term]; !ok {
+			continue
+		}
+		if n := len(spans); n > 0 && t.Start <= spans[n-1][1] {
+			spans[n-1][1] = max(spans[n-1][1], t.End)
+			continue
+		}
+		spans = append(spans, [2]int{t.Start, t.End})
+	}
+	start, end := 0, len(text)
+	if maxLen > 0 && utf8.RuneCountInString(text) > maxLen {
+		if len(spans) > 0 {
+			start = backward(text, spans[0][0], maxLen/4)
+		}
+		end = forward(text, start, maxLen)
+	}
+
+	var sb strings.Builder
+	if start > 0 {
+		sb.WriteString("…")
+	}
+	pos := start
+	for _, s := range spans {
+		if s[0] >= end {
+			break
+		}
+		s1 := min(s[1], end)
+		sb.WriteString(html.EscapeString(text[pos:s[0]]))
+		sb.WriteString("<em>")
+		sb.WriteString(html.EscapeString(text[s[0]:s1]))
+		sb.WriteString("</em>")
+		pos = s1
+	}
+	sb.WriteString(html.EscapeString(text[pos:end]))
+	if end < len(text) {
+		sb.WriteString("…")
+	}
+	return sb.String()
+}
+
+// backward 从 pos 往前退 n 个字，返回字节下标
+func backward(text string, pos int, n int) int {
+	for ; n > 0 && pos > 0; n-- {
+		_, size := utf8.DecodeLastRuneInString(text[:pos])
+		pos -= size
+	}
+	return pos
+}
+
+// forward 从 pos 往后走 n 个字，返回字节下标
+func forward(text string, pos int, n int) int {
+	for ; n > 0 && pos < len(text); n-- {
+		_, size := utf8.DecodeRuneInString(text[pos:])
+		pos += size
+	}
+	return pos
+}
@@ -0,0 +1,214 @@
+package fulltext
+
+import (
+	"math"
+	"sort"
+	"sync"
+)
+
+// Field 文档的一个字段，Boost 是这个字段命中的时候分数的倍数，
+// 例如标题命中比正文命中更重要
+type Field struct {
+	Name  string
+	Boost float64
+}
+
+type Document struct {
+	Id     int64
+	Fields map[string]string
+}
+
+type Hit struct {
+	Id    int64
+	Score float64
+	// Fields 放进索引的原文
+	Fields map[string]string
+	// Highlights 每个字段的摘要，命中的词用 <em> 包起来，已经转义过 HTML 了
+	Highlights map[string]string
+}
+
+type Option func(idx *Index)
+
+// WithBM25 k1 控制词频饱和的速度，b 控制文档长度的影响，默认 1.2 和 0.75
+func WithBM25(k1, b float64) Option {
+	return func(idx *Index) {
+		idx.k1 = k1
+		idx.b = b
+	}
+}
+
+// WithSnippetLength 摘要最多多少个字，默认 120
+func WithSnippetLength(n int) Option {
+	return func(idx *Index) {
+		idx.snippetLen = n
+	}
+}
+
+// Index 内存里面的倒排索引，按照 BM25 打分。
+// 所有的数据都在内存里面，重启之后要重新灌数据，适合数据量不大的场景
+type Index struct {
+	mu         sync.RWMutex
+	fields     []Field
+	k1         float64
+	b          float64
+	snippetLen int
+
+	docs map[int64]*document
+	// postings 词 -> 文档 ID -> 这个词在每个字段里面出现的次数
+	postings map[string]map[int64][]int
+	// totalLen 每个字段所有文档加起来的词数，用来算平均长度
+	totalLen []int
+}
+
+type document struct {
+	texts []string
+	lens  []int
+	terms []string
+}
+
+func NewIndex(fields []Field, opts ...Option) *Index {
+	idx := &Index{
+		fields:     fields,
+		k1:         1.2,
+		b:          0.75,
+		snippetLen: 120,
+		docs:       make(map[int64]*document),
+		postings:   make(map[string]map[int64][]int),
+		totalLen:   make([]int, len(fields)),
+	}
+	for _, opt := range opts {
+		opt(idx)
+	}
+	return idx
+}
+
+// Put 已经存在的文档会被覆盖
+func (idx *Index) Put(doc Document) {
+	d := &document{
+		texts: make([]string, len(idx.fields)),
+		lens:  make([]int, len(idx.fields)),
+	}
+	tfs := make(map[string][]int)
+	for i, f := range idx.fields {
+		d.texts[i] = doc.Fields[f.Name]
+		tokens := Tokenize(d.texts[i])
+		d.lens[i] = len(tokens)
+		for _, t := range tokens {
+			tf, ok := tfs[t.Term]
+			if !ok {
+				tf = make([]int, len(idx.fields))
+				tfs[t.Term] = tf
+				d.terms = append(d.terms, t.Term)
+			}
+			tf[i]++
+		}
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.remove(doc.Id)
+	idx.docs[doc.Id] = d
+	for i, l := range d.lens {
+		idx.totalLen[i] += l
+	}
+	for term, tf := range tfs {
+		p, ok := idx.postings[term]
+		if !ok {
+			p = make(map[int64][]int)
+			idx.postings[term] = p
+		}
+		p[doc.Id] = tf
+	}
+}
+
+func (idx *Index) Delete(id int64) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.remove(id)
+}
+
+func (idx *Index) remove(id int64) {
+	d, ok := idx.docs[id]
+	if !ok {
+		return
+	}
+	delete(idx.docs, id)
+	for i, l := range d.lens {
+		idx.totalLen[i] -= l
+	}
+	for _, term := range d.terms {
+		p := idx.postings[term]
+		delete(p, id)
+		if len(p) == 0 {
+			delete(idx.postings, term)
+		}
+	}
+}
+
+// Len 索引里面的文档数量
+func (idx *Index) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return len(idx.docs)
+}
+
+// Search 命中任何一个词的文档都会返回，按照分数倒序，分数一样的 ID 大的在前面。
+// total 是命中的文档总数
+func (idx *Index) Search(query string, offset, limit int) (hits []Hit, total int) {
+	terms := make(map[string]struct{})
+	for _, t := range Tokenize(query) {
+		terms[t.Term] = struct{}{}
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	n := float64(len(idx.docs))
+	scores := make(map[int64]float64)
+	for term := range terms {
+		p := idx.postings[term]
+		if len(p) == 0 {
+			continue
+		}
+		df := float64(len(p))
+		idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+		for id, tf := range p {
+			d := idx.docs[id]
+			for i, cnt := range tf {
+				if cnt == 0 {
+					continue
+				}
+				avg := float64(idx.totalLen[i]) / n
+				norm := float64(cnt) * (idx.k1 + 1) /
+					(float64(cnt) + idx.k1*(1-idx.b+idx.b*float64(d.lens[i])/avg))
+				scores[id] += idx.fields[i].Boost * idf * norm
+			}
+		}
+	}
+
+	total = len(scores)
+	hits = make([]Hit, 0, total)
+	for id, score := range scores {
+		hits = append(hits, Hit{Id: id, Score: score})
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].Id > hits[j].Id
+	})
+	if offset >= len(hits) {
+		return nil, total
+	}
+	hits = hits[offset:min(offset+limit, len(hits))]
+	// 只给这一页的结果生成摘要
+	for i := range hits {
+		d := idx.docs[hits[i].Id]
+		hits[i].Fields = make(map[string]string, len(idx.fields))
+		hits[i].Highlights = make(map[string]string, len(idx.fields))
+		for fi, f := range idx.fields {
+			hits[i].Fields[f.Name] = d.texts[fi]
+			hits[i].Highlights[f.Name] = highlight(d.texts[fi], terms, idx.snippetLen)
+		}
+	}
+	return hits, total
+}
@@ -0,0 +1,72 @@
+package fulltext
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestIndex() *Index {
+	idx := NewIndex([]Field{{Name: "title", Boost: 2}, {Name: "content", Boost: 1}},
+		WithSnippetLength(10))
+	idx.Put(Document{Id: 1, Fields: map[string]string{
+		"title":   "Go 并发编程",
+		"content": "介绍 goroutine 和 channel",
+	}})
+	idx.Put(Document{Id: 2, Fields: map[string]string{
+		"title":   "数据库索引",
+		"content": "MySQL 的索引和并发控制，<b>锁</b>",
+	}})
+	idx.Put(Document{Id: 3, Fields: map[string]string{
+		"title":   "Redis",
+		"content": "缓存",
+	}})
+	return idx
+}
+
+func TestIndex_Search(t *testing.T) {
+	idx := newTestIndex()
+
+	// 标题命中的排在正文命中的前面
+	hits, total := idx.Search("并发", 0, 10)
+	assert.Equal(t, 2, total)
+	require.Len(t, hits, 2)
+	assert.Equal(t, int64(1), hits[0].Id)
+	assert.Equal(t, int64(2), hits[1].Id)
+	assert.Equal(t, "Go <em>并发</em>编程", hits[0].Highlights["title"])
+	// 正文超过摘要长度，从命中的位置前面开始截，HTML 要转义
+	assert.Equal(t, "…引和<em>并发</em>控制，&lt;b&gt;…", hits[1].Highlights["content"])
+
+	// 分页
+	hits, total = idx.Search("并发", 1, 10)
+	assert.Equal(t, 2, total)
+	require.Len(t, hits, 1)
+	assert.Equal(t, int64(2), hits[0].Id)
+
+	hits, total = idx.Search("不存在", 0, 10)
+	assert.Equal(t, 0, total)
+	assert.Empty(t, hits)
+}
+
+func TestIndex_PutAndDelete(t *testing.T) {
+	idx := newTestIndex()
+
+	// 覆盖之后旧的内容搜不到了
+	idx.Put(Document{Id: 1, Fields: map[string]string{
+		"title":   "Go 泛型",
+		"content": "类型参数",
+	}})
+	hits, _ := idx.Search("并发编程", 0, 10)
+	require.Len(t, hits, 1)
+	assert.Equal(t, int64(2), hits[0].Id)
+	hits, _ = idx.Search("泛型", 0, 10)
+	require.Len(t, hits, 1)
+	assert.Equal(t, int64(1), hits[0].Id)
+
+	idx.Delete(2)
+	idx.Delete(100)
+	assert.Equal(t, 2, idx.Len())
+	_, total := idx.Search("索引", 0, 10)
+	assert.Equal(t, 0, total)
+}
@@ -0,0 +1,83 @@
+package fulltext
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// Token 一个词，Start 和 End 是在原文里面的字节下标，左闭右开
+type Token struct {
+	Term  string
+	Start int
+	End   int
+}
+
+// Tokenize 不依赖词典的分词：
+// 字母和数字连在一起的算一个词，转成小写；
+// 汉字、假名这些没有空格分隔的文字按照二元切分，"搜索引擎" 切成 "搜索" "索引" "引擎"，
+// 单独一个字就是一个词。别的字符都当成分隔符
+func Tokenize(text string) []Token {
+	var res []Token
+	// word 当前的字母数字词的起始位置，-1 表示不在词里面
+	word := -1
+	// prev 上一个 CJK 字符的起始位置，-1 表示上一个字符不是 CJK
+	prev := -1
+	// single 连续的 CJK 字符只有一个的时候要单独成词
+	single := false
+	flushCJK := func(end int) {
+		if prev >= 0 && single {
+			res = append(res, Token{Term: text[prev:end], Start: prev, End: end})
+		}
+		prev = -1
+		single = false
+	}
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		switch {
+		case isCJK(r):
+			if word >= 0 {
+				res = append(res, lowerToken(text, word, i))
+				word = -1
+			}
+			if prev >= 0 {
+				res = append(res, Token{Term: text[prev : i+size], Start: prev, End: i + size})
+				single = false
+			} else {
+				single = true
+			}
+			prev = i
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			flushCJK(i)
+			if word < 0 {
+				word = i
+			}
+		default:
+			flushCJK(i)
+			if word >= 0 {
+				res = append(res, lowerToken(text, word, i))
+				word = -1
+			}
+		}
+		i += size
+	}
+	flushCJK(len(text))
+	if word >= 0 {
+		res = append(res, lowerToken(text, word, len(text)))
+	}
+	return res
+}
+
+func isCJK(r rune) bool {
+	return unicode.Is(unicode.Han, r) ||
+		unicode.Is(unicode.Hiragana, r) ||
+		unicode.Is(unicode.Katakana, r) ||
+		unicode.Is(unicode.Hangul, r)
+}
+
+func lowerToken(text string, start, end int) Token {
+	rs := []rune(text[start:end])
+	for i, r := range rs {
+		rs[i] = unicode.ToLower(r)
+	}
+	return Token{Term: string(rs), Start: start, End: end}
+}
@@ -0,0 +1,50 @@
+package fulltext
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenize(t *testing.T) {
+	testCases := []struct {
+		name string
+		text string
+		want []Token
+	}{
+		{
+			name: "英文转小写",
+			text: "Hello, Go1.21!",
+			want: []Token{
+				{Term: "hello", Start: 0, End: 5},
+				{Term: "go1", Start: 7, End: 10},
+				{Term: "21", Start: 11, End: 13},
+			},
+		},
+		{
+			name: "中文二元切分",
+			text: "搜索引擎",
+			want: []Token{
+				{Term: "搜索", Start: 0, End: 6},
+				{Term: "索引", Start: 3, End: 9},
+				{Term: "引擎", Start: 6, End: 12},
+			},
+		},
+		{
+			name: "中英文混合，单独的汉字也是一个词",
+			text: "用Go写，快",
+			want: []Token{
+				{Term: "用", Start: 0, End: 3},
+				{Term: "go", Start: 3, End: 5},
+				{Term: "写", Start: 5, End: 8},
+				{Term: "快", Start: 11, End: 14},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, Tokenize(tc.text))
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package rbac
+
+import (
+	"net/http"
+	"webook/pkg/rbac"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RolesFunc 从请求里面拿到当前用户的角色，ok 为 false 表示没有登录
+type RolesFunc func(ctx *gin.Context) (roles []string, ok bool)
+
+// Builder 放在登录校验后面，按照路由检查权限
+type Builder struct {
+	policy *rbac.Policy
+	roles  RolesFunc
+}
+
+func NewBuilder(policy *rbac.Policy, roles RolesFunc) *Builder {
+	return &Builder{
+		policy: policy,
+		roles:  roles,
+	}
+}
+
+// Require 要求当前用户拥有 perm 权限，可以用在单个路由上，也可以用在路由分组上
+func (b *Builder) Require(perm string) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		roles, ok := b.roles(ctx)
+		if !ok {
+			ctx.AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+		if !b.policy.Allowed(roles, perm) {
+			ctx.AbortWithStatus(http.StatusForbidden)
+			return
+		}
+		ctx.Next()
+	}
+}
@@ -4,4 +4,6 @@ type Result struct {
 	Code int    `json:"code"`
 	Msg  string `json:"msg"`
 	Data any    `json:"data"`
+	// CaptchaRequired 告诉前端下一次请求需要带上验证码
+	CaptchaRequired bool `json:"captchaRequired,omitempty"`
 }
@@ -0,0 +1,82 @@
+package rbac
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"webook/pkg/rbac"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var ErrNoToken = errors.New("没有 token")
+
+// RolesFunc 从请求里面拿到调用者的角色
+type RolesFunc func(ctx context.Context) ([]string, error)
+
+type InterceptorBuilder struct {
+	policy *rbac.Policy
+	// perms 方法的全名到需要的权限，例如 /intr.v1.InteractiveService/Like，
+	// 没有配置的方法不做检查
+	perms map[string]string
+	roles RolesFunc
+}
+
+func NewInterceptorBuilder(policy *rbac.Policy, perms map[string]string,
+	roles RolesFunc) *InterceptorBuilder {
+	return &InterceptorBuilder{
+		policy: policy,
+		perms:  perms,
+		roles:  roles,
+	}
+}
+
+func (b *InterceptorBuilder) BuildServerUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any,
+		info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		perm, ok := b.perms[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+		roles, err := b.roles(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, "身份校验失败")
+		}
+		if !b.policy.Allowed(roles, perm) {
+			return nil, status.Errorf(codes.PermissionDenied, "没有权限 %s", perm)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Claims 只关心 token 里面的角色
+type Claims struct {
+	jwt.RegisteredClaims
+	Roles []string
+}
+
+// JWTRoles 从 metadata 的 authorization 里面解析 Bearer token，
+// keyfunc 一般是 webook 公开的 JWKS
+func JWTRoles(keyfunc jwt.Keyfunc) RolesFunc {
+	return func(ctx context.Context) ([]string, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		vals := md.Get("authorization")
+		if len(vals) == 0 {
+			return nil, ErrNoToken
+		}
+		tokenStr, ok := strings.CutPrefix(vals[0], "Bearer ")
+		if !ok {
+			return nil, ErrNoToken
+		}
+		var claims Claims
+		_, err := jwt.ParseWithClaims(tokenStr, &claims, keyfunc)
+		if err != nil {
+			return nil, err
+		}
+		return claims.Roles, nil
+	}
+}
@@ -0,0 +1,86 @@
+package rbac
+
+import (
+	"context"
+	"testing"
+	"time"
+	"webook/pkg/rbac"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestInterceptorBuilder_BuildServerUnaryInterceptor(t *testing.T) {
+	key := []byte("key")
+	keyfunc := func(token *jwt.Token) (any, error) {
+		return key, nil
+	}
+	sign := func(roles ...string) string {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, Claims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+			},
+			Roles: roles,
+		}).SignedString(key)
+		assert.NoError(t, err)
+		return token
+	}
+	interceptor := NewInterceptorBuilder(
+		rbac.NewPolicy(map[string][]string{"admin": {"*"}}),
+		map[string]string{"/test.Service/Admin": "test:admin"},
+		JWTRoles(keyfunc)).BuildServerUnaryInterceptor()
+	handler := func(ctx context.Context, req any) (any, error) {
+		return "ok", nil
+	}
+
+	testCases := []struct {
+		name   string
+		method string
+		token  string
+
+		wantCode codes.Code
+	}{
+		{
+			name:     "不需要权限的方法",
+			method:   "/test.Service/Public",
+			wantCode: codes.OK,
+		},
+		{
+			name:     "没有 token",
+			method:   "/test.Service/Admin",
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "token 不对",
+			method:   "/test.Service/Admin",
+			token:    "Bearer abc",
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "没有权限",
+			method:   "/test.Service/Admin",
+			token:    "Bearer " + sign("user"),
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "有权限",
+			method:   "/test.Service/Admin",
+			token:    "Bearer " + sign("user", "admin"),
+			wantCode: codes.OK,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.token != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tc.token))
+			}
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tc.method}, handler)
+			assert.Equal(t, tc.wantCode, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,220 @@
+package mdx
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+// Heading 目录里面的一项，Id 和 HTML 里面标题的 id 属性一致，前端可以直接做锚点
+type Heading struct {
+	Level int
+	Id    string
+	Title string
+}
+
+// Document 渲染的结果
+type Document struct {
+	// HTML 已经过滤过了，可以直接输出给前端
+	HTML string
+	TOC  []Heading
+	// Text 去掉了所有标记的纯文本，连续的空白会合并成一个空格
+	Text string
+}
+
+var (
+	md = goldmark.New(
+		goldmark.WithExtensions(extension.GFM),
+		goldmark.WithParserOptions(parser.WithAutoHeadingID()),
+	)
+	policy = newPolicy()
+)
+
+// newPolicy 在 UGC 白名单的基础上放开标题的 id 和代码块的语言，
+// goldmark 默认不输出原始 HTML，这里再过滤一遍是兜底
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	p.AllowAttrs("id").Matching(regexp.MustCompile(`^[\p{L}\p{N}_-]+$`)).
+		OnElements("h1", "h2", "h3", "h4", "h5", "h6")
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^language-[\w+-]+$`)).
+		OnElements("code")
+	p.RequireNoFollowOnLinks(true)
+	p.AddTargetBlankToFullyQualifiedLinks(true)
+	return p
+}
+
+type Option func(o *options)
+
+type options struct {
+	resolve func(dest string) string
+}
+
+// WithLinkResolver 渲染之前改写链接和图片的地址，例如把 media:123 换成真实的 URL，
+// 返回空字符串表示不改
+func WithLinkResolver(fn func(dest string) string) Option {
+	return func(o *options) {
+		o.resolve = fn
+	}
+}
+
+// Render 把 Markdown 渲染成安全的 HTML，同时生成目录和纯文本
+func Render(src string, opts ...Option) (Document, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	source := []byte(src)
+	ctx := parser.NewContext(parser.WithIDs(&headingIDs{seen: map[string]int{}}))
+	doc := md.Parser().Parse(text.NewReader(source), parser.WithContext(ctx))
+	if o.resolve != nil {
+		resolveLinks(doc, o.resolve)
+	}
+
+	toc, plain, err := walk(doc, source)
+	if err != nil {
+		return Document{}, err
+	}
+
+	var buf bytes.Buffer
+	err = md.Renderer().Render(&buf, source, doc)
+	if err != nil {
+		return Document{}, err
+	}
+	return Document{
+		HTML: policy.Sanitize(buf.String()),
+		TOC:  toc,
+		Text: plain,
+	}, nil
+}
+
+// PlainText 只提取纯文本，不渲染 HTML
+func PlainText(src string) string {
+	source := []byte(src)
+	doc := md.Parser().Parse(text.NewReader(source))
+	_, plain, _ := walk(doc, source)
+	return plain
+}
+
+func walk(doc ast.Node, source []byte) ([]Heading, string, error) {
+	var (
+		toc   []Heading
+		plain strings.Builder
+	)
+	err := ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			// 块之间用空格隔开，不然两段的文字会粘在一起
+			if n.Type() == ast.TypeBlock {
+				plain.WriteByte(' ')
+			}
+			return ast.WalkContinue, nil
+		}
+		switch node := n.(type) {
+		case *ast.Heading:
+			id, _ := node.AttributeString("id")
+			idBytes, _ := id.([]byte)
+			toc = append(toc, Heading{
+				Level: node.Level,
+				Id:    string(idBytes),
+				Title: collapse(nodeText(node, source)),
+			})
+		case *ast.Text:
+			plain.Write(node.Segment.Value(source))
+			if node.SoftLineBreak() || node.HardLineBreak() {
+				plain.WriteByte(' ')
+			}
+		case *ast.String:
+			plain.Write(node.Value)
+		case *ast.CodeBlock, *ast.FencedCodeBlock:
+			lines := node.Lines()
+			for i := 0; i < lines.Len(); i++ {
+				seg := lines.At(i)
+				plain.Write(seg.Value(source))
+			}
+		}
+		return ast.WalkContinue, nil
+	})
+	return toc, collapse(plain.String()), err
+}
+
+func resolveLinks(doc ast.Node, resolve func(dest string) string) {
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		switch node := n.(type) {
+		case *ast.Image:
+			if dest := resolve(string(node.Destination)); dest != "" {
+				node.Destination = []byte(dest)
+			}
+		case *ast.Link:
+			if dest := resolve(string(node.Destination)); dest != "" {
+				node.Destination = []byte(dest)
+			}
+		}
+		return ast.WalkContinue, nil
+	})
+}
+
+// nodeText 拼接 n 下面所有的文字
+func nodeText(n ast.Node, source []byte) string {
+	var sb strings.Builder
+	_ = ast.Walk(n, func(c ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		switch node := c.(type) {
+		case *ast.Text:
+			sb.Write(node.Segment.Value(source))
+		case *ast.String:
+			sb.Write(node.Value)
+		}
+		return ast.WalkContinue, nil
+	})
+	return sb.String()
+}
+
+// headingIDs goldmark 默认的实现会丢掉中文，中文标题全都变成 heading-N
+type headingIDs struct {
+	seen map[string]int
+}
+
+func (h *headingIDs) Generate(value []byte, _ ast.NodeKind) []byte {
+	var sb strings.Builder
+	for _, r := range strings.ToLower(string(value)) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsNumber(r) || r == '_' || r == '-':
+			sb.WriteRune(r)
+		case unicode.IsSpace(r):
+			sb.WriteByte('-')
+		}
+	}
+	id := strings.Trim(sb.String(), "-")
+	if id == "" {
+		id = "heading"
+	}
+	// 重复的标题加上序号
+	if cnt, ok := h.seen[id]; ok {
+		h.seen[id] = cnt + 1
+		id = id + "-" + strconv.Itoa(cnt+1)
+	} else {
+		h.seen[id] = 0
+	}
+	return []byte(id)
+}
+
+func (h *headingIDs) Put(value []byte) {
+	h.seen[string(value)] = 0
+}
+
+func collapse(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,85 @@
+package mdx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender_LinkResolver(t *testing.T) {
+	resolve := func(dest string) string {
+		if dest == "media:1" {
+			return "https://cdn.example.com/1.png"
+		}
+		return ""
+	}
+	doc, err := Render("![图](media:1)\n\n![别人的](media:2)", WithLinkResolver(resolve))
+	require.NoError(t, err)
+	// 没有解析出来的地址不是合法的 URL，会被过滤掉
+	assert.Equal(t, "<p><img src=\"https://cdn.example.com/1.png\" alt=\"图\"></p>\n"+
+		"<p><img alt=\"别人的\"></p>\n", doc.HTML)
+}
+
+func TestRender(t *testing.T) {
+	testCases := []struct {
+		name     string
+		src      string
+		wantHTML string
+		wantTOC  []Heading
+		wantText string
+	}{
+		{
+			name:     "普通段落",
+			src:      "正文 **加粗**\n第二行",
+			wantHTML: "<p>正文 <strong>加粗</strong>\n第二行</p>\n",
+			wantText: "正文 加粗 第二行",
+		},
+		{
+			name: "目录，中文标题和重复的标题",
+			src:  "# 标题\n\n## Second Title\n\n## 标题",
+			wantHTML: "<h1 id=\"标题\">标题</h1>\n" +
+				"<h2 id=\"second-title\">Second Title</h2>\n" +
+				"<h2 id=\"标题-1\">标题</h2>\n",
+			wantTOC: []Heading{
+				{Level: 1, Id: "标题", Title: "标题"},
+				{Level: 2, Id: "second-title", Title: "Second Title"},
+				{Level: 2, Id: "标题-1", Title: "标题"},
+			},
+			wantText: "标题 Second Title 标题",
+		},
+		{
+			name:     "过滤脚本",
+			src:      "<script>alert(1)</script>\n\n<img src=x onerror=alert(1)>",
+			wantHTML: "\n\n",
+			wantText: "",
+		},
+		{
+			name:     "过滤 javascript 链接",
+			src:      "[点我](javascript:alert(1))",
+			wantHTML: "<p>点我</p>\n",
+			wantText: "点我",
+		},
+		{
+			name:     "外链",
+			src:      "[链接](https://example.com)",
+			wantHTML: "<p><a href=\"https://example.com\" rel=\"nofollow noopener\" target=\"_blank\">链接</a></p>\n",
+			wantText: "链接",
+		},
+		{
+			name:     "代码块",
+			src:      "```go\nfmt.Println(1)\n```",
+			wantHTML: "<pre><code class=\"language-go\">fmt.Println(1)\n</code></pre>\n",
+			wantText: "fmt.Println(1)",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			doc, err := Render(tc.src)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantHTML, doc.HTML)
+			assert.Equal(t, tc.wantTOC, doc.TOC)
+			assert.Equal(t, tc.wantText, doc.Text)
+		})
+	}
+}
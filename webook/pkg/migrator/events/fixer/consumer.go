@@ -16,14 +16,17 @@ import (
 
 type Consumer[T migrator.Entity] struct {
 	client   sarama.Client
+	producer sarama.SyncProducer
 	l        logger.LoggerV1
 	srcFirst *fixer.OverrideFixer[T]
 	dstFirst *fixer.OverrideFixer[T]
 	topic    string
 }
 
+// NewConsumer producer 用来投递死信队列
 func NewConsumer[T migrator.Entity](
 	client sarama.Client,
+	producer sarama.SyncProducer,
 	l logger.LoggerV1,
 	topic string,
 	src *gorm.DB,
@@ -38,6 +41,7 @@ func NewConsumer[T migrator.Entity](
 	}
 	return &Consumer[T]{
 		client:   client,
+		producer: producer,
 		l:        l,
 		srcFirst: srcFirst,
 		dstFirst: dstFirst,
@@ -55,7 +59,8 @@ func (r *Consumer[T]) Start() error {
 	go func() {
 		err := cg.Consume(context.Background(),
 			[]string{r.topic},
-			saramax.NewHandler[events.InconsistentEvent](r.l, r.Consume))
+			saramax.NewHandler[events.InconsistentEvent](r.l, r.Consume,
+				saramax.WithDeadLetter(saramax.NewDeadLetter(r.producer, ""))))
 		if err != nil {
 			r.l.Error("退出了消费循环异常", logger.Error(err))
 		}
@@ -0,0 +1,31 @@
+package netx
+
+import (
+	"context"
+	"net"
+)
+
+// Locator 根据 IP 解析出大概的地理位置
+type Locator interface {
+	Locate(ctx context.Context, ip string) string
+}
+
+// LocalLocator 本地的替身实现，只能区分内网和外网
+// 真正上线的时候可以替换成 IP 库或者第三方的定位服务
+type LocalLocator struct {
+}
+
+func NewLocalLocator() *LocalLocator {
+	return &LocalLocator{}
+}
+
+func (l *LocalLocator) Locate(ctx context.Context, ip string) string {
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return "未知"
+	}
+	if addr.IsLoopback() || addr.IsPrivate() {
+		return "局域网"
+	}
+	return "未知"
+}
@@ -0,0 +1,72 @@
+package objstore
+
+import (
+	"context"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStore(t *testing.T) {
+	s := NewLocalStore(t.TempDir(), "/media/files/")
+	ctx := context.Background()
+
+	err := s.Put(ctx, "1/a.png", strings.NewReader("hello"), 5, "image/png")
+	require.NoError(t, err)
+
+	r, err := s.Get(ctx, "1/a.png")
+	require.NoError(t, err)
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.NoError(t, r.Close())
+	assert.Equal(t, "hello", string(data))
+	assert.Equal(t, "/media/files/1/a.png", s.URL("1/a.png"))
+
+	require.NoError(t, s.Delete(ctx, "1/a.png"))
+	_, err = s.Get(ctx, "1/a.png")
+	assert.Equal(t, ErrObjectNotFound, err)
+
+	// 不能跳出目录
+	_, err = s.Get(ctx, "../etc/passwd")
+	assert.Equal(t, ErrObjectNotFound, err)
+	err = s.Put(ctx, "1/../../a", strings.NewReader("x"), 1, "text/plain")
+	assert.Equal(t, ErrObjectNotFound, err)
+}
+
+func TestLocalStore_DeleteBefore(t *testing.T) {
+	s := NewLocalStore(t.TempDir(), "/media/files")
+	ctx := context.Background()
+	for _, key := range []string{"exports/1/a.zip", "exports/2/b.zip", "media/1/c.png"} {
+		err := s.Put(ctx, key, strings.NewReader("x"), 1, "application/zip")
+		require.NoError(t, err)
+	}
+	old := time.Now().Add(-time.Hour)
+	p, err := s.path("exports/1/a.zip")
+	require.NoError(t, err)
+	require.NoError(t, os.Chtimes(p, old, old))
+	p, err = s.path("media/1/c.png")
+	require.NoError(t, err)
+	require.NoError(t, os.Chtimes(p, old, old))
+
+	cnt, err := s.DeleteBefore(ctx, "exports/", time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, 1, cnt)
+	_, err = s.Get(ctx, "exports/1/a.zip")
+	assert.Equal(t, ErrObjectNotFound, err)
+	// 没有过期的，以及别的前缀下面的都不会删
+	for _, key := range []string{"exports/2/b.zip", "media/1/c.png"} {
+		r, err := s.Get(ctx, key)
+		require.NoError(t, err)
+		assert.NoError(t, r.Close())
+	}
+
+	// 前缀不存在
+	cnt, err = s.DeleteBefore(ctx, "nothing/", time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, 0, cnt)
+}
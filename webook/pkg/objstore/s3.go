@@ -0,0 +1,82 @@
+package objstore
+
+import (
+	"context"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+)
+
+// S3Store 兼容 S3 协议的对象存储，例如 AWS S3、腾讯云 COS、阿里云 OSS 和 MinIO
+type S3Store struct {
+	client *minio.Client
+	bucket string
+	// baseURL 一般是 CDN 或者 bucket 的公开访问地址
+	baseURL string
+}
+
+func NewS3Store(client *minio.Client, bucket string, baseURL string) *S3Store {
+	return &S3Store{
+		client:  client,
+		bucket:  bucket,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+func (s *S3Store) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	_, err := s.client.PutObject(ctx, s.bucket, key, r, size, minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	return err
+}
+
+func (s *S3Store) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	obj, err := s.client.GetObject(ctx, s.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	// GetObject 是懒加载的，要 Stat 一下才知道存不存在
+	_, err = obj.Stat()
+	if err != nil {
+		_ = obj.Close()
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, ErrObjectNotFound
+		}
+		return nil, err
+	}
+	return obj, nil
+}
+
+func (s *S3Store) Delete(ctx context.Context, key string) error {
+	return s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{})
+}
+
+func (s *S3Store) URL(key string) string {
+	return s.baseURL + "/" + key
+}
+
+func (s *S3Store) DeleteBefore(ctx context.Context, prefix string, before time.Time) (int, error) {
+	// 提前返回的时候要取消掉，不然 ListObjects 的 goroutine 会一直阻塞
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	cnt := 0
+	for obj := range s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{
+		Prefix:    prefix,
+		Recursive: true,
+	}) {
+		if obj.Err != nil {
+			return cnt, obj.Err
+		}
+		if !obj.LastModified.Before(before) {
+			continue
+		}
+		err := s.Delete(ctx, obj.Key)
+		if err != nil {
+			return cnt, err
+		}
+		cnt++
+	}
+	return cnt, nil
+}
@@ -0,0 +1,23 @@
+package objstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+)
+
+var ErrObjectNotFound = errors.New("对象不存在")
+
+// Store 对象存储，key 用 / 分隔，不要以 / 开头
+type Store interface {
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	// Get 调用者负责关闭返回的 ReadCloser
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+	// URL 前端访问 key 用的地址
+	URL(key string) string
+	// DeleteBefore 删除 prefix 下面最后修改时间早于 before 的对象，返回删除了多少个，
+	// 用来清理有有效期的对象
+	DeleteBefore(ctx context.Context, prefix string, before time.Time) (int, error)
+}
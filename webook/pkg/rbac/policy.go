@@ -0,0 +1,42 @@
+// Package rbac 基于角色的权限控制，用户身上只记录角色，
+// 角色对应哪些权限由 Policy 决定，改权限不需要改用户数据
+package rbac
+
+import "strings"
+
+// Policy 角色到权限的映射。
+// 权限的格式是 资源:操作，例如 migrator:write；
+// 支持通配符，* 表示所有权限，migrator:* 表示 migrator 下面的所有权限
+type Policy struct {
+	roles map[string][]string
+}
+
+func NewPolicy(roles map[string][]string) *Policy {
+	return &Policy{roles: roles}
+}
+
+// Allowed 只要有一个角色拥有这个权限就可以
+func (p *Policy) Allowed(roles []string, perm string) bool {
+	for _, role := range roles {
+		for _, granted := range p.roles[role] {
+			if match(granted, perm) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// HasRole 角色是不是在配置里面，给用户分配角色之前检查一下
+func (p *Policy) HasRole(role string) bool {
+	_, ok := p.roles[role]
+	return ok
+}
+
+func match(granted string, perm string) bool {
+	if granted == "*" || granted == perm {
+		return true
+	}
+	prefix, ok := strings.CutSuffix(granted, "*")
+	return ok && strings.HasPrefix(perm, prefix)
+}
@@ -0,0 +1,35 @@
+package rbac
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicy_Allowed(t *testing.T) {
+	p := NewPolicy(map[string][]string{
+		"admin":    {"*"},
+		"migrator": {"migrator:*"},
+		"editor":   {"article:write", "article:publish"},
+	})
+	testCases := []struct {
+		name  string
+		roles []string
+		perm  string
+		want  bool
+	}{
+		{name: "没有角色", perm: "migrator:write", want: false},
+		{name: "未知角色", roles: []string{"unknown"}, perm: "migrator:write", want: false},
+		{name: "管理员拥有所有权限", roles: []string{"admin"}, perm: "user:role:write", want: true},
+		{name: "前缀通配符", roles: []string{"migrator"}, perm: "migrator:write", want: true},
+		{name: "前缀通配符不匹配", roles: []string{"migrator"}, perm: "user:role:write", want: false},
+		{name: "精确匹配", roles: []string{"editor"}, perm: "article:publish", want: true},
+		{name: "精确不匹配", roles: []string{"editor"}, perm: "article:delete", want: false},
+		{name: "多个角色取并集", roles: []string{"editor", "migrator"}, perm: "migrator:read", want: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, p.Allowed(tc.roles, tc.perm))
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package saramax
+
+import (
+	"encoding/json"
+	"time"
+	"webook/pkg/logger"
+
+	"github.com/IBM/sarama"
+)
+
+// BatchHandler 攒够 batchSize 条消息，或者等了 batchDuration 之后，一次性交给 fn 处理。
+// fn 成功之后才会提交这一批的偏移量，失败了按照 RetryPolicy 退避重试，不会丢掉这一批。
+// 重试和死信队列和 Handler 一样，只是整批一起重试、一起投递
+type BatchHandler[T any] struct {
+	l             logger.LoggerV1
+	fn            func(msgs []*sarama.ConsumerMessage, ts []T) error
+	batchSize     int
+	batchDuration time.Duration
+	retry         RetryPolicy
+	dlq           *DeadLetter
+}
+
+// NewBatchHandler 没有配置死信队列的时候不管 MaxRetries，一直重试到成功或者会话结束
+func NewBatchHandler[T any](l logger.LoggerV1, batchSize int, batchDuration time.Duration,
+	fn func(msgs []*sarama.ConsumerMessage, ts []T) error, opts ...Option) *BatchHandler[T] {
+	o := handlerOptions{
+		retry: DefaultRetryPolicy,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return &BatchHandler[T]{
+		l:             l,
+		fn:            fn,
+		batchSize:     batchSize,
+		batchDuration: batchDuration,
+		retry:         o.retry,
+		dlq:           o.dlq,
+	}
+}
+
+func (h *BatchHandler[T]) Setup(session sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (h *BatchHandler[T]) Cleanup(session sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (h *BatchHandler[T]) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	msgs := claim.Messages()
+	for {
+		batch := make([]*sarama.ConsumerMessage, 0, h.batchSize)
+		ts := make([]T, 0, h.batchSize)
+		// 从这一批的第一条消息开始计时，没有消息的时候不需要空转
+		var timeout <-chan time.Time
+		closed, done := false, false
+		for len(batch) < h.batchSize && !done {
+			select {
+			case <-timeout:
+				done = true
+			case <-session.Context().Done():
+				// 重平衡了，没有处理完的消息会交给别的消费者
+				return nil
+			case msg, ok := <-msgs:
+				if !ok {
+					closed, done = true, true
+					break
+				}
+				if timeout == nil {
+					timeout = time.After(h.batchDuration)
+				}
+				var t T
+				err := json.Unmarshal(msg.Value, &t)
+				if err != nil {
+					h.l.Error("反序列化失败",
+						logger.String("topic", msg.Topic),
+						logger.Int32("partition", msg.Partition),
+						logger.Int64("offset", msg.Offset),
+						logger.Error(err))
+					if !sendDeadLetter(h.l, h.dlq, msg, err, 0) {
+						// 后面的消息也不能提交，不然会把这条跳过去
+						return nil
+					}
+					// 前面还有没处理的消息的话，等这一批处理完一起提交
+					if len(batch) == 0 {
+						session.MarkMessage(msg, "")
+					}
+					continue
+				}
+				batch = append(batch, msg)
+				ts = append(ts, t)
+			}
+		}
+		if !h.flush(session, batch, ts) {
+			// 重平衡了，这一批没有提交，会交给别的消费者
+			return nil
+		}
+		if closed {
+			return nil
+		}
+	}
+}
+
+// flush 返回 false 说明会话结束了，或者投递死信队列失败了，这一批不能提交
+func (h *BatchHandler[T]) flush(session sarama.ConsumerGroupSession,
+	batch []*sarama.ConsumerMessage, ts []T) bool {
+	if len(batch) == 0 {
+		return true
+	}
+	retries := 0
+	err := h.fn(batch, ts)
+	for err != nil && (h.dlq == nil || retries < h.retry.MaxRetries) {
+		last := batch[len(batch)-1]
+		h.l.Error("批量消费失败",
+			logger.String("topic", last.Topic),
+			logger.Int32("partition", last.Partition),
+			logger.Int64("offset", last.Offset),
+			logger.Int("size", len(batch)),
+			logger.Int("retries", retries),
+			logger.Error(err))
+		select {
+		case <-session.Context().Done():
+			return false
+		case <-time.After(h.retry.Interval(retries)):
+		}
+		retries++
+		err = h.fn(batch, ts)
+	}
+	if err != nil {
+		// 重试完了还是失败，整批投递到死信队列
+		for _, msg := range batch {
+			if !sendDeadLetter(h.l, h.dlq, msg, err, retries) {
+				return false
+			}
+		}
+	}
+	for _, msg := range batch {
+		session.MarkMessage(msg, "")
+	}
+	return true
+}
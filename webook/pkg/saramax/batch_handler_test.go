@@ -0,0 +1,215 @@
+package saramax
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+	"webook/pkg/logger"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchHandler_ConsumeClaim(t *testing.T) {
+	testCases := []struct {
+		name string
+		// msgs 发送完之后就关闭 channel
+		msgs      []string
+		batchSize int
+		// errs fn 每次调用返回的错误，超出的部分返回 nil
+		errs []error
+		// alwaysFail fn 一直失败，直到会话结束
+		alwaysFail bool
+
+		wantBatches [][]int
+		wantMarked  []int64
+	}{
+		{
+			name:        "攒够一批就处理，剩下的在关闭的时候处理",
+			msgs:        []string{`{"id":1}`, `{"id":2}`, `{"id":3}`},
+			batchSize:   2,
+			wantBatches: [][]int{{1, 2}, {3}},
+			wantMarked:  []int64{0, 1, 2},
+		},
+		{
+			name:        "反序列化失败的跳过",
+			msgs:        []string{`{"id":1}`, `abc`, `{"id":3}`},
+			batchSize:   10,
+			wantBatches: [][]int{{1, 3}},
+			wantMarked:  []int64{0, 2},
+		},
+		{
+			name:        "处理失败重试这一批，成功之后再处理下一批",
+			msgs:        []string{`{"id":1}`, `{"id":2}`, `{"id":3}`},
+			batchSize:   2,
+			errs:        []error{errors.New("mock error"), errors.New("mock error")},
+			wantBatches: [][]int{{1, 2}, {1, 2}, {1, 2}, {3}},
+			wantMarked:  []int64{0, 1, 2},
+		},
+		{
+			name:       "一直失败，会话结束了也不提交",
+			msgs:       []string{`{"id":1}`, `{"id":2}`},
+			batchSize:  10,
+			alwaysFail: true,
+		},
+	}
+
+	type Evt struct {
+		Id int `json:"id"`
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var batches [][]int
+			h := NewBatchHandler[Evt](logger.NewNopLogger(), tc.batchSize, time.Second,
+				func(msgs []*sarama.ConsumerMessage, ts []Evt) error {
+					ids := make([]int, 0, len(ts))
+					for _, evt := range ts {
+						ids = append(ids, evt.Id)
+					}
+					batches = append(batches, ids)
+					if tc.alwaysFail {
+						return errors.New("mock error")
+					}
+					if len(batches) <= len(tc.errs) {
+						return tc.errs[len(batches)-1]
+					}
+					return nil
+				}, WithRetry(RetryPolicy{Initial: time.Millisecond}))
+			ch := make(chan *sarama.ConsumerMessage, len(tc.msgs))
+			for i, m := range tc.msgs {
+				ch <- &sarama.ConsumerMessage{Offset: int64(i), Value: []byte(m)}
+			}
+			close(ch)
+			ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+			defer cancel()
+			session := &fakeSession{ctx: ctx}
+			err := h.ConsumeClaim(session, fakeClaim{msgs: ch})
+			assert.NoError(t, err)
+			if tc.alwaysFail {
+				assert.True(t, len(batches) > 1)
+			} else {
+				assert.Equal(t, tc.wantBatches, batches)
+			}
+			assert.Equal(t, tc.wantMarked, session.marked)
+		})
+	}
+}
+
+func TestBatchHandler_ConsumeClaimTimeout(t *testing.T) {
+	flushed := make(chan int, 1)
+	h := NewBatchHandler[int](logger.NewNopLogger(), 10, time.Millisecond*50,
+		func(msgs []*sarama.ConsumerMessage, ts []int) error {
+			flushed <- len(ts)
+			return nil
+		})
+	ch := make(chan *sarama.ConsumerMessage, 1)
+	ch <- &sarama.ConsumerMessage{Value: []byte("1")}
+	session := &fakeSession{ctx: context.Background()}
+	go func() {
+		// 没有攒够一批，超时之后也要处理
+		select {
+		case n := <-flushed:
+			assert.Equal(t, 1, n)
+		case <-time.After(time.Second):
+			t.Error("超时之后没有处理")
+		}
+		close(ch)
+	}()
+	err := h.ConsumeClaim(session, fakeClaim{msgs: ch})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{0}, session.marked)
+}
+
+func TestBatchHandler_ConsumeClaimDeadLetter(t *testing.T) {
+	testCases := []struct {
+		name     string
+		msgs     []string
+		producer *fakeProducer
+
+		wantCalls  int
+		wantDLQ    []int64
+		wantMarked []int64
+	}{
+		{
+			name:       "重试完了整批投递死信队列，然后提交",
+			msgs:       []string{`1`, `2`},
+			producer:   &fakeProducer{},
+			wantCalls:  3,
+			wantDLQ:    []int64{0, 1},
+			wantMarked: []int64{0, 1},
+		},
+		{
+			name:      "投递死信队列失败，不提交",
+			msgs:      []string{`1`, `2`},
+			producer:  &fakeProducer{err: errors.New("mock kafka 错误")},
+			wantCalls: 3,
+		},
+		{
+			name:       "反序列化失败的直接投递死信队列",
+			msgs:       []string{`abc`},
+			producer:   &fakeProducer{},
+			wantDLQ:    []int64{0},
+			wantMarked: []int64{0},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			calls := 0
+			h := NewBatchHandler[int](logger.NewNopLogger(), 10, time.Millisecond*10,
+				func(msgs []*sarama.ConsumerMessage, ts []int) error {
+					calls++
+					return errors.New("mock error")
+				},
+				WithRetry(RetryPolicy{MaxRetries: 2, Initial: time.Millisecond}),
+				WithDeadLetter(NewDeadLetter(tc.producer, "")))
+			ch := make(chan *sarama.ConsumerMessage, len(tc.msgs))
+			for i, m := range tc.msgs {
+				ch <- &sarama.ConsumerMessage{Topic: "article_read", Offset: int64(i), Value: []byte(m)}
+			}
+			close(ch)
+			session := &fakeSession{ctx: context.Background()}
+			err := h.ConsumeClaim(session, fakeClaim{msgs: ch})
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantCalls, calls)
+			var dlq []int64
+			for _, msg := range tc.producer.msgs {
+				assert.Equal(t, "article_read_dead_letter", msg.Topic)
+				for _, hd := range msg.Headers {
+					if string(hd.Key) == HeaderOffset {
+						offset, err := strconv.ParseInt(string(hd.Value), 10, 64)
+						require.NoError(t, err)
+						dlq = append(dlq, offset)
+					}
+				}
+			}
+			assert.Equal(t, tc.wantDLQ, dlq)
+			assert.Equal(t, tc.wantMarked, session.marked)
+		})
+	}
+}
+
+type fakeSession struct {
+	sarama.ConsumerGroupSession
+	ctx    context.Context
+	marked []int64
+}
+
+func (s *fakeSession) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeSession) MarkMessage(msg *sarama.ConsumerMessage, metadata string) {
+	s.marked = append(s.marked, msg.Offset)
+}
+
+type fakeClaim struct {
+	sarama.ConsumerGroupClaim
+	msgs chan *sarama.ConsumerMessage
+}
+
+func (c fakeClaim) Messages() <-chan *sarama.ConsumerMessage {
+	return c.msgs
+}
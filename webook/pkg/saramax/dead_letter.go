@@ -0,0 +1,104 @@
+package saramax
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/sarama"
+)
+
+const (
+	// DeadLetterSuffix 没有指定死信队列的时候，投递到原来的 topic 加上这个后缀
+	DeadLetterSuffix = "_dead_letter"
+
+	// 死信消息在原来的 header 之外，额外带上这些 header
+	headerPrefix    = "x-dlq-"
+	HeaderTopic     = headerPrefix + "topic"
+	HeaderPartition = headerPrefix + "partition"
+	HeaderOffset    = headerPrefix + "offset"
+	HeaderError     = headerPrefix + "error"
+	HeaderRetries   = headerPrefix + "retries"
+	HeaderTime      = headerPrefix + "time"
+)
+
+var ErrNotDeadLetter = errors.New("不是死信消息，缺少原来的 topic")
+
+// DeadLetter 把消费失败的消息原样投递到死信队列，key、value 和 header 都保留，
+// 另外记录原来的位置和失败的原因，方便排查和重新投递
+type DeadLetter struct {
+	producer sarama.SyncProducer
+	topic    string
+}
+
+// NewDeadLetter topic 为空的时候投递到 原来的 topic + DeadLetterSuffix
+func NewDeadLetter(producer sarama.SyncProducer, topic string) *DeadLetter {
+	return &DeadLetter{
+		producer: producer,
+		topic:    topic,
+	}
+}
+
+func (d *DeadLetter) Send(msg *sarama.ConsumerMessage, cause error, retries int) error {
+	topic := d.topic
+	if topic == "" {
+		topic = msg.Topic + DeadLetterSuffix
+	}
+	headers := make([]sarama.RecordHeader, 0, len(msg.Headers)+6)
+	for _, hd := range msg.Headers {
+		headers = append(headers, *hd)
+	}
+	headers = append(headers,
+		header(HeaderTopic, msg.Topic),
+		header(HeaderPartition, strconv.FormatInt(int64(msg.Partition), 10)),
+		header(HeaderOffset, strconv.FormatInt(msg.Offset, 10)),
+		header(HeaderError, cause.Error()),
+		header(HeaderRetries, strconv.Itoa(retries)),
+		header(HeaderTime, strconv.FormatInt(time.Now().UnixMilli(), 10)),
+	)
+	_, _, err := d.producer.SendMessage(&sarama.ProducerMessage{
+		Topic:   topic,
+		Key:     byteEncoder(msg.Key),
+		Value:   byteEncoder(msg.Value),
+		Headers: headers,
+	})
+	return err
+}
+
+// ReplayMessage 把死信消息还原成投递到原来 topic 的消息，去掉死信队列加上的 header
+func ReplayMessage(msg *sarama.ConsumerMessage) (*sarama.ProducerMessage, error) {
+	var topic string
+	headers := make([]sarama.RecordHeader, 0, len(msg.Headers))
+	for _, hd := range msg.Headers {
+		key := string(hd.Key)
+		if key == HeaderTopic {
+			topic = string(hd.Value)
+		}
+		if strings.HasPrefix(key, headerPrefix) {
+			continue
+		}
+		headers = append(headers, *hd)
+	}
+	if topic == "" {
+		return nil, ErrNotDeadLetter
+	}
+	return &sarama.ProducerMessage{
+		Topic:   topic,
+		Key:     byteEncoder(msg.Key),
+		Value:   byteEncoder(msg.Value),
+		Headers: headers,
+	}, nil
+}
+
+func header(key, val string) sarama.RecordHeader {
+	return sarama.RecordHeader{Key: []byte(key), Value: []byte(val)}
+}
+
+// byteEncoder 没有 key 的消息要保持没有 key，不然会影响分区
+func byteEncoder(data []byte) sarama.Encoder {
+	if data == nil {
+		return nil
+	}
+	return sarama.ByteEncoder(data)
+}
@@ -2,20 +2,58 @@ package saramax
 
 import (
 	"encoding/json"
+	"time"
 	"webook/pkg/logger"
 
 	"github.com/IBM/sarama"
 )
 
+// Handler 一条一条消费。fn 失败了会按照 RetryPolicy 重试，
+// 重试完了还是失败，或者反序列化失败的消息，会投递到死信队列，然后提交偏移量。
+// 不会卡在同一条消息上。投递死信队列也失败了就不提交，退出这个分区的消费，
+// 等重新分配之后再从这条消息开始
 type Handler[T any] struct {
-	l  logger.LoggerV1
-	fn func(msg *sarama.ConsumerMessage, evt T) error
+	l     logger.LoggerV1
+	fn    func(msg *sarama.ConsumerMessage, evt T) error
+	retry RetryPolicy
+	dlq   *DeadLetter
 }
 
-func NewHandler[T any](l logger.LoggerV1, fn func(msg *sarama.ConsumerMessage, t T) error) *Handler[T] {
+// Option 配置 Handler 的重试和死信队列
+type Option func(h *handlerOptions)
+
+type handlerOptions struct {
+	retry RetryPolicy
+	dlq   *DeadLetter
+}
+
+// WithRetry MaxRetries 为 0 就是不重试
+func WithRetry(p RetryPolicy) Option {
+	return func(h *handlerOptions) {
+		h.retry = p
+	}
+}
+
+// WithDeadLetter 不配置的话，最终失败的消息只会记录日志
+func WithDeadLetter(dlq *DeadLetter) Option {
+	return func(h *handlerOptions) {
+		h.dlq = dlq
+	}
+}
+
+func NewHandler[T any](l logger.LoggerV1, fn func(msg *sarama.ConsumerMessage, t T) error,
+	opts ...Option) *Handler[T] {
+	o := handlerOptions{
+		retry: DefaultRetryPolicy,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
 	return &Handler[T]{
-		l:  l,
-		fn: fn,
+		l:     l,
+		fn:    fn,
+		retry: o.retry,
+		dlq:   o.dlq,
 	}
 }
 
@@ -30,26 +68,66 @@ func (h *Handler[T]) Cleanup(session sarama.ConsumerGroupSession) error {
 func (h *Handler[T]) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
 	msgs := claim.Messages()
 	for msg := range msgs {
-		var t T
-		err := json.Unmarshal(msg.Value, &t)
-		if err != nil {
-			h.l.Error("反序列化失败",
-				logger.String("topic", msg.Topic),
-				logger.Int32("partition", msg.Partition),
-				logger.Int64("offset", msg.Offset),
-				logger.Error(err))
-			continue
-		}
-		err = h.fn(msg, t)
-		if err != nil {
-			h.l.Error("消费失败",
-				logger.String("topic", msg.Topic),
-				logger.Int32("partition", msg.Partition),
-				logger.Int64("offset", msg.Offset),
-				logger.Error(err))
-			continue
+		if !h.handle(session, msg) {
+			// 重平衡了，或者投递死信队列失败了，这条消息没有提交，会重新消费
+			return nil
 		}
 		session.MarkMessage(msg, "")
 	}
 	return nil
 }
+
+// handle 返回 false 说明会话结束了，或者投递死信队列失败了，这条消息不能提交
+func (h *Handler[T]) handle(session sarama.ConsumerGroupSession, msg *sarama.ConsumerMessage) bool {
+	var t T
+	err := json.Unmarshal(msg.Value, &t)
+	if err != nil {
+		// 格式不对，重试也没有用
+		h.l.Error("反序列化失败",
+			logger.String("topic", msg.Topic),
+			logger.Int32("partition", msg.Partition),
+			logger.Int64("offset", msg.Offset),
+			logger.Error(err))
+		return sendDeadLetter(h.l, h.dlq, msg, err, 0)
+	}
+	retries := 0
+	err = h.fn(msg, t)
+	for err != nil && retries < h.retry.MaxRetries {
+		select {
+		case <-session.Context().Done():
+			return false
+		case <-time.After(h.retry.Interval(retries)):
+		}
+		retries++
+		err = h.fn(msg, t)
+	}
+	if err != nil {
+		h.l.Error("消费失败",
+			logger.String("topic", msg.Topic),
+			logger.Int32("partition", msg.Partition),
+			logger.Int64("offset", msg.Offset),
+			logger.Int("retries", retries),
+			logger.Error(err))
+		return sendDeadLetter(h.l, h.dlq, msg, err, retries)
+	}
+	return true
+}
+
+// sendDeadLetter 返回 false 说明投递失败了，这条消息不能提交。
+// 没有配置死信队列的时候只记录日志
+func sendDeadLetter(l logger.LoggerV1, dlq *DeadLetter,
+	msg *sarama.ConsumerMessage, cause error, retries int) bool {
+	if dlq == nil {
+		return true
+	}
+	err := dlq.Send(msg, cause, retries)
+	if err != nil {
+		l.Error("投递死信队列失败",
+			logger.String("topic", msg.Topic),
+			logger.Int32("partition", msg.Partition),
+			logger.Int64("offset", msg.Offset),
+			logger.Error(err))
+		return false
+	}
+	return true
+}
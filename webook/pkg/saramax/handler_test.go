@@ -0,0 +1,195 @@
+package saramax
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"webook/pkg/logger"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_ConsumeClaim(t *testing.T) {
+	testCases := []struct {
+		name  string
+		value string
+		// errs fn 每次调用返回的错误，超出的部分返回 nil
+		errs []error
+
+		wantCalls   int
+		wantDLQ     bool
+		wantRetries string
+		wantError   string
+	}{
+		{
+			name:      "第一次就成功",
+			value:     `{"id":1}`,
+			wantCalls: 1,
+		},
+		{
+			name:      "重试之后成功",
+			value:     `{"id":1}`,
+			errs:      []error{errors.New("mock error"), errors.New("mock error")},
+			wantCalls: 3,
+		},
+		{
+			name:  "重试完了还是失败，投递死信队列",
+			value: `{"id":1}`,
+			errs: []error{errors.New("mock error"), errors.New("mock error"),
+				errors.New("mock error")},
+			wantCalls:   3,
+			wantDLQ:     true,
+			wantRetries: "2",
+			wantError:   "mock error",
+		},
+		{
+			name:        "反序列化失败不重试，直接投递死信队列",
+			value:       `abc`,
+			wantCalls:   0,
+			wantDLQ:     true,
+			wantRetries: "0",
+			wantError:   "invalid character 'a' looking for beginning of value",
+		},
+	}
+
+	type Evt struct {
+		Id int `json:"id"`
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			calls := 0
+			producer := &fakeProducer{}
+			h := NewHandler[Evt](logger.NewNopLogger(),
+				func(msg *sarama.ConsumerMessage, evt Evt) error {
+					calls++
+					if calls <= len(tc.errs) {
+						return tc.errs[calls-1]
+					}
+					return nil
+				},
+				WithRetry(RetryPolicy{MaxRetries: 2, Initial: time.Millisecond}),
+				WithDeadLetter(NewDeadLetter(producer, "")))
+			ch := make(chan *sarama.ConsumerMessage, 1)
+			ch <- &sarama.ConsumerMessage{
+				Topic:     "user_deleted",
+				Partition: 1,
+				Offset:    12,
+				Key:       []byte("key"),
+				Value:     []byte(tc.value),
+				Headers: []*sarama.RecordHeader{
+					{Key: []byte("trace"), Value: []byte("abc")},
+				},
+			}
+			close(ch)
+			session := &fakeSession{ctx: context.Background()}
+			err := h.ConsumeClaim(session, fakeClaim{msgs: ch})
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantCalls, calls)
+			// 不管成功失败都要提交，不能卡住
+			assert.Equal(t, []int64{12}, session.marked)
+			if !tc.wantDLQ {
+				assert.Empty(t, producer.msgs)
+				return
+			}
+			require.Len(t, producer.msgs, 1)
+			dlq := producer.msgs[0]
+			assert.Equal(t, "user_deleted_dead_letter", dlq.Topic)
+			headers := map[string]string{}
+			for _, hd := range dlq.Headers {
+				headers[string(hd.Key)] = string(hd.Value)
+			}
+			assert.Equal(t, "abc", headers["trace"])
+			assert.Equal(t, "user_deleted", headers[HeaderTopic])
+			assert.Equal(t, "1", headers[HeaderPartition])
+			assert.Equal(t, "12", headers[HeaderOffset])
+			assert.Equal(t, tc.wantRetries, headers[HeaderRetries])
+			assert.Equal(t, tc.wantError, headers[HeaderError])
+		})
+	}
+}
+
+func TestHandler_ConsumeClaimCanceled(t *testing.T) {
+	h := NewHandler[int](logger.NewNopLogger(),
+		func(msg *sarama.ConsumerMessage, evt int) error {
+			return errors.New("mock error")
+		},
+		WithRetry(RetryPolicy{MaxRetries: 3, Initial: time.Hour}))
+	ch := make(chan *sarama.ConsumerMessage, 1)
+	ch <- &sarama.ConsumerMessage{Value: []byte("1")}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	session := &fakeSession{ctx: ctx}
+	err := h.ConsumeClaim(session, fakeClaim{msgs: ch})
+	require.NoError(t, err)
+	// 等重试的时候重平衡了，不能提交
+	assert.Empty(t, session.marked)
+}
+
+func TestHandler_ConsumeClaimDeadLetterFailed(t *testing.T) {
+	calls := 0
+	h := NewHandler[int](logger.NewNopLogger(),
+		func(msg *sarama.ConsumerMessage, evt int) error {
+			calls++
+			return errors.New("mock error")
+		},
+		WithRetry(RetryPolicy{}),
+		WithDeadLetter(NewDeadLetter(&fakeProducer{err: errors.New("mock kafka 错误")}, "")))
+	ch := make(chan *sarama.ConsumerMessage, 2)
+	ch <- &sarama.ConsumerMessage{Offset: 0, Value: []byte("1")}
+	ch <- &sarama.ConsumerMessage{Offset: 1, Value: []byte("2")}
+	close(ch)
+	session := &fakeSession{ctx: context.Background()}
+	err := h.ConsumeClaim(session, fakeClaim{msgs: ch})
+	require.NoError(t, err)
+	// 投递死信队列失败了，不能提交，后面的消息也不再消费
+	assert.Equal(t, 1, calls)
+	assert.Empty(t, session.marked)
+}
+
+func TestRetryPolicy_Interval(t *testing.T) {
+	p := RetryPolicy{Initial: time.Millisecond * 100, Max: time.Millisecond * 300}
+	assert.Equal(t, time.Millisecond*100, p.Interval(0))
+	assert.Equal(t, time.Millisecond*200, p.Interval(1))
+	assert.Equal(t, time.Millisecond*300, p.Interval(2))
+	assert.Equal(t, time.Millisecond*300, p.Interval(10))
+}
+
+func TestReplayMessage(t *testing.T) {
+	pm, err := ReplayMessage(&sarama.ConsumerMessage{
+		Topic: "user_deleted_dead_letter",
+		Value: []byte("abc"),
+		Headers: []*sarama.RecordHeader{
+			{Key: []byte("trace"), Value: []byte("abc")},
+			{Key: []byte(HeaderTopic), Value: []byte("user_deleted")},
+			{Key: []byte(HeaderError), Value: []byte("mock error")},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &sarama.ProducerMessage{
+		Topic: "user_deleted",
+		Value: sarama.ByteEncoder("abc"),
+		Headers: []sarama.RecordHeader{
+			{Key: []byte("trace"), Value: []byte("abc")},
+		},
+	}, pm)
+
+	_, err = ReplayMessage(&sarama.ConsumerMessage{Topic: "user_deleted"})
+	assert.Equal(t, ErrNotDeadLetter, err)
+}
+
+type fakeProducer struct {
+	sarama.SyncProducer
+	msgs []*sarama.ProducerMessage
+	err  error
+}
+
+func (p *fakeProducer) SendMessage(msg *sarama.ProducerMessage) (int32, int64, error) {
+	if p.err != nil {
+		return 0, 0, p.err
+	}
+	p.msgs = append(p.msgs, msg)
+	return 0, 0, nil
+}
@@ -0,0 +1,114 @@
+package saramax
+
+import (
+	"context"
+	"webook/pkg/logger"
+
+	"github.com/IBM/sarama"
+)
+
+// Replayer 把死信队列里面的消息重新投递到原来的 topic。
+// 投递到了哪里记录在 group 的偏移量里面，重复执行只会投递新的死信
+type Replayer struct {
+	client   sarama.Client
+	producer sarama.SyncProducer
+	group    string
+	l        logger.LoggerV1
+}
+
+func NewReplayer(client sarama.Client, producer sarama.SyncProducer,
+	group string, l logger.LoggerV1) *Replayer {
+	return &Replayer{
+		client:   client,
+		producer: producer,
+		group:    group,
+		l:        l,
+	}
+}
+
+// Replay 投递 dlqTopic 里面开始执行的时候已经有的消息，返回投递成功的条数。
+// 投递失败就停下来，下次从失败的那条开始
+func (r *Replayer) Replay(ctx context.Context, dlqTopic string) (int, error) {
+	om, err := sarama.NewOffsetManagerFromClient(r.group, r.client)
+	if err != nil {
+		return 0, err
+	}
+	defer om.Close()
+	consumer, err := sarama.NewConsumerFromClient(r.client)
+	if err != nil {
+		return 0, err
+	}
+	defer consumer.Close()
+	partitions, err := r.client.Partitions(dlqTopic)
+	if err != nil {
+		return 0, err
+	}
+	total := 0
+	for _, p := range partitions {
+		cnt, err := r.replayPartition(ctx, om, consumer, dlqTopic, p)
+		total += cnt
+		// 每个分区都提交一次，后面失败了前面的也不会重复投递
+		om.Commit()
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+func (r *Replayer) replayPartition(ctx context.Context, om sarama.OffsetManager,
+	consumer sarama.Consumer, topic string, partition int32) (int, error) {
+	pom, err := om.ManagePartition(topic, partition)
+	if err != nil {
+		return 0, err
+	}
+	defer pom.Close()
+	// 只投递到现在为止的消息，不然投递回去又失败的消息会一直循环
+	end, err := r.client.GetOffset(topic, partition, sarama.OffsetNewest)
+	if err != nil {
+		return 0, err
+	}
+	oldest, err := r.client.GetOffset(topic, partition, sarama.OffsetOldest)
+	if err != nil {
+		return 0, err
+	}
+	// 第一次执行，或者记录的位置已经过期被删掉了
+	start, _ := pom.NextOffset()
+	if start < oldest {
+		start = oldest
+	}
+	if start >= end {
+		return 0, nil
+	}
+	pc, err := consumer.ConsumePartition(topic, partition, start)
+	if err != nil {
+		return 0, err
+	}
+	defer pc.Close()
+	cnt := 0
+	for {
+		select {
+		case <-ctx.Done():
+			return cnt, ctx.Err()
+		case msg := <-pc.Messages():
+			pm, err := ReplayMessage(msg)
+			if err == nil {
+				_, _, err = r.producer.SendMessage(pm)
+				if err != nil {
+					return cnt, err
+				}
+				cnt++
+			} else {
+				r.l.Warn("跳过不能重新投递的消息",
+					logger.String("topic", msg.Topic),
+					logger.Int32("partition", msg.Partition),
+					logger.Int64("offset", msg.Offset),
+					logger.Error(err))
+			}
+			pom.MarkOffset(msg.Offset+1, "")
+			if msg.Offset+1 >= end {
+				return cnt, nil
+			}
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package saramax
+
+import "time"
+
+// DefaultRetryPolicy 最多重试三次，分别等 100ms、200ms、400ms
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 3,
+	Initial:    time.Millisecond * 100,
+	Max:        time.Second * 5,
+}
+
+// RetryPolicy 指数退避，每次重试之前的等待时间翻倍，但是不超过 Max
+type RetryPolicy struct {
+	MaxRetries int
+	Initial    time.Duration
+	Max        time.Duration
+}
+
+// Interval 第 retries+1 次重试之前要等多久，retries 从 0 开始
+func (p RetryPolicy) Interval(retries int) time.Duration {
+	res := p.Initial
+	for i := 0; i < retries && res < p.Max; i++ {
+		res *= 2
+	}
+	if p.Max > 0 && res > p.Max {
+		return p.Max
+	}
+	return res
+}
@@ -0,0 +1,197 @@
+package seox
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// Entry 订阅源和站点地图里面的一篇文章
+type Entry struct {
+	// Url 文章的规范地址，同时作为 RSS 的 guid 和 Atom 的 id
+	Url       string
+	Title     string
+	Author    string
+	Summary   string
+	Published time.Time
+	Updated   time.Time
+}
+
+// Feed 整站或者某个作者的订阅源
+type Feed struct {
+	Title       string
+	Description string
+	// Link 对应的网页
+	Link string
+	// Self 订阅源自己的地址
+	Self    string
+	Updated time.Time
+	Entries []Entry
+}
+
+type sitemapURLSet struct {
+	XMLName xml.Name     `xml:"urlset"`
+	Xmlns   string       `xml:"xmlns,attr"`
+	URLs    []sitemapURL `xml:"url"`
+}
+
+type sitemapURL struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+// Sitemap 生成 sitemaps.org 格式的站点地图
+func Sitemap(entries []Entry) ([]byte, error) {
+	set := sitemapURLSet{
+		Xmlns: "http://www.sitemaps.org/schemas/sitemap/0.9",
+		URLs:  make([]sitemapURL, 0, len(entries)),
+	}
+	for _, e := range entries {
+		u := sitemapURL{Loc: e.Url}
+		if !e.Updated.IsZero() {
+			u.LastMod = e.Updated.UTC().Format(time.RFC3339)
+		}
+		set.URLs = append(set.URLs, u)
+	}
+	return marshal(set)
+}
+
+type rss struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Atom    string     `xml:"xmlns:atom,attr"`
+	Dc      string     `xml:"xmlns:dc,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Self          atomLink  `xml:"atom:link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string  `xml:"title"`
+	Link        string  `xml:"link"`
+	Guid        rssGuid `xml:"guid"`
+	Author      string  `xml:"dc:creator,omitempty"`
+	Description string  `xml:"description,omitempty"`
+	PubDate     string  `xml:"pubDate,omitempty"`
+}
+
+type rssGuid struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// RSS 生成 RSS 2.0。RSS 的 author 要求是邮箱，所以作者放在 dc:creator 里面
+func RSS(f Feed) ([]byte, error) {
+	ch := rssChannel{
+		Title:       f.Title,
+		Link:        f.Link,
+		Self:        atomLink{Href: f.Self, Rel: "self", Type: "application/rss+xml"},
+		Description: f.Description,
+		Items:       make([]rssItem, 0, len(f.Entries)),
+	}
+	if !f.Updated.IsZero() {
+		ch.LastBuildDate = f.Updated.UTC().Format(time.RFC1123Z)
+	}
+	for _, e := range f.Entries {
+		item := rssItem{
+			Title:       e.Title,
+			Link:        e.Url,
+			Guid:        rssGuid{IsPermaLink: true, Value: e.Url},
+			Author:      e.Author,
+			Description: e.Summary,
+		}
+		if !e.Published.IsZero() {
+			item.PubDate = e.Published.UTC().Format(time.RFC1123Z)
+		}
+		ch.Items = append(ch.Items, item)
+	}
+	return marshal(rss{
+		Version: "2.0",
+		Atom:    "http://www.w3.org/2005/Atom",
+		Dc:      "http://purl.org/dc/elements/1.1/",
+		Channel: ch,
+	})
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"feed"`
+	Xmlns   string      `xml:"xmlns,attr"`
+	Id      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Sub     string      `xml:"subtitle,omitempty"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type atomEntry struct {
+	Id        string      `xml:"id"`
+	Title     string      `xml:"title"`
+	Link      atomLink    `xml:"link"`
+	Author    *atomAuthor `xml:"author,omitempty"`
+	Summary   string      `xml:"summary,omitempty"`
+	Published string      `xml:"published,omitempty"`
+	Updated   string      `xml:"updated"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+// Atom 生成 Atom 1.0，Atom 要求每个 entry 都有 updated，
+// 没有的话用 Published 代替
+func Atom(f Feed) ([]byte, error) {
+	feed := atomFeed{
+		Xmlns:   "http://www.w3.org/2005/Atom",
+		Id:      f.Self,
+		Title:   f.Title,
+		Sub:     f.Description,
+		Updated: f.Updated.UTC().Format(time.RFC3339),
+		Links: []atomLink{
+			{Href: f.Link, Rel: "alternate", Type: "text/html"},
+			{Href: f.Self, Rel: "self", Type: "application/atom+xml"},
+		},
+		Entries: make([]atomEntry, 0, len(f.Entries)),
+	}
+	for _, e := range f.Entries {
+		updated := e.Updated
+		if updated.IsZero() {
+			updated = e.Published
+		}
+		entry := atomEntry{
+			Id:      e.Url,
+			Title:   e.Title,
+			Link:    atomLink{Href: e.Url, Rel: "alternate", Type: "text/html"},
+			Summary: e.Summary,
+			Updated: updated.UTC().Format(time.RFC3339),
+		}
+		if e.Author != "" {
+			entry.Author = &atomAuthor{Name: e.Author}
+		}
+		if !e.Published.IsZero() {
+			entry.Published = e.Published.UTC().Format(time.RFC3339)
+		}
+		feed.Entries = append(feed.Entries, entry)
+	}
+	return marshal(feed)
+}
+
+func marshal(v any) ([]byte, error) {
+	data, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), data...), nil
+}
@@ -0,0 +1,97 @@
+package seox
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSitemap(t *testing.T) {
+	data, err := Sitemap([]Entry{
+		{Url: "https://webook.com/articles/1/go", Updated: time.UnixMilli(0)},
+		{Url: "https://webook.com/articles/2/a&b"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">
+  <url>
+    <loc>https://webook.com/articles/1/go</loc>
+    <lastmod>1970-01-01T00:00:00Z</lastmod>
+  </url>
+  <url>
+    <loc>https://webook.com/articles/2/a&amp;b</loc>
+  </url>
+</urlset>`, string(data))
+}
+
+func TestRSS(t *testing.T) {
+	data, err := RSS(Feed{
+		Title:       "webook",
+		Description: "最新文章",
+		Link:        "https://webook.com",
+		Self:        "https://webook.com/feed/rss",
+		Updated:     time.UnixMilli(0),
+		Entries: []Entry{
+			{
+				Url:       "https://webook.com/articles/1/go",
+				Title:     "Go <入门>",
+				Author:    "Tom",
+				Summary:   "摘要",
+				Published: time.UnixMilli(0),
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom" xmlns:dc="http://purl.org/dc/elements/1.1/">
+  <channel>
+    <title>webook</title>
+    <link>https://webook.com</link>
+    <atom:link href="https://webook.com/feed/rss" rel="self" type="application/rss+xml"></atom:link>
+    <description>最新文章</description>
+    <lastBuildDate>Thu, 01 Jan 1970 00:00:00 +0000</lastBuildDate>
+    <item>
+      <title>Go &lt;入门&gt;</title>
+      <link>https://webook.com/articles/1/go</link>
+      <guid isPermaLink="true">https://webook.com/articles/1/go</guid>
+      <dc:creator>Tom</dc:creator>
+      <description>摘要</description>
+      <pubDate>Thu, 01 Jan 1970 00:00:00 +0000</pubDate>
+    </item>
+  </channel>
+</rss>`, string(data))
+}
+
+func TestAtom(t *testing.T) {
+	data, err := Atom(Feed{
+		Title:   "webook",
+		Link:    "https://webook.com",
+		Self:    "https://webook.com/feed/atom",
+		Updated: time.UnixMilli(0),
+		Entries: []Entry{
+			{
+				Url:       "https://webook.com/articles/1/go",
+				Title:     "Go",
+				Published: time.UnixMilli(0),
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <id>https://webook.com/feed/atom</id>
+  <title>webook</title>
+  <updated>1970-01-01T00:00:00Z</updated>
+  <link href="https://webook.com" rel="alternate" type="text/html"></link>
+  <link href="https://webook.com/feed/atom" rel="self" type="application/atom+xml"></link>
+  <entry>
+    <id>https://webook.com/articles/1/go</id>
+    <title>Go</title>
+    <link href="https://webook.com/articles/1/go" rel="alternate" type="text/html"></link>
+    <published>1970-01-01T00:00:00Z</published>
+    <updated>1970-01-01T00:00:00Z</updated>
+  </entry>
+</feed>`, string(data))
+}
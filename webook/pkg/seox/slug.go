@@ -0,0 +1,38 @@
+package seox
+
+import (
+	"strings"
+	"unicode"
+)
+
+// maxSlugLen slug 最多这么多个字符，太长的链接不好看，搜索引擎也会截断
+const maxSlugLen = 64
+
+// Slug 把标题转成链接里面的一段：
+// 字母转小写，字母、数字和中文保留，别的字符都换成 -，连续的 - 只留一个。
+// 中文保留原样，浏览器会自己做 URL 编码
+func Slug(title string) string {
+	var sb strings.Builder
+	n := 0
+	// dash 前面有没有需要补的 -，放到下一个保留的字符之前再写，
+	// 这样开头和结尾都不会有 -
+	dash := false
+	for _, r := range title {
+		if n >= maxSlugLen {
+			break
+		}
+		if !(unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			dash = n > 0
+			continue
+		}
+		if dash {
+			sb.WriteByte('-')
+			n++
+			dash = false
+		}
+		sb.WriteRune(unicode.ToLower(r))
+		n++
+	}
+	// 截断的时候最后一个可能是 -
+	return strings.TrimRight(sb.String(), "-")
+}
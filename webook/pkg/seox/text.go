@@ -0,0 +1,68 @@
+package seox
+
+import (
+	"time"
+	"unicode"
+)
+
+const (
+	// 英文大概每分钟 200 个词，中文大概每分钟 400 个字
+	wordsPerMinute = 200
+	cjkPerMinute   = 400
+)
+
+// TextStats 纯文本的字数统计，中文这种没有空格的文字一个字算一个，
+// 英文按照单词算
+type TextStats struct {
+	// Words 字母和数字连在一起的算一个词
+	Words int
+	// CJK 汉字、假名、谚文的个数
+	CJK int
+}
+
+// Count 统计字数，标点和空白不算
+func Count(text string) TextStats {
+	var res TextStats
+	inWord := false
+	for _, r := range text {
+		switch {
+		case isCJK(r):
+			res.CJK++
+			inWord = false
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if !inWord {
+				res.Words++
+				inWord = true
+			}
+		case r == '\'' || r == '’':
+			// don't、it’s 这种还是一个词
+		default:
+			inWord = false
+		}
+	}
+	return res
+}
+
+// Total 字数，一个汉字和一个英文单词都算一个
+func (s TextStats) Total() int {
+	return s.Words + s.CJK
+}
+
+// ReadingTime 阅读时间，向上取整到分钟，有内容的话至少一分钟
+func (s TextStats) ReadingTime() time.Duration {
+	if s.Total() == 0 {
+		return 0
+	}
+	// 按照秒来算，避免中英文混合的时候两边各取整一次
+	seconds := (s.Words*60+wordsPerMinute-1)/wordsPerMinute +
+		(s.CJK*60+cjkPerMinute-1)/cjkPerMinute
+	minutes := (seconds + 59) / 60
+	return time.Duration(minutes) * time.Minute
+}
+
+func isCJK(r rune) bool {
+	return unicode.Is(unicode.Han, r) ||
+		unicode.Is(unicode.Hiragana, r) ||
+		unicode.Is(unicode.Katakana, r) ||
+		unicode.Is(unicode.Hangul, r)
+}
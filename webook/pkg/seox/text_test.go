@@ -0,0 +1,102 @@
+package seox
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCount(t *testing.T) {
+	testCases := []struct {
+		name string
+		text string
+		want TextStats
+	}{
+		{
+			name: "英文按照单词算",
+			text: "Hello, world! It's Go 1.21.",
+			want: TextStats{Words: 6},
+		},
+		{
+			name: "中文一个字算一个，标点不算",
+			text: "你好，世界。",
+			want: TextStats{CJK: 4},
+		},
+		{
+			name: "中英文混合",
+			text: "用Go写web服务",
+			want: TextStats{Words: 2, CJK: 4},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, Count(tc.text))
+		})
+	}
+}
+
+func TestTextStats_ReadingTime(t *testing.T) {
+	testCases := []struct {
+		name  string
+		stats TextStats
+		want  time.Duration
+	}{
+		{
+			name: "没有内容",
+			want: 0,
+		},
+		{
+			name:  "不到一分钟算一分钟",
+			stats: TextStats{CJK: 10},
+			want:  time.Minute,
+		},
+		{
+			name:  "英文",
+			stats: TextStats{Words: 1000},
+			want:  5 * time.Minute,
+		},
+		{
+			name:  "中英文混合合在一起取整",
+			stats: TextStats{Words: 100, CJK: 200},
+			want:  time.Minute,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.stats.ReadingTime())
+		})
+	}
+}
+
+func TestSlug(t *testing.T) {
+	testCases := []struct {
+		name  string
+		title string
+		want  string
+	}{
+		{
+			name:  "英文",
+			title: "  Hello, World! Go 1.21 ",
+			want:  "hello-world-go-1-21",
+		},
+		{
+			name:  "中文保留",
+			title: "Go 语言：入门到放弃",
+			want:  "go-语言-入门到放弃",
+		},
+		{
+			name:  "没有可以用的字符",
+			title: "!!!",
+			want:  "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, Slug(tc.title))
+		})
+	}
+}
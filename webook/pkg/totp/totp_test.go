@@ -0,0 +1,69 @@
+package totp
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 测试数据来自 RFC 6238 附录 B 的 SHA1 部分，取后 6 位
+func TestCode(t *testing.T) {
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+	testCases := []struct {
+		unix int64
+		want string
+	}{
+		{unix: 59, want: "287082"},
+		{unix: 1111111109, want: "081804"},
+		{unix: 1111111111, want: "050471"},
+		{unix: 1234567890, want: "005924"},
+		{unix: 2000000000, want: "279037"},
+		{unix: 20000000000, want: "353130"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.want, func(t *testing.T) {
+			res, err := Code(secret, time.Unix(tc.unix, 0))
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, res)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret, err := GenerateSecret()
+	require.NoError(t, err)
+	now := time.Now()
+	prev, err := Code(secret, now.Add(-30*time.Second))
+	require.NoError(t, err)
+
+	ok, err := Validate(secret, prev, now, 1)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = Validate(secret, prev, now, 0)
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestMatch(t *testing.T) {
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+	// 1111111109 在第 37037036 个周期，1111111111 在下一个周期
+	now := time.Unix(1111111111, 0)
+
+	step, ok, err := Match(secret, "081804", now, 1)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, int64(37037036), step)
+
+	step, ok, err = Match(secret, "050471", now, 1)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, int64(37037037), step)
+
+	_, ok, err = Match(secret, "12345", now, 1)
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
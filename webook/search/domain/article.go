@@ -0,0 +1,20 @@
+package domain
+
+// ArticleStatusPublished 和 webook 里面的 ArticleStatusPublished 保持一致，
+// 只有已经发表的文章才能被搜索到
+const ArticleStatusPublished int32 = 2
+
+type Article struct {
+	Id     int64
+	Title  string
+	Status int32
+	// Content 搜索结果里面没有，只返回 ContentHighlight
+	Content string
+
+	// 下面这些只有搜索结果里面有
+	// TitleHighlight 命中的词用 <em> 包起来，已经转义过 HTML 了
+	TitleHighlight string
+	// ContentHighlight 正文命中的部分的摘要
+	ContentHighlight string
+	Score            float64
+}
@@ -0,0 +1,7 @@
+package domain
+
+type SearchResult struct {
+	Articles []Article
+	// Total 命中的文章总数，用来分页
+	Total int64
+}
@@ -0,0 +1,111 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+	"webook/pkg/logger"
+	"webook/pkg/saramax"
+	"webook/search/domain"
+	"webook/search/service"
+
+	"github.com/IBM/sarama"
+)
+
+// TopicSyncArticle 和 webook 里面的保持一致。每次启动都要从头重建索引，
+// 所以部署的时候要把这个 topic 配成 cleanup.policy=compact 或者不过期，
+// 不然过期删掉的文章重启之后就搜不到了。自动创建的 topic 用的是默认的 delete 策略
+const TopicSyncArticle = "sync_article_event"
+
+type ArticleEvent struct {
+	Id      int64  `json:"id"`
+	Title   string `json:"title"`
+	Status  int32  `json:"status"`
+	Content string `json:"content"`
+}
+
+// ArticleConsumer 索引在内存里面，每个实例都要有全量的数据，所以不能用消费者组。
+// 每次启动都从头消费所有的分区，把索引重新建起来。
+// 没有用 saramax.Handler，所以重试在 consume 里面自己做。失败的消息不投递死信队列：
+// 每个实例都会消费全部的消息，投递的话同一条消息会有好几份，
+// 而且索引是每个实例自己的，重启之后从头消费就能补回来
+type ArticleConsumer struct {
+	client sarama.Client
+	svc    service.SyncService
+	l      logger.LoggerV1
+	retry  saramax.RetryPolicy
+}
+
+func NewArticleConsumer(client sarama.Client,
+	l logger.LoggerV1,
+	svc service.SyncService) *ArticleConsumer {
+	return &ArticleConsumer{
+		client: client,
+		l:      l,
+		svc:    svc,
+		retry:  saramax.DefaultRetryPolicy,
+	}
+}
+
+func (c *ArticleConsumer) Start() error {
+	consumer, err := sarama.NewConsumerFromClient(c.client)
+	if err != nil {
+		return err
+	}
+	partitions, err := consumer.Partitions(TopicSyncArticle)
+	if err != nil {
+		return err
+	}
+	for _, p := range partitions {
+		pc, err := consumer.ConsumePartition(TopicSyncArticle, p, sarama.OffsetOldest)
+		if err != nil {
+			return err
+		}
+		go func() {
+			for msg := range pc.Messages() {
+				c.consume(msg)
+			}
+		}()
+	}
+	return nil
+}
+
+func (c *ArticleConsumer) consume(msg *sarama.ConsumerMessage) {
+	var evt ArticleEvent
+	err := json.Unmarshal(msg.Value, &evt)
+	if err != nil {
+		// 格式不对，重试也没有用
+		c.l.Error("反序列化消息体失败",
+			logger.String("topic", msg.Topic),
+			logger.Int32("partition", msg.Partition),
+			logger.Int64("offset", msg.Offset),
+			logger.Error(err))
+		return
+	}
+	art := domain.Article{
+		Id:      evt.Id,
+		Title:   evt.Title,
+		Status:  evt.Status,
+		Content: evt.Content,
+	}
+	retries := 0
+	err = c.input(art)
+	for err != nil && retries < c.retry.MaxRetries {
+		time.Sleep(c.retry.Interval(retries))
+		retries++
+		err = c.input(art)
+	}
+	if err != nil {
+		c.l.Error("同步文章到索引失败",
+			logger.Int64("aid", evt.Id),
+			logger.Int64("offset", msg.Offset),
+			logger.Int("retries", retries),
+			logger.Error(err))
+	}
+}
+
+func (c *ArticleConsumer) input(art domain.Article) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	return c.svc.InputArticle(ctx, art)
+}
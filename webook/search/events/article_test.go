@@ -0,0 +1,81 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"webook/pkg/logger"
+	"webook/pkg/saramax"
+	"webook/search/domain"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArticleConsumer_consume(t *testing.T) {
+	testCases := []struct {
+		name  string
+		value string
+		errs  []error
+
+		wantCalls int
+	}{
+		{
+			name:      "第一次就成功",
+			value:     `{"id":1,"title":"标题","status":2,"content":"内容"}`,
+			errs:      []error{nil},
+			wantCalls: 1,
+		},
+		{
+			name:      "失败了重试，重试成功",
+			value:     `{"id":1,"title":"标题","status":2,"content":"内容"}`,
+			errs:      []error{errors.New("索引错误"), errors.New("索引错误"), nil},
+			wantCalls: 3,
+		},
+		{
+			name:  "重试完了还是失败",
+			value: `{"id":1,"title":"标题","status":2,"content":"内容"}`,
+			errs: []error{errors.New("索引错误"), errors.New("索引错误"),
+				errors.New("索引错误"), errors.New("索引错误")},
+			wantCalls: 4,
+		},
+		{
+			name:      "格式不对，不重试",
+			value:     `abc`,
+			wantCalls: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := &fakeSyncService{errs: tc.errs}
+			c := NewArticleConsumer(nil, logger.NewNopLogger(), svc)
+			c.retry = saramax.RetryPolicy{
+				MaxRetries: 3,
+				Initial:    time.Millisecond,
+				Max:        time.Millisecond * 10,
+			}
+			c.consume(&sarama.ConsumerMessage{
+				Topic: TopicSyncArticle,
+				Value: []byte(tc.value),
+			})
+			assert.Equal(t, tc.wantCalls, len(svc.arts))
+			for _, art := range svc.arts {
+				assert.Equal(t, domain.Article{Id: 1, Title: "标题", Status: 2, Content: "内容"}, art)
+			}
+		})
+	}
+}
+
+// fakeSyncService 第 i 次调用返回 errs[i]
+type fakeSyncService struct {
+	errs []error
+	arts []domain.Article
+}
+
+func (f *fakeSyncService) InputArticle(ctx context.Context, art domain.Article) error {
+	err := f.errs[len(f.arts)]
+	f.arts = append(f.arts, art)
+	return err
+}
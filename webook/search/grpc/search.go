@@ -0,0 +1,52 @@
+package grpc
+
+import (
+	"context"
+	searchv1 "webook/api/proto/gen/search/v1"
+	"webook/search/domain"
+	"webook/search/service"
+
+	"github.com/ecodeclub/ekit/slice"
+	"google.golang.org/grpc"
+)
+
+type SearchServiceServer struct {
+	searchv1.UnimplementedSearchServiceServer
+	svc service.SearchService
+}
+
+func NewSearchServiceServer(svc service.SearchService) *SearchServiceServer {
+	return &SearchServiceServer{
+		svc: svc,
+	}
+}
+
+func (s *SearchServiceServer) Register(server grpc.ServiceRegistrar) {
+	searchv1.RegisterSearchServiceServer(server, s)
+}
+
+func (s *SearchServiceServer) Search(ctx context.Context, request *searchv1.SearchRequest) (*searchv1.SearchResponse, error) {
+	res, err := s.svc.Search(ctx, request.Uid, request.Expression,
+		int(request.Offset), int(request.Limit))
+	if err != nil {
+		return nil, err
+	}
+	return &searchv1.SearchResponse{
+		// 现在只有文章能搜，用户的结果先留空
+		User: &searchv1.UserResult{},
+		Article: &searchv1.ArticleResult{
+			Articles: slice.Map(res.Articles, func(idx int, src domain.Article) *searchv1.Article {
+				return &searchv1.Article{
+					Id:               src.Id,
+					Title:            src.Title,
+					Status:           src.Status,
+					Content:          src.Content,
+					TitleHighlight:   src.TitleHighlight,
+					ContentHighlight: src.ContentHighlight,
+					Score:            src.Score,
+				}
+			}),
+			Total: res.Total,
+		},
+	}, nil
+}
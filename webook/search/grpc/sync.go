@@ -0,0 +1,36 @@
+package grpc
+
+import (
+	"context"
+	searchv1 "webook/api/proto/gen/search/v1"
+	"webook/search/domain"
+	"webook/search/service"
+
+	"google.golang.org/grpc"
+)
+
+type SyncServiceServer struct {
+	searchv1.UnimplementedSyncServiceServer
+	svc service.SyncService
+}
+
+func NewSyncServiceServer(svc service.SyncService) *SyncServiceServer {
+	return &SyncServiceServer{
+		svc: svc,
+	}
+}
+
+func (s *SyncServiceServer) Register(server grpc.ServiceRegistrar) {
+	searchv1.RegisterSyncServiceServer(server, s)
+}
+
+func (s *SyncServiceServer) InputArticle(ctx context.Context, request *searchv1.InputArticleRequest) (*searchv1.InputArticleResponse, error) {
+	art := request.GetArticle()
+	err := s.svc.InputArticle(ctx, domain.Article{
+		Id:      art.GetId(),
+		Title:   art.GetTitle(),
+		Status:  art.GetStatus(),
+		Content: art.GetContent(),
+	})
+	return &searchv1.InputArticleResponse{}, err
+}
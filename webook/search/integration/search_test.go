@@ -0,0 +1,96 @@
+package integration
+
+import (
+	"context"
+	"testing"
+	searchv1 "webook/api/proto/gen/search/v1"
+	"webook/search/integration/startup"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type SearchSuite struct {
+	suite.Suite
+	searchSvc searchv1.SearchServiceServer
+	syncSvc   searchv1.SyncServiceServer
+}
+
+func (s *SearchSuite) SetupTest() {
+	// 每个测试用一个新的索引
+	servers := startup.InitServers()
+	s.searchSvc = servers.Search
+	s.syncSvc = servers.Sync
+}
+
+func (s *SearchSuite) input(arts ...*searchv1.Article) {
+	for _, art := range arts {
+		_, err := s.syncSvc.InputArticle(context.Background(), &searchv1.InputArticleRequest{
+			Article: art,
+		})
+		require.NoError(s.T(), err)
+	}
+}
+
+func (s *SearchSuite) TestSearch() {
+	s.input(&searchv1.Article{
+		Id:      1,
+		Title:   "Go 并发编程",
+		Status:  2,
+		Content: "介绍 goroutine 和 channel",
+	}, &searchv1.Article{
+		Id:      2,
+		Title:   "MySQL 索引",
+		Status:  2,
+		Content: "聊一聊 **并发** 场景下的锁",
+	}, &searchv1.Article{
+		// 没有发表的搜不到
+		Id:      3,
+		Title:   "并发草稿",
+		Status:  1,
+		Content: "并发",
+	})
+
+	resp, err := s.searchSvc.Search(context.Background(), &searchv1.SearchRequest{
+		Expression: "并发",
+		Limit:      10,
+	})
+	require.NoError(s.T(), err)
+	arts := resp.Article.Articles
+	assert.Equal(s.T(), int64(2), resp.Article.Total)
+	require.Len(s.T(), arts, 2)
+	// 标题命中的排在前面
+	assert.Equal(s.T(), int64(1), arts[0].Id)
+	assert.Equal(s.T(), "Go <em>并发</em>编程", arts[0].TitleHighlight)
+	assert.Equal(s.T(), int64(2), arts[1].Id)
+	// Markdown 的标记不会进索引，结果里面只有摘要，没有全文
+	assert.Empty(s.T(), arts[1].Content)
+	assert.Equal(s.T(), "聊一聊 <em>并发</em> 场景下的锁", arts[1].ContentHighlight)
+	assert.True(s.T(), arts[0].Score > arts[1].Score)
+}
+
+func (s *SearchSuite) TestWithdraw() {
+	s.input(&searchv1.Article{
+		Id:      1,
+		Title:   "Go 并发编程",
+		Status:  2,
+		Content: "介绍 goroutine 和 channel",
+	})
+	// 撤回之后发过来的是私有状态
+	s.input(&searchv1.Article{
+		Id:     1,
+		Status: 3,
+	})
+	resp, err := s.searchSvc.Search(context.Background(), &searchv1.SearchRequest{
+		Expression: "并发",
+		Limit:      10,
+	})
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(0), resp.Article.Total)
+	assert.Empty(s.T(), resp.Article.Articles)
+}
+
+func TestSearch(t *testing.T) {
+	suite.Run(t, new(SearchSuite))
+}
@@ -0,0 +1,12 @@
+package startup
+
+import (
+	"webook/pkg/fulltext"
+)
+
+func InitIndex() *fulltext.Index {
+	return fulltext.NewIndex([]fulltext.Field{
+		{Name: "title", Boost: 3},
+		{Name: "content", Boost: 1},
+	}, fulltext.WithSnippetLength(20))
+}
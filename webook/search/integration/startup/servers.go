@@ -0,0 +1,11 @@
+package startup
+
+import (
+	"webook/search/grpc"
+)
+
+// Servers 同步和搜索要用同一个索引
+type Servers struct {
+	Search *grpc.SearchServiceServer
+	Sync   *grpc.SyncServiceServer
+}
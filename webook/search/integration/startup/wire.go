@@ -0,0 +1,26 @@
+//go:build wireinject
+
+package startup
+
+import (
+	"webook/search/grpc"
+	"webook/search/repository"
+	"webook/search/repository/dao"
+	"webook/search/service"
+
+	"github.com/google/wire"
+)
+
+func InitServers() *Servers {
+	wire.Build(
+		InitIndex,
+		dao.NewIndexArticleDAO,
+		repository.NewArticleRepository,
+		service.NewSearchService,
+		service.NewSyncService,
+		grpc.NewSearchServiceServer,
+		grpc.NewSyncServiceServer,
+		wire.Struct(new(Servers), "*"),
+	)
+	return new(Servers)
+}
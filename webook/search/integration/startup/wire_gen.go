@@ -0,0 +1,31 @@
+// Code generated by Wire. DO NOT EDIT.
+
+//go:generate go run -mod=mod github.com/google/wire/cmd/wire
+//go:build !wireinject
+// +build !wireinject
+
+package startup
+
+import (
+	"webook/search/grpc"
+	"webook/search/repository"
+	"webook/search/repository/dao"
+	"webook/search/service"
+)
+
+// Injectors from wire.go:
+
+func InitServers() *Servers {
+	index := InitIndex()
+	articleDAO := dao.NewIndexArticleDAO(index)
+	articleRepository := repository.NewArticleRepository(articleDAO)
+	searchService := service.NewSearchService(articleRepository)
+	searchServiceServer := grpc.NewSearchServiceServer(searchService)
+	syncService := service.NewSyncService(articleRepository)
+	syncServiceServer := grpc.NewSyncServiceServer(syncService)
+	servers := &Servers{
+		Search: searchServiceServer,
+		Sync:   syncServiceServer,
+	}
+	return servers
+}
@@ -0,0 +1,34 @@
+package ioc
+
+import (
+	"webook/pkg/grpcx"
+	"webook/pkg/logger"
+	grpc2 "webook/search/grpc"
+
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+)
+
+func InitGRPCxServer(searchSvc *grpc2.SearchServiceServer,
+	syncSvc *grpc2.SyncServiceServer, l logger.LoggerV1) *grpcx.Server {
+	type Config struct {
+		EtcdAddr string `yaml:"etcdAddr"`
+		Port     int    `yaml:"port"`
+		Name     string `yaml:"name"`
+	}
+	var cfg Config
+	err := viper.UnmarshalKey("grpc.server", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	server := grpc.NewServer()
+	searchSvc.Register(server)
+	syncSvc.Register(server)
+	return &grpcx.Server{
+		Server:   server,
+		EtcdAddr: cfg.EtcdAddr,
+		Port:     cfg.Port,
+		Name:     cfg.Name,
+		L:        l,
+	}
+}
@@ -0,0 +1,27 @@
+package ioc
+
+import (
+	"webook/pkg/fulltext"
+
+	"github.com/spf13/viper"
+)
+
+func InitIndex() *fulltext.Index {
+	type Config struct {
+		// TitleBoost 标题命中的分数是正文的多少倍
+		TitleBoost    float64 `yaml:"titleBoost"`
+		SnippetLength int     `yaml:"snippetLength"`
+	}
+	cfg := Config{
+		TitleBoost:    3,
+		SnippetLength: 120,
+	}
+	err := viper.UnmarshalKey("index", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	return fulltext.NewIndex([]fulltext.Field{
+		{Name: "title", Boost: cfg.TitleBoost},
+		{Name: "content", Boost: 1},
+	}, fulltext.WithSnippetLength(cfg.SnippetLength))
+}
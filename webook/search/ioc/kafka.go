@@ -0,0 +1,30 @@
+package ioc
+
+import (
+	"webook/internal/events"
+	events2 "webook/search/events"
+
+	"github.com/IBM/sarama"
+	"github.com/spf13/viper"
+)
+
+func InitSaramaClient() sarama.Client {
+	type Config struct {
+		Addr []string `yaml:"addr"`
+	}
+	var cfg Config
+	err := viper.UnmarshalKey("kafka", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	scfg := sarama.NewConfig()
+	client, err := sarama.NewClient(cfg.Addr, scfg)
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
+func InitConsumers(c1 *events2.ArticleConsumer) []events.Consumer {
+	return []events.Consumer{c1}
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"webook/search/domain"
+	"webook/search/repository/dao"
+
+	"github.com/ecodeclub/ekit/slice"
+)
+
+type ArticleRepository interface {
+	InputArticle(ctx context.Context, art domain.Article) error
+	SearchArticle(ctx context.Context, expression string, offset, limit int) (domain.SearchResult, error)
+}
+
+type articleRepository struct {
+	dao dao.ArticleDAO
+}
+
+func NewArticleRepository(d dao.ArticleDAO) ArticleRepository {
+	return &articleRepository{
+		dao: d,
+	}
+}
+
+func (repo *articleRepository) InputArticle(ctx context.Context, art domain.Article) error {
+	return repo.dao.InputArticle(ctx, dao.Article{
+		Id:      art.Id,
+		Title:   art.Title,
+		Status:  art.Status,
+		Content: art.Content,
+	})
+}
+
+func (repo *articleRepository) SearchArticle(ctx context.Context, expression string,
+	offset, limit int) (domain.SearchResult, error) {
+	arts, total, err := repo.dao.Search(ctx, expression, offset, limit)
+	if err != nil {
+		return domain.SearchResult{}, err
+	}
+	return domain.SearchResult{
+		Articles: slice.Map(arts, func(idx int, src dao.Article) domain.Article {
+			return domain.Article{
+				Id:               src.Id,
+				Title:            src.Title,
+				Status:           src.Status,
+				Content:          src.Content,
+				TitleHighlight:   src.TitleHighlight,
+				ContentHighlight: src.ContentHighlight,
+				Score:            src.Score,
+			}
+		}),
+		Total: total,
+	}, nil
+}
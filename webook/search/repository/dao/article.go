@@ -0,0 +1,75 @@
+package dao
+
+import (
+	"context"
+	"webook/pkg/fulltext"
+)
+
+const (
+	articleStatusPublished int32 = 2
+
+	fieldTitle   = "title"
+	fieldContent = "content"
+)
+
+// ArticleDAO 现在用的是内置的倒排索引，换成 Elasticsearch 只需要换一个实现
+type ArticleDAO interface {
+	// InputArticle 没有发表的文章会从索引里面删掉
+	InputArticle(ctx context.Context, art Article) error
+	// Search 按照相关度倒序，total 是命中的总数
+	Search(ctx context.Context, expression string, offset, limit int) (arts []Article, total int64, err error)
+}
+
+type IndexArticleDAO struct {
+	idx *fulltext.Index
+}
+
+func NewIndexArticleDAO(idx *fulltext.Index) ArticleDAO {
+	return &IndexArticleDAO{
+		idx: idx,
+	}
+}
+
+func (d *IndexArticleDAO) InputArticle(ctx context.Context, art Article) error {
+	if art.Status != articleStatusPublished {
+		d.idx.Delete(art.Id)
+		return nil
+	}
+	d.idx.Put(fulltext.Document{
+		Id: art.Id,
+		Fields: map[string]string{
+			fieldTitle:   art.Title,
+			fieldContent: art.Content,
+		},
+	})
+	return nil
+}
+
+func (d *IndexArticleDAO) Search(ctx context.Context, expression string,
+	offset, limit int) ([]Article, int64, error) {
+	hits, total := d.idx.Search(expression, offset, limit)
+	res := make([]Article, 0, len(hits))
+	for _, hit := range hits {
+		res = append(res, Article{
+			Id:               hit.Id,
+			Title:            hit.Fields[fieldTitle],
+			Status:           articleStatusPublished,
+			TitleHighlight:   hit.Highlights[fieldTitle],
+			ContentHighlight: hit.Highlights[fieldContent],
+			Score:            hit.Score,
+		})
+	}
+	return res, int64(total), nil
+}
+
+type Article struct {
+	Id     int64
+	Title  string
+	Status int32
+	// Content 搜索结果里面不返回全文，只有 ContentHighlight
+	Content string
+
+	TitleHighlight   string
+	ContentHighlight string
+	Score            float64
+}
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+	"webook/search/domain"
+	"webook/search/repository"
+)
+
+const defaultSearchLimit = 20
+
+type SearchService interface {
+	// Search uid 是搜索的人，现在还没有用上，以后可以用来做个性化排序
+	Search(ctx context.Context, uid int64, expression string, offset, limit int) (domain.SearchResult, error)
+}
+
+type searchService struct {
+	articleRepo repository.ArticleRepository
+}
+
+func NewSearchService(articleRepo repository.ArticleRepository) SearchService {
+	return &searchService{
+		articleRepo: articleRepo,
+	}
+}
+
+func (s *searchService) Search(ctx context.Context, uid int64, expression string,
+	offset, limit int) (domain.SearchResult, error) {
+	if offset < 0 {
+		offset = 0
+	}
+	if limit <= 0 || limit > 100 {
+		limit = defaultSearchLimit
+	}
+	return s.articleRepo.SearchArticle(ctx, expression, offset, limit)
+}
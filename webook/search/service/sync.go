@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+	"webook/pkg/mdx"
+	"webook/search/domain"
+	"webook/search/repository"
+)
+
+type SyncService interface {
+	InputArticle(ctx context.Context, art domain.Article) error
+}
+
+type syncService struct {
+	articleRepo repository.ArticleRepository
+}
+
+func NewSyncService(articleRepo repository.ArticleRepository) SyncService {
+	return &syncService{
+		articleRepo: articleRepo,
+	}
+}
+
+// InputArticle 只用纯文本建索引，不然链接、代码块的标记也会被搜到，摘要里面也会有。
+// webook 发过来的已经是纯文本了，但是 topic 里面还有以前发的 Markdown 原文，
+// 重放的时候也要转一下
+func (s *syncService) InputArticle(ctx context.Context, art domain.Article) error {
+	art.Content = mdx.PlainText(art.Content)
+	return s.articleRepo.InputArticle(ctx, art)
+}
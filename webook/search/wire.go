@@ -0,0 +1,41 @@
+//go:build wireinject
+
+package main
+
+import (
+	"webook/search/events"
+	grpc2 "webook/search/grpc"
+	"webook/search/ioc"
+	"webook/search/repository"
+	"webook/search/repository/dao"
+	"webook/search/service"
+
+	"github.com/google/wire"
+)
+
+var serviceProviderSet = wire.NewSet(
+	dao.NewIndexArticleDAO,
+	repository.NewArticleRepository,
+	service.NewSearchService,
+	service.NewSyncService,
+	grpc2.NewSearchServiceServer,
+	grpc2.NewSyncServiceServer,
+	events.NewArticleConsumer,
+)
+
+var thirdProvider = wire.NewSet(
+	ioc.InitIndex,
+	ioc.InitLogger,
+	ioc.InitSaramaClient,
+)
+
+func Init() *App {
+	wire.Build(
+		thirdProvider,
+		serviceProviderSet,
+		ioc.InitGRPCxServer,
+		ioc.InitConsumers,
+		wire.Struct(new(App), "*"),
+	)
+	return new(App)
+}
@@ -0,0 +1,45 @@
+// Code generated by Wire. DO NOT EDIT.
+
+//go:generate go run -mod=mod github.com/google/wire/cmd/wire
+//go:build !wireinject
+// +build !wireinject
+
+package main
+
+import (
+	"github.com/google/wire"
+	"webook/search/events"
+	"webook/search/grpc"
+	"webook/search/ioc"
+	"webook/search/repository"
+	"webook/search/repository/dao"
+	"webook/search/service"
+)
+
+// Injectors from wire.go:
+
+func Init() *App {
+	index := ioc.InitIndex()
+	articleDAO := dao.NewIndexArticleDAO(index)
+	articleRepository := repository.NewArticleRepository(articleDAO)
+	searchService := service.NewSearchService(articleRepository)
+	searchServiceServer := grpc.NewSearchServiceServer(searchService)
+	syncService := service.NewSyncService(articleRepository)
+	syncServiceServer := grpc.NewSyncServiceServer(syncService)
+	loggerV1 := ioc.InitLogger()
+	server := ioc.InitGRPCxServer(searchServiceServer, syncServiceServer, loggerV1)
+	client := ioc.InitSaramaClient()
+	articleConsumer := events.NewArticleConsumer(client, loggerV1, syncService)
+	v := ioc.InitConsumers(articleConsumer)
+	app := &App{
+		server:    server,
+		consumers: v,
+	}
+	return app
+}
+
+// wire.go:
+
+var serviceProviderSet = wire.NewSet(dao.NewIndexArticleDAO, repository.NewArticleRepository, service.NewSearchService, service.NewSyncService, grpc.NewSearchServiceServer, grpc.NewSyncServiceServer, events.NewArticleConsumer)
+
+var thirdProvider = wire.NewSet(ioc.InitIndex, ioc.InitLogger, ioc.InitSaramaClient)
@@ -8,6 +8,7 @@ import (
 	dao2 "webook/interactive/repository/dao"
 	service2 "webook/interactive/service"
 	"webook/internal/events/article"
+	"webook/internal/events/user"
 	"webook/internal/repository"
 	"webook/internal/repository/cache"
 	"webook/internal/repository/dao"
@@ -24,6 +25,15 @@ var interactiveSvcSet = wire.NewSet(
 	cache2.NewRedisInteractiveCache,
 	repository2.NewCachedInteractiveRepository,
 	service2.NewInteractiveService,
+	dao2.NewGORMCollectionDAO,
+	repository2.NewCachedCollectionRepository,
+	service2.NewCollectionService,
+)
+
+var jobSvcSet = wire.NewSet(
+	dao.NewGORMJobDAO,
+	repository.NewPreemptJobRepository,
+	service.NewCronJobService,
 )
 
 var rankingSvcSet = wire.NewSet(
@@ -44,37 +54,88 @@ func InitWebServer() *App {
 		// DAO
 		dao.NewUserDAO,
 		dao.NewArticleGORMDAO,
+		dao.NewGORMArticleCollaboratorDAO,
+		dao.NewGORMMediaDAO,
+		dao.NewGORMColumnDAO,
+		dao.NewGORMArticleReviewDAO,
 
 		// interactiveSvcSet,
 		rankingSvcSet,
 		ioc.InitJobs,
 		ioc.InitRankingJob,
+		ioc.InitUserDeletionJob,
+		jobSvcSet,
+		ioc.InitArticlePublishJob,
+		ioc.InitJobScheduler,
 
 		article.NewSaramaSyncProducer,
+		user.NewSaramaSyncProducer,
+		user.NewExportEventConsumer,
+		wire.Bind(new(user.Exporter), new(service.UserDataService)),
 		// events.NewInteractiveReadEventConsumer,
 
 		// Cache
 		cache.NewCodeCache, cache.NewUserCache,
+		cache.NewUserTokenCache,
 		cache.NewArticleRedisCache,
+		cache.NewUserExportCache,
 
 		// Repository
 		repository.NewUserRepository, repository.NewCodeRepository,
+		repository.NewUserTokenRepository,
 		repository.NewArticleRepository,
+		repository.NewArticleCollaboratorRepository,
+		repository.NewUserExportRepository,
+		repository.NewMediaRepository,
+		repository.NewColumnRepository,
+		repository.NewArticleReviewRepository,
 
 		// Service
 		ioc.InitSMSService,
+		ioc.InitEmailService,
+		ioc.InitCaptchaService,
 		ioc.InitWechatService,
+		ioc.InitOAuth2Registry,
+		ioc.InitRBACPolicy,
+		ioc.InitRBACBuilder,
+		ioc.InitUserConfig,
 		// ioc.InitIntrClient,
 		ioc.InitIntrClientV1,
+		ioc.InitCommentClient,
+		ioc.InitFollowClient,
+		ioc.InitUserDataConfig,
 		service.NewUserService,
+		service.NewTwoFactorService,
 		service.NewCodeService,
 		service.NewArticleService,
+		service.NewUserDataService,
+		ioc.InitObjectStore,
+		ioc.InitMediaConfig,
+		service.NewMediaService,
+		service.NewColumnService,
+		ioc.InitModerator,
+		service.NewArticleReviewService,
 
 		// Handler
 		web.NewUserHandler,
 		web.NewOAuth2WechatHandler,
+		web.NewOAuth2Handler,
+		web.NewAdminHandler,
+		web.NewUserDataHandler,
+		web.NewMediaHandler,
+		web.NewColumnHandler,
+		web.NewArticleReviewHandler,
 		web.NewArticleHandler,
+		web.NewFeedHandler,
+		web.NewCollectionHandler,
+		web.NewUserInteractiveHandler,
+		web.NewJWKSHandler,
+
+		ioc.InitSiteConfig,
 
+		ioc.InitLoginGuard,
+		ioc.InitJWTKeySet,
+		ioc.InitRefreshKey,
 		ijwt.NewRedisJWTHandler,
 
 		ioc.InitGinMiddlewares,
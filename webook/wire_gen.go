@@ -13,6 +13,7 @@ import (
 	dao2 "webook/interactive/repository/dao"
 	service2 "webook/interactive/service"
 	"webook/internal/events/article"
+	"webook/internal/events/user"
 	"webook/internal/repository"
 	"webook/internal/repository/cache"
 	"webook/internal/repository/dao"
@@ -30,49 +31,103 @@ import (
 
 func InitWebServer() *App {
 	cmdable := ioc.InitRedis()
-	handler := jwt.NewRedisJWTHandler(cmdable)
 	loggerV1 := ioc.InitLogger()
+	keySet := ioc.InitJWTKeySet(loggerV1)
+	refreshKey := ioc.InitRefreshKey()
+	handler := jwt.NewRedisJWTHandler(cmdable, loggerV1, keySet, refreshKey)
 	v := ioc.InitGinMiddlewares(cmdable, handler, loggerV1)
 	db := ioc.InitDB(loggerV1)
 	userDAO := dao.NewUserDAO(db)
 	userCache := cache.NewUserCache(cmdable)
 	userRepository := repository.NewUserRepository(userDAO, userCache)
-	userService := service.NewUserService(userRepository)
+	userTokenCache := cache.NewUserTokenCache(cmdable)
+	userTokenRepository := repository.NewUserTokenRepository(userTokenCache)
+	emailService := ioc.InitEmailService()
+	userConfig := ioc.InitUserConfig()
+	client := ioc.InitSaramaClient()
+	syncProducer := ioc.InitSyncProducer(client)
+	userProducer := user.NewSaramaSyncProducer(syncProducer)
+	userService := service.NewUserService(userRepository, userTokenRepository, emailService, userProducer, userConfig)
 	codeCache := cache.NewCodeCache(cmdable)
 	codeRepository := repository.NewCodeRepository(codeCache)
 	smsService := ioc.InitSMSService()
 	codeService := service.NewCodeService(codeRepository, smsService)
-	userHandler := web.NewUserHandler(userService, codeService, handler)
+	twoFactorService := service.NewTwoFactorService(userRepository)
+	captchaService := ioc.InitCaptchaService()
+	guard := ioc.InitLoginGuard(cmdable)
+	userHandler := web.NewUserHandler(userService, codeService, twoFactorService, captchaService, guard, handler)
 	articleDAO := dao.NewArticleGORMDAO(db)
 	articleCache := cache.NewArticleRedisCache(cmdable)
 	articleRepository := repository.NewArticleRepository(articleDAO, userRepository, articleCache)
-	client := ioc.InitSaramaClient()
-	syncProducer := ioc.InitSyncProducer(client)
+	articleCollaboratorDAO := dao.NewGORMArticleCollaboratorDAO(db)
+	articleCollaboratorRepository := repository.NewArticleCollaboratorRepository(articleCollaboratorDAO)
+	mediaDAO := dao.NewGORMMediaDAO(db)
+	mediaRepository := repository.NewMediaRepository(mediaDAO)
+	store := ioc.InitObjectStore()
+	mediaConfig := ioc.InitMediaConfig()
+	mediaService := service.NewMediaService(mediaRepository, store, mediaConfig, loggerV1)
+	articleReviewDAO := dao.NewGORMArticleReviewDAO(db)
+	articleReviewRepository := repository.NewArticleReviewRepository(articleReviewDAO)
+	moderator := ioc.InitModerator(loggerV1)
 	producer := article.NewSaramaSyncProducer(syncProducer)
-	articleService := service.NewArticleService(articleRepository, producer, loggerV1)
+	articleService := service.NewArticleService(articleRepository, articleCollaboratorRepository, mediaService, articleReviewRepository, moderator, producer, loggerV1)
 	clientv3Client := ioc.InitEtcd()
 	interactiveServiceClient := ioc.InitIntrClientV1(clientv3Client)
-	articleHandler := web.NewArticleHandler(articleService, interactiveServiceClient, loggerV1)
+	siteConfig := ioc.InitSiteConfig()
+	articleHandler := web.NewArticleHandler(articleService, interactiveServiceClient, siteConfig, loggerV1)
 	wechatService := ioc.InitWechatService(loggerV1)
 	oAuth2WechatHandler := web.NewOAuth2WechatHandler(wechatService, handler, userService)
-	engine := ioc.InitWebServer(v, userHandler, articleHandler, oAuth2WechatHandler)
-	v2 := ioc.InitConsumers()
+	registry := ioc.InitOAuth2Registry()
+	oAuth2Handler := web.NewOAuth2Handler(registry, handler, userService)
+	policy := ioc.InitRBACPolicy()
+	builder := ioc.InitRBACBuilder(policy)
+	adminHandler := web.NewAdminHandler(userService, handler, policy, builder)
+	userExportCache := cache.NewUserExportCache(cmdable)
+	userExportRepository := repository.NewUserExportRepository(userExportCache)
+	commentServiceClient := ioc.InitCommentClient(clientv3Client)
+	followServiceClient := ioc.InitFollowClient(clientv3Client)
+	userDataConfig := ioc.InitUserDataConfig()
+	userDataService := service.NewUserDataService(userRepository, articleRepository, userExportRepository, interactiveServiceClient, commentServiceClient, followServiceClient, twoFactorService, store, userProducer, userDataConfig)
+	userDataHandler := web.NewUserDataHandler(userDataService, handler, guard)
+	mediaHandler := web.NewMediaHandler(mediaService, mediaConfig, loggerV1)
+	columnDAO := dao.NewGORMColumnDAO(db)
+	columnRepository := repository.NewColumnRepository(columnDAO)
+	columnService := service.NewColumnService(columnRepository, articleRepository, loggerV1)
+	columnHandler := web.NewColumnHandler(columnService, loggerV1)
+	articleReviewService := service.NewArticleReviewService(articleReviewRepository, articleRepository, userRepository, emailService, producer, loggerV1)
+	articleReviewHandler := web.NewArticleReviewHandler(articleReviewService, builder)
+	feedHandler := web.NewFeedHandler(articleService, siteConfig, loggerV1)
+	collectionHandler := web.NewCollectionHandler(interactiveServiceClient, loggerV1)
+	userInteractiveHandler := web.NewUserInteractiveHandler(interactiveServiceClient, articleService, loggerV1)
+	jwksHandler := web.NewJWKSHandler(keySet)
+	engine := ioc.InitWebServer(v, userHandler, articleHandler, oAuth2WechatHandler, oAuth2Handler, adminHandler, userDataHandler, mediaHandler, columnHandler, articleReviewHandler, feedHandler, collectionHandler, userInteractiveHandler, jwksHandler)
+	exportEventConsumer := user.NewExportEventConsumer(client, syncProducer, loggerV1, userDataService)
+	v2 := ioc.InitConsumers(exportEventConsumer)
 	rankingCache := cache.NewRankingRedisCache(cmdable)
 	rankingRepository := repository.NewCachedRankingRepository(rankingCache)
 	rankingService := service.NewBatchRankingService(interactiveServiceClient, articleService, rankingRepository)
 	rlockClient := ioc.InitRlockClient(cmdable)
 	rankingJob := ioc.InitRankingJob(rankingService, rlockClient, loggerV1)
-	cron := ioc.InitJobs(loggerV1, rankingJob)
+	userDeletionJob := ioc.InitUserDeletionJob(userDataService, loggerV1)
+	cron := ioc.InitJobs(loggerV1, rankingJob, userDeletionJob)
+	jobDAO := dao.NewGORMJobDAO(db)
+	cronJobRepository := repository.NewPreemptJobRepository(jobDAO)
+	cronJobService := service.NewCronJobService(cronJobRepository, loggerV1)
+	articlePublishJob := ioc.InitArticlePublishJob(articleService, loggerV1)
+	scheduler := ioc.InitJobScheduler(cronJobService, articlePublishJob, loggerV1)
 	app := &App{
 		server:    engine,
 		consumers: v2,
 		cron:      cron,
+		scheduler: scheduler,
 	}
 	return app
 }
 
 // wire.go:
 
-var interactiveSvcSet = wire.NewSet(dao2.NewGORMInteractiveDAO, cache2.NewRedisInteractiveCache, repository2.NewCachedInteractiveRepository, service2.NewInteractiveService)
+var interactiveSvcSet = wire.NewSet(dao2.NewGORMInteractiveDAO, cache2.NewRedisInteractiveCache, repository2.NewCachedInteractiveRepository, service2.NewInteractiveService, dao2.NewGORMCollectionDAO, repository2.NewCachedCollectionRepository, service2.NewCollectionService)
+
+var jobSvcSet = wire.NewSet(dao.NewGORMJobDAO, repository.NewPreemptJobRepository, service.NewCronJobService)
 
 var rankingSvcSet = wire.NewSet(cache.NewRankingRedisCache, repository.NewCachedRankingRepository, service.NewBatchRankingService)